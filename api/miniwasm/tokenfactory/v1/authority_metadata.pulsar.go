@@ -2,26 +2,33 @@
 package tokenfactoryv1
 
 import (
+	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
 var (
-	md_DenomAuthorityMetadata       protoreflect.MessageDescriptor
-	fd_DenomAuthorityMetadata_admin protoreflect.FieldDescriptor
+	md_DenomAuthorityMetadata                        protoreflect.MessageDescriptor
+	fd_DenomAuthorityMetadata_admin                  protoreflect.FieldDescriptor
+	fd_DenomAuthorityMetadata_burn_from_enabled      protoreflect.FieldDescriptor
+	fd_DenomAuthorityMetadata_force_transfer_enabled protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_miniwasm_tokenfactory_v1_authority_metadata_proto_init()
 	md_DenomAuthorityMetadata = File_miniwasm_tokenfactory_v1_authority_metadata_proto.Messages().ByName("DenomAuthorityMetadata")
 	fd_DenomAuthorityMetadata_admin = md_DenomAuthorityMetadata.Fields().ByName("admin")
+	fd_DenomAuthorityMetadata_burn_from_enabled = md_DenomAuthorityMetadata.Fields().ByName("burn_from_enabled")
+	fd_DenomAuthorityMetadata_force_transfer_enabled = md_DenomAuthorityMetadata.Fields().ByName("force_transfer_enabled")
 }
 
 var _ protoreflect.Message = (*fastReflection_DenomAuthorityMetadata)(nil)
@@ -95,6 +102,18 @@ func (x *fastReflection_DenomAuthorityMetadata) Range(f func(protoreflect.FieldD
 			return
 		}
 	}
+	if x.BurnFromEnabled != false {
+		value := protoreflect.ValueOfBool(x.BurnFromEnabled)
+		if !f(fd_DenomAuthorityMetadata_burn_from_enabled, value) {
+			return
+		}
+	}
+	if x.ForceTransferEnabled != false {
+		value := protoreflect.ValueOfBool(x.ForceTransferEnabled)
+		if !f(fd_DenomAuthorityMetadata_force_transfer_enabled, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -112,6 +131,10 @@ func (x *fastReflection_DenomAuthorityMetadata) Has(fd protoreflect.FieldDescrip
 	switch fd.FullName() {
 	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.admin":
 		return x.Admin != ""
+	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.burn_from_enabled":
+		return x.BurnFromEnabled != false
+	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.force_transfer_enabled":
+		return x.ForceTransferEnabled != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.DenomAuthorityMetadata"))
@@ -130,6 +153,10 @@ func (x *fastReflection_DenomAuthorityMetadata) Clear(fd protoreflect.FieldDescr
 	switch fd.FullName() {
 	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.admin":
 		x.Admin = ""
+	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.burn_from_enabled":
+		x.BurnFromEnabled = false
+	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.force_transfer_enabled":
+		x.ForceTransferEnabled = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.DenomAuthorityMetadata"))
@@ -149,6 +176,12 @@ func (x *fastReflection_DenomAuthorityMetadata) Get(descriptor protoreflect.Fiel
 	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.admin":
 		value := x.Admin
 		return protoreflect.ValueOfString(value)
+	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.burn_from_enabled":
+		value := x.BurnFromEnabled
+		return protoreflect.ValueOfBool(value)
+	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.force_transfer_enabled":
+		value := x.ForceTransferEnabled
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.DenomAuthorityMetadata"))
@@ -171,6 +204,10 @@ func (x *fastReflection_DenomAuthorityMetadata) Set(fd protoreflect.FieldDescrip
 	switch fd.FullName() {
 	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.admin":
 		x.Admin = value.Interface().(string)
+	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.burn_from_enabled":
+		x.BurnFromEnabled = value.Bool()
+	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.force_transfer_enabled":
+		x.ForceTransferEnabled = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.DenomAuthorityMetadata"))
@@ -193,6 +230,10 @@ func (x *fastReflection_DenomAuthorityMetadata) Mutable(fd protoreflect.FieldDes
 	switch fd.FullName() {
 	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.admin":
 		panic(fmt.Errorf("field admin of message miniwasm.tokenfactory.v1.DenomAuthorityMetadata is not mutable"))
+	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.burn_from_enabled":
+		panic(fmt.Errorf("field burn_from_enabled of message miniwasm.tokenfactory.v1.DenomAuthorityMetadata is not mutable"))
+	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.force_transfer_enabled":
+		panic(fmt.Errorf("field force_transfer_enabled of message miniwasm.tokenfactory.v1.DenomAuthorityMetadata is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.DenomAuthorityMetadata"))
@@ -208,6 +249,10 @@ func (x *fastReflection_DenomAuthorityMetadata) NewField(fd protoreflect.FieldDe
 	switch fd.FullName() {
 	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.admin":
 		return protoreflect.ValueOfString("")
+	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.burn_from_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "miniwasm.tokenfactory.v1.DenomAuthorityMetadata.force_transfer_enabled":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.DenomAuthorityMetadata"))
@@ -281,6 +326,12 @@ func (x *fastReflection_DenomAuthorityMetadata) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.BurnFromEnabled {
+			n += 2
+		}
+		if x.ForceTransferEnabled {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -310,6 +361,26 @@ func (x *fastReflection_DenomAuthorityMetadata) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ForceTransferEnabled {
+			i--
+			if x.ForceTransferEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.BurnFromEnabled {
+			i--
+			if x.BurnFromEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
 		if len(x.Admin) > 0 {
 			i -= len(x.Admin)
 			copy(dAtA[i:], x.Admin)
@@ -398,6 +469,46 @@ func (x *fastReflection_DenomAuthorityMetadata) ProtoMethods() *protoiface.Metho
 				}
 				x.Admin = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnFromEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnFromEnabled = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForceTransferEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ForceTransferEnabled = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -433,123 +544,2504 @@ func (x *fastReflection_DenomAuthorityMetadata) ProtoMethods() *protoiface.Metho
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: miniwasm/tokenfactory/v1/authority_metadata.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_DenomRoleGrant         protoreflect.MessageDescriptor
+	fd_DenomRoleGrant_role    protoreflect.FieldDescriptor
+	fd_DenomRoleGrant_address protoreflect.FieldDescriptor
 )
 
-// DenomAuthorityMetadata specifies metadata for addresses that have specific
-// capabilities over a token factory denom. Right now there is only one Admin
-// permission, but is planned to be extended to the future.
-type DenomAuthorityMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_miniwasm_tokenfactory_v1_authority_metadata_proto_init()
+	md_DenomRoleGrant = File_miniwasm_tokenfactory_v1_authority_metadata_proto.Messages().ByName("DenomRoleGrant")
+	fd_DenomRoleGrant_role = md_DenomRoleGrant.Fields().ByName("role")
+	fd_DenomRoleGrant_address = md_DenomRoleGrant.Fields().ByName("address")
+}
 
-	// Can be empty for no admin, or a valid address
-	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+var _ protoreflect.Message = (*fastReflection_DenomRoleGrant)(nil)
+
+type fastReflection_DenomRoleGrant DenomRoleGrant
+
+func (x *DenomRoleGrant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DenomRoleGrant)(x)
 }
 
-func (x *DenomAuthorityMetadata) Reset() {
-	*x = DenomAuthorityMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[0]
+func (x *DenomRoleGrant) slowProtoReflect() protoreflect.Message {
+	mi := &file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *DenomAuthorityMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
+var _fastReflection_DenomRoleGrant_messageType fastReflection_DenomRoleGrant_messageType
+var _ protoreflect.MessageType = fastReflection_DenomRoleGrant_messageType{}
+
+type fastReflection_DenomRoleGrant_messageType struct{}
+
+func (x fastReflection_DenomRoleGrant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DenomRoleGrant)(nil)
+}
+func (x fastReflection_DenomRoleGrant_messageType) New() protoreflect.Message {
+	return new(fastReflection_DenomRoleGrant)
+}
+func (x fastReflection_DenomRoleGrant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomRoleGrant
 }
 
-func (*DenomAuthorityMetadata) ProtoMessage() {}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DenomRoleGrant) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomRoleGrant
+}
 
-// Deprecated: Use DenomAuthorityMetadata.ProtoReflect.Descriptor instead.
-func (*DenomAuthorityMetadata) Descriptor() ([]byte, []int) {
-	return file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescGZIP(), []int{0}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DenomRoleGrant) Type() protoreflect.MessageType {
+	return _fastReflection_DenomRoleGrant_messageType
 }
 
-func (x *DenomAuthorityMetadata) GetAdmin() string {
-	if x != nil {
-		return x.Admin
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DenomRoleGrant) New() protoreflect.Message {
+	return new(fastReflection_DenomRoleGrant)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DenomRoleGrant) Interface() protoreflect.ProtoMessage {
+	return (*DenomRoleGrant)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DenomRoleGrant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Role != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Role))
+		if !f(fd_DenomRoleGrant_role, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_DenomRoleGrant_address, value) {
+			return
+		}
 	}
-	return ""
 }
 
-var File_miniwasm_tokenfactory_v1_authority_metadata_proto protoreflect.FileDescriptor
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DenomRoleGrant) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.DenomRoleGrant.role":
+		return x.Role != 0
+	case "miniwasm.tokenfactory.v1.DenomRoleGrant.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.DenomRoleGrant"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.DenomRoleGrant does not contain field %s", fd.FullName()))
+	}
+}
 
-var file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDesc = []byte{
-	0x0a, 0x31, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x18, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67,
-	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x46, 0x0a, 0x16, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a,
-	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde,
-	0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x52, 0x05,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0x85, 0x02, 0x0a, 0x1c,
-	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x16, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d,
-	0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6e, 0x69,
-	0x77, 0x61, 0x73, 0x6d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x54, 0x58, 0xaa, 0x02, 0x18, 0x4d, 0x69, 0x6e, 0x69,
-	0x77, 0x61, 0x73, 0x6d, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x5c,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x24, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73,
-	0x6d, 0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomRoleGrant) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.DenomRoleGrant.role":
+		x.Role = 0
+	case "miniwasm.tokenfactory.v1.DenomRoleGrant.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.DenomRoleGrant"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.DenomRoleGrant does not contain field %s", fd.FullName()))
+	}
 }
 
-var (
-	file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescOnce sync.Once
-	file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescData = file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDesc
-)
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DenomRoleGrant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "miniwasm.tokenfactory.v1.DenomRoleGrant.role":
+		value := x.Role
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "miniwasm.tokenfactory.v1.DenomRoleGrant.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.DenomRoleGrant"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.DenomRoleGrant does not contain field %s", descriptor.FullName()))
+	}
+}
 
-func file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescGZIP() []byte {
-	file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescOnce.Do(func() {
-		file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescData = protoimpl.X.CompressGZIP(file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescData)
-	})
-	return file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescData
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomRoleGrant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.DenomRoleGrant.role":
+		x.Role = (DenomRole)(value.Enum())
+	case "miniwasm.tokenfactory.v1.DenomRoleGrant.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.DenomRoleGrant"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.DenomRoleGrant does not contain field %s", fd.FullName()))
+	}
 }
 
-var file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_miniwasm_tokenfactory_v1_authority_metadata_proto_goTypes = []interface{}{
-	(*DenomAuthorityMetadata)(nil), // 0: miniwasm.tokenfactory.v1.DenomAuthorityMetadata
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomRoleGrant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.DenomRoleGrant.role":
+		panic(fmt.Errorf("field role of message miniwasm.tokenfactory.v1.DenomRoleGrant is not mutable"))
+	case "miniwasm.tokenfactory.v1.DenomRoleGrant.address":
+		panic(fmt.Errorf("field address of message miniwasm.tokenfactory.v1.DenomRoleGrant is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.DenomRoleGrant"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.DenomRoleGrant does not contain field %s", fd.FullName()))
+	}
 }
-var file_miniwasm_tokenfactory_v1_authority_metadata_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DenomRoleGrant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.DenomRoleGrant.role":
+		return protoreflect.ValueOfEnum(0)
+	case "miniwasm.tokenfactory.v1.DenomRoleGrant.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.DenomRoleGrant"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.DenomRoleGrant does not contain field %s", fd.FullName()))
+	}
 }
 
-func init() { file_miniwasm_tokenfactory_v1_authority_metadata_proto_init() }
-func file_miniwasm_tokenfactory_v1_authority_metadata_proto_init() {
-	if File_miniwasm_tokenfactory_v1_authority_metadata_proto != nil {
-		return
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DenomRoleGrant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in miniwasm.tokenfactory.v1.DenomRoleGrant", d.FullName()))
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DenomAuthorityMetadata); i {
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DenomRoleGrant) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomRoleGrant) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DenomRoleGrant) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DenomRoleGrant) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DenomRoleGrant)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Role != 0 {
+			n += 1 + runtime.Sov(uint64(x.Role))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DenomRoleGrant)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Role != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Role))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DenomRoleGrant)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomRoleGrant: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomRoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				}
+				x.Role = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Role |= DenomRole(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MintAllowance           protoreflect.MessageDescriptor
+	fd_MintAllowance_minter    protoreflect.FieldDescriptor
+	fd_MintAllowance_allowance protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_miniwasm_tokenfactory_v1_authority_metadata_proto_init()
+	md_MintAllowance = File_miniwasm_tokenfactory_v1_authority_metadata_proto.Messages().ByName("MintAllowance")
+	fd_MintAllowance_minter = md_MintAllowance.Fields().ByName("minter")
+	fd_MintAllowance_allowance = md_MintAllowance.Fields().ByName("allowance")
+}
+
+var _ protoreflect.Message = (*fastReflection_MintAllowance)(nil)
+
+type fastReflection_MintAllowance MintAllowance
+
+func (x *MintAllowance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MintAllowance)(x)
+}
+
+func (x *MintAllowance) slowProtoReflect() protoreflect.Message {
+	mi := &file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MintAllowance_messageType fastReflection_MintAllowance_messageType
+var _ protoreflect.MessageType = fastReflection_MintAllowance_messageType{}
+
+type fastReflection_MintAllowance_messageType struct{}
+
+func (x fastReflection_MintAllowance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MintAllowance)(nil)
+}
+func (x fastReflection_MintAllowance_messageType) New() protoreflect.Message {
+	return new(fastReflection_MintAllowance)
+}
+func (x fastReflection_MintAllowance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintAllowance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MintAllowance) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintAllowance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MintAllowance) Type() protoreflect.MessageType {
+	return _fastReflection_MintAllowance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MintAllowance) New() protoreflect.Message {
+	return new(fastReflection_MintAllowance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MintAllowance) Interface() protoreflect.ProtoMessage {
+	return (*MintAllowance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MintAllowance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Minter != "" {
+		value := protoreflect.ValueOfString(x.Minter)
+		if !f(fd_MintAllowance_minter, value) {
+			return
+		}
+	}
+	if x.Allowance != "" {
+		value := protoreflect.ValueOfString(x.Allowance)
+		if !f(fd_MintAllowance_allowance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MintAllowance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.MintAllowance.minter":
+		return x.Minter != ""
+	case "miniwasm.tokenfactory.v1.MintAllowance.allowance":
+		return x.Allowance != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.MintAllowance"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.MintAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintAllowance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.MintAllowance.minter":
+		x.Minter = ""
+	case "miniwasm.tokenfactory.v1.MintAllowance.allowance":
+		x.Allowance = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.MintAllowance"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.MintAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MintAllowance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "miniwasm.tokenfactory.v1.MintAllowance.minter":
+		value := x.Minter
+		return protoreflect.ValueOfString(value)
+	case "miniwasm.tokenfactory.v1.MintAllowance.allowance":
+		value := x.Allowance
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.MintAllowance"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.MintAllowance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintAllowance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.MintAllowance.minter":
+		x.Minter = value.Interface().(string)
+	case "miniwasm.tokenfactory.v1.MintAllowance.allowance":
+		x.Allowance = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.MintAllowance"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.MintAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintAllowance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.MintAllowance.minter":
+		panic(fmt.Errorf("field minter of message miniwasm.tokenfactory.v1.MintAllowance is not mutable"))
+	case "miniwasm.tokenfactory.v1.MintAllowance.allowance":
+		panic(fmt.Errorf("field allowance of message miniwasm.tokenfactory.v1.MintAllowance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.MintAllowance"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.MintAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MintAllowance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.MintAllowance.minter":
+		return protoreflect.ValueOfString("")
+	case "miniwasm.tokenfactory.v1.MintAllowance.allowance":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.MintAllowance"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.MintAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MintAllowance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in miniwasm.tokenfactory.v1.MintAllowance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MintAllowance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintAllowance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MintAllowance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MintAllowance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MintAllowance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Minter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Allowance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MintAllowance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Allowance) > 0 {
+			i -= len(x.Allowance)
+			copy(dAtA[i:], x.Allowance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Allowance)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Minter) > 0 {
+			i -= len(x.Minter)
+			copy(dAtA[i:], x.Minter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Minter)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MintAllowance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintAllowance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Minter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Allowance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PendingAdminTransfer               protoreflect.MessageDescriptor
+	fd_PendingAdminTransfer_pending_admin protoreflect.FieldDescriptor
+	fd_PendingAdminTransfer_expires_at    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_miniwasm_tokenfactory_v1_authority_metadata_proto_init()
+	md_PendingAdminTransfer = File_miniwasm_tokenfactory_v1_authority_metadata_proto.Messages().ByName("PendingAdminTransfer")
+	fd_PendingAdminTransfer_pending_admin = md_PendingAdminTransfer.Fields().ByName("pending_admin")
+	fd_PendingAdminTransfer_expires_at = md_PendingAdminTransfer.Fields().ByName("expires_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingAdminTransfer)(nil)
+
+type fastReflection_PendingAdminTransfer PendingAdminTransfer
+
+func (x *PendingAdminTransfer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingAdminTransfer)(x)
+}
+
+func (x *PendingAdminTransfer) slowProtoReflect() protoreflect.Message {
+	mi := &file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingAdminTransfer_messageType fastReflection_PendingAdminTransfer_messageType
+var _ protoreflect.MessageType = fastReflection_PendingAdminTransfer_messageType{}
+
+type fastReflection_PendingAdminTransfer_messageType struct{}
+
+func (x fastReflection_PendingAdminTransfer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingAdminTransfer)(nil)
+}
+func (x fastReflection_PendingAdminTransfer_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingAdminTransfer)
+}
+func (x fastReflection_PendingAdminTransfer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingAdminTransfer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingAdminTransfer) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingAdminTransfer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingAdminTransfer) Type() protoreflect.MessageType {
+	return _fastReflection_PendingAdminTransfer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingAdminTransfer) New() protoreflect.Message {
+	return new(fastReflection_PendingAdminTransfer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingAdminTransfer) Interface() protoreflect.ProtoMessage {
+	return (*PendingAdminTransfer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingAdminTransfer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PendingAdmin != "" {
+		value := protoreflect.ValueOfString(x.PendingAdmin)
+		if !f(fd_PendingAdminTransfer_pending_admin, value) {
+			return
+		}
+	}
+	if x.ExpiresAt != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiresAt.ProtoReflect())
+		if !f(fd_PendingAdminTransfer_expires_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingAdminTransfer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.PendingAdminTransfer.pending_admin":
+		return x.PendingAdmin != ""
+	case "miniwasm.tokenfactory.v1.PendingAdminTransfer.expires_at":
+		return x.ExpiresAt != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.PendingAdminTransfer"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.PendingAdminTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingAdminTransfer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.PendingAdminTransfer.pending_admin":
+		x.PendingAdmin = ""
+	case "miniwasm.tokenfactory.v1.PendingAdminTransfer.expires_at":
+		x.ExpiresAt = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.PendingAdminTransfer"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.PendingAdminTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingAdminTransfer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "miniwasm.tokenfactory.v1.PendingAdminTransfer.pending_admin":
+		value := x.PendingAdmin
+		return protoreflect.ValueOfString(value)
+	case "miniwasm.tokenfactory.v1.PendingAdminTransfer.expires_at":
+		value := x.ExpiresAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.PendingAdminTransfer"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.PendingAdminTransfer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingAdminTransfer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.PendingAdminTransfer.pending_admin":
+		x.PendingAdmin = value.Interface().(string)
+	case "miniwasm.tokenfactory.v1.PendingAdminTransfer.expires_at":
+		x.ExpiresAt = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.PendingAdminTransfer"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.PendingAdminTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingAdminTransfer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.PendingAdminTransfer.expires_at":
+		if x.ExpiresAt == nil {
+			x.ExpiresAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiresAt.ProtoReflect())
+	case "miniwasm.tokenfactory.v1.PendingAdminTransfer.pending_admin":
+		panic(fmt.Errorf("field pending_admin of message miniwasm.tokenfactory.v1.PendingAdminTransfer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.PendingAdminTransfer"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.PendingAdminTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingAdminTransfer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.PendingAdminTransfer.pending_admin":
+		return protoreflect.ValueOfString("")
+	case "miniwasm.tokenfactory.v1.PendingAdminTransfer.expires_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.PendingAdminTransfer"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.PendingAdminTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingAdminTransfer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in miniwasm.tokenfactory.v1.PendingAdminTransfer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingAdminTransfer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingAdminTransfer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingAdminTransfer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingAdminTransfer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingAdminTransfer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PendingAdmin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiresAt != nil {
+			l = options.Size(x.ExpiresAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingAdminTransfer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpiresAt != nil {
+			encoded, err := options.Marshal(x.ExpiresAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PendingAdmin) > 0 {
+			i -= len(x.PendingAdmin)
+			copy(dAtA[i:], x.PendingAdmin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingAdmin)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingAdminTransfer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingAdminTransfer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingAdminTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingAdmin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingAdmin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiresAt == nil {
+					x.ExpiresAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiresAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BeforeSendHookFailures               protoreflect.MessageDescriptor
+	fd_BeforeSendHookFailures_count         protoreflect.FieldDescriptor
+	fd_BeforeSendHookFailures_last_height   protoreflect.FieldDescriptor
+	fd_BeforeSendHookFailures_last_contract protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_miniwasm_tokenfactory_v1_authority_metadata_proto_init()
+	md_BeforeSendHookFailures = File_miniwasm_tokenfactory_v1_authority_metadata_proto.Messages().ByName("BeforeSendHookFailures")
+	fd_BeforeSendHookFailures_count = md_BeforeSendHookFailures.Fields().ByName("count")
+	fd_BeforeSendHookFailures_last_height = md_BeforeSendHookFailures.Fields().ByName("last_height")
+	fd_BeforeSendHookFailures_last_contract = md_BeforeSendHookFailures.Fields().ByName("last_contract")
+}
+
+var _ protoreflect.Message = (*fastReflection_BeforeSendHookFailures)(nil)
+
+type fastReflection_BeforeSendHookFailures BeforeSendHookFailures
+
+func (x *BeforeSendHookFailures) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BeforeSendHookFailures)(x)
+}
+
+func (x *BeforeSendHookFailures) slowProtoReflect() protoreflect.Message {
+	mi := &file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BeforeSendHookFailures_messageType fastReflection_BeforeSendHookFailures_messageType
+var _ protoreflect.MessageType = fastReflection_BeforeSendHookFailures_messageType{}
+
+type fastReflection_BeforeSendHookFailures_messageType struct{}
+
+func (x fastReflection_BeforeSendHookFailures_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BeforeSendHookFailures)(nil)
+}
+func (x fastReflection_BeforeSendHookFailures_messageType) New() protoreflect.Message {
+	return new(fastReflection_BeforeSendHookFailures)
+}
+func (x fastReflection_BeforeSendHookFailures_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BeforeSendHookFailures
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BeforeSendHookFailures) Descriptor() protoreflect.MessageDescriptor {
+	return md_BeforeSendHookFailures
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BeforeSendHookFailures) Type() protoreflect.MessageType {
+	return _fastReflection_BeforeSendHookFailures_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BeforeSendHookFailures) New() protoreflect.Message {
+	return new(fastReflection_BeforeSendHookFailures)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BeforeSendHookFailures) Interface() protoreflect.ProtoMessage {
+	return (*BeforeSendHookFailures)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BeforeSendHookFailures) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Count != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Count)
+		if !f(fd_BeforeSendHookFailures_count, value) {
+			return
+		}
+	}
+	if x.LastHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastHeight)
+		if !f(fd_BeforeSendHookFailures_last_height, value) {
+			return
+		}
+	}
+	if x.LastContract != "" {
+		value := protoreflect.ValueOfString(x.LastContract)
+		if !f(fd_BeforeSendHookFailures_last_contract, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BeforeSendHookFailures) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.count":
+		return x.Count != uint64(0)
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.last_height":
+		return x.LastHeight != int64(0)
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.last_contract":
+		return x.LastContract != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.BeforeSendHookFailures"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.BeforeSendHookFailures does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BeforeSendHookFailures) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.count":
+		x.Count = uint64(0)
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.last_height":
+		x.LastHeight = int64(0)
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.last_contract":
+		x.LastContract = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.BeforeSendHookFailures"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.BeforeSendHookFailures does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BeforeSendHookFailures) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.count":
+		value := x.Count
+		return protoreflect.ValueOfUint64(value)
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.last_height":
+		value := x.LastHeight
+		return protoreflect.ValueOfInt64(value)
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.last_contract":
+		value := x.LastContract
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.BeforeSendHookFailures"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.BeforeSendHookFailures does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BeforeSendHookFailures) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.count":
+		x.Count = value.Uint()
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.last_height":
+		x.LastHeight = value.Int()
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.last_contract":
+		x.LastContract = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.BeforeSendHookFailures"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.BeforeSendHookFailures does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BeforeSendHookFailures) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.count":
+		panic(fmt.Errorf("field count of message miniwasm.tokenfactory.v1.BeforeSendHookFailures is not mutable"))
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.last_height":
+		panic(fmt.Errorf("field last_height of message miniwasm.tokenfactory.v1.BeforeSendHookFailures is not mutable"))
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.last_contract":
+		panic(fmt.Errorf("field last_contract of message miniwasm.tokenfactory.v1.BeforeSendHookFailures is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.BeforeSendHookFailures"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.BeforeSendHookFailures does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BeforeSendHookFailures) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.last_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "miniwasm.tokenfactory.v1.BeforeSendHookFailures.last_contract":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.BeforeSendHookFailures"))
+		}
+		panic(fmt.Errorf("message miniwasm.tokenfactory.v1.BeforeSendHookFailures does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BeforeSendHookFailures) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in miniwasm.tokenfactory.v1.BeforeSendHookFailures", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BeforeSendHookFailures) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BeforeSendHookFailures) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BeforeSendHookFailures) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BeforeSendHookFailures) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BeforeSendHookFailures)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		if x.LastHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastHeight))
+		}
+		l = len(x.LastContract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BeforeSendHookFailures)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LastContract) > 0 {
+			i -= len(x.LastContract)
+			copy(dAtA[i:], x.LastContract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastContract)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.LastHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BeforeSendHookFailures)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BeforeSendHookFailures: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BeforeSendHookFailures: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+				}
+				x.LastHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastContract", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastContract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: miniwasm/tokenfactory/v1/authority_metadata.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DenomRole defines the roles the admin of a denom can grant to other
+// accounts. The admin implicitly holds every role.
+type DenomRole int32
+
+const (
+	// DENOM_ROLE_UNSPECIFIED is the default value, which is not a valid role.
+	DenomRole_DENOM_ROLE_UNSPECIFIED DenomRole = 0
+	// DENOM_ROLE_MINTER allows minting, optionally bounded by a mint allowance.
+	DenomRole_DENOM_ROLE_MINTER DenomRole = 1
+	// DENOM_ROLE_BURNER allows burning from the balance of the burner.
+	DenomRole_DENOM_ROLE_BURNER DenomRole = 2
+	// DENOM_ROLE_METADATA_MANAGER allows setting the bank metadata.
+	DenomRole_DENOM_ROLE_METADATA_MANAGER DenomRole = 3
+	// DENOM_ROLE_HOOK_MANAGER allows setting the before send hooks.
+	DenomRole_DENOM_ROLE_HOOK_MANAGER DenomRole = 4
+)
+
+// Enum value maps for DenomRole.
+var (
+	DenomRole_name = map[int32]string{
+		0: "DENOM_ROLE_UNSPECIFIED",
+		1: "DENOM_ROLE_MINTER",
+		2: "DENOM_ROLE_BURNER",
+		3: "DENOM_ROLE_METADATA_MANAGER",
+		4: "DENOM_ROLE_HOOK_MANAGER",
+	}
+	DenomRole_value = map[string]int32{
+		"DENOM_ROLE_UNSPECIFIED":      0,
+		"DENOM_ROLE_MINTER":           1,
+		"DENOM_ROLE_BURNER":           2,
+		"DENOM_ROLE_METADATA_MANAGER": 3,
+		"DENOM_ROLE_HOOK_MANAGER":     4,
+	}
+)
+
+func (x DenomRole) Enum() *DenomRole {
+	p := new(DenomRole)
+	*p = x
+	return p
+}
+
+func (x DenomRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DenomRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_miniwasm_tokenfactory_v1_authority_metadata_proto_enumTypes[0].Descriptor()
+}
+
+func (DenomRole) Type() protoreflect.EnumType {
+	return &file_miniwasm_tokenfactory_v1_authority_metadata_proto_enumTypes[0]
+}
+
+func (x DenomRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DenomRole.Descriptor instead.
+func (DenomRole) EnumDescriptor() ([]byte, []int) {
+	return file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescGZIP(), []int{0}
+}
+
+// DenomAuthorityMetadata specifies metadata for addresses that have specific
+// capabilities over a token factory denom, the Admin permission and the
+// admin capabilities the denom opted into at creation.
+type DenomAuthorityMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Can be empty for no admin, or a valid address
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// burn_from_enabled allows the admin to burn tokens from any account. It can
+	// only be enabled at denom creation and can only be turned off afterwards.
+	BurnFromEnabled bool `protobuf:"varint,2,opt,name=burn_from_enabled,json=burnFromEnabled,proto3" json:"burn_from_enabled,omitempty"`
+	// force_transfer_enabled allows the admin to transfer tokens between any
+	// accounts. It can only be enabled at denom creation and can only be turned
+	// off afterwards.
+	ForceTransferEnabled bool `protobuf:"varint,3,opt,name=force_transfer_enabled,json=forceTransferEnabled,proto3" json:"force_transfer_enabled,omitempty"`
+}
+
+func (x *DenomAuthorityMetadata) Reset() {
+	*x = DenomAuthorityMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenomAuthorityMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenomAuthorityMetadata) ProtoMessage() {}
+
+// Deprecated: Use DenomAuthorityMetadata.ProtoReflect.Descriptor instead.
+func (*DenomAuthorityMetadata) Descriptor() ([]byte, []int) {
+	return file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DenomAuthorityMetadata) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *DenomAuthorityMetadata) GetBurnFromEnabled() bool {
+	if x != nil {
+		return x.BurnFromEnabled
+	}
+	return false
+}
+
+func (x *DenomAuthorityMetadata) GetForceTransferEnabled() bool {
+	if x != nil {
+		return x.ForceTransferEnabled
+	}
+	return false
+}
+
+// DenomRoleGrant is a role granted to an address for a denom.
+type DenomRoleGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role    DenomRole `protobuf:"varint,1,opt,name=role,proto3,enum=miniwasm.tokenfactory.v1.DenomRole" json:"role,omitempty"`
+	Address string    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *DenomRoleGrant) Reset() {
+	*x = DenomRoleGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenomRoleGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenomRoleGrant) ProtoMessage() {}
+
+// Deprecated: Use DenomRoleGrant.ProtoReflect.Descriptor instead.
+func (*DenomRoleGrant) Descriptor() ([]byte, []int) {
+	return file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DenomRoleGrant) GetRole() DenomRole {
+	if x != nil {
+		return x.Role
+	}
+	return DenomRole_DENOM_ROLE_UNSPECIFIED
+}
+
+func (x *DenomRoleGrant) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// MintAllowance is the remaining amount a minter can mint. Minters without
+// a mint allowance can mint without limit.
+type MintAllowance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Minter    string `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty"`
+	Allowance string `protobuf:"bytes,2,opt,name=allowance,proto3" json:"allowance,omitempty"`
+}
+
+func (x *MintAllowance) Reset() {
+	*x = MintAllowance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintAllowance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintAllowance) ProtoMessage() {}
+
+// Deprecated: Use MintAllowance.ProtoReflect.Descriptor instead.
+func (*MintAllowance) Descriptor() ([]byte, []int) {
+	return file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MintAllowance) GetMinter() string {
+	if x != nil {
+		return x.Minter
+	}
+	return ""
+}
+
+func (x *MintAllowance) GetAllowance() string {
+	if x != nil {
+		return x.Allowance
+	}
+	return ""
+}
+
+// PendingAdminTransfer is an admin transfer proposed by the admin of a denom
+// that waits for the acceptance of the pending admin.
+type PendingAdminTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PendingAdmin string `protobuf:"bytes,1,opt,name=pending_admin,json=pendingAdmin,proto3" json:"pending_admin,omitempty"`
+	// expires_at is the time after which the transfer cannot be accepted
+	// anymore. The zero time means no expiry.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *PendingAdminTransfer) Reset() {
+	*x = PendingAdminTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingAdminTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingAdminTransfer) ProtoMessage() {}
+
+// Deprecated: Use PendingAdminTransfer.ProtoReflect.Descriptor instead.
+func (*PendingAdminTransfer) Descriptor() ([]byte, []int) {
+	return file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PendingAdminTransfer) GetPendingAdmin() string {
+	if x != nil {
+		return x.PendingAdmin
+	}
+	return ""
+}
+
+func (x *PendingAdminTransfer) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// BeforeSendHookFailures counts the failed before send hook calls of a denom,
+// so that the issuer can see when its hook contracts are broken. The count
+// saturates at types.MaxBeforeSendHookFailures.
+type BeforeSendHookFailures struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// last_height is the block height of the last failure.
+	LastHeight int64 `protobuf:"varint,2,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// last_contract is the hook contract of the last failure.
+	LastContract string `protobuf:"bytes,3,opt,name=last_contract,json=lastContract,proto3" json:"last_contract,omitempty"`
+}
+
+func (x *BeforeSendHookFailures) Reset() {
+	*x = BeforeSendHookFailures{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeforeSendHookFailures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeforeSendHookFailures) ProtoMessage() {}
+
+// Deprecated: Use BeforeSendHookFailures.ProtoReflect.Descriptor instead.
+func (*BeforeSendHookFailures) Descriptor() ([]byte, []int) {
+	return file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BeforeSendHookFailures) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BeforeSendHookFailures) GetLastHeight() int64 {
+	if x != nil {
+		return x.LastHeight
+	}
+	return 0
+}
+
+func (x *BeforeSendHookFailures) GetLastContract() string {
+	if x != nil {
+		return x.LastContract
+	}
+	return ""
+}
+
+var File_miniwasm_tokenfactory_v1_authority_metadata_proto protoreflect.FileDescriptor
+
+var file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x18, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61,
+	0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xe9, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a,
+	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde,
+	0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x52, 0x05,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x75, 0x72, 0x6e,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x52, 0x0f,
+	0x62, 0x75, 0x72, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x57, 0x0a, 0x16, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x21, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x22, 0x52, 0x14, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa6,
+	0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x12, 0x48, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x52, 0x6f, 0x6c, 0x65, 0x42, 0x0f, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xf2, 0xde,
+	0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xbc, 0x01, 0x0a, 0x0d, 0x4d, 0x69, 0x6e, 0x74,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x6d, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x0d, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x09,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x44, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde,
+	0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xd2, 0x01, 0x0a, 0x14, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12,
+	0x55, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x5d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x11,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74,
+	0x22, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xd6, 0x01, 0x0a, 0x16,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37,
+	0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x52, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x55, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x2a, 0x95, 0x02, 0x0a, 0x09, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x44, 0x45, 0x4e, 0x4f, 0x4d, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x18,
+	0x8a, 0x9d, 0x20, 0x14, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x55, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x44, 0x45, 0x4e, 0x4f,
+	0x4d, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x01, 0x1a,
+	0x13, 0x8a, 0x9d, 0x20, 0x0f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x44, 0x45, 0x4e, 0x4f, 0x4d, 0x5f, 0x52, 0x4f,
+	0x4c, 0x45, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x45, 0x52, 0x10, 0x02, 0x1a, 0x13, 0x8a, 0x9d, 0x20,
+	0x0f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x72,
+	0x12, 0x3d, 0x0a, 0x1b, 0x44, 0x45, 0x4e, 0x4f, 0x4d, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4d,
+	0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x52, 0x10,
+	0x03, 0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12,
+	0x35, 0x0a, 0x17, 0x44, 0x45, 0x4e, 0x4f, 0x4d, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x48, 0x4f,
+	0x4f, 0x4b, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x52, 0x10, 0x04, 0x1a, 0x18, 0x8a, 0x9d,
+	0x20, 0x14, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0x85, 0x02, 0x0a,
+	0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x16, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6e,
+	0x69, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x54, 0x58, 0xaa, 0x02, 0x18, 0x4d, 0x69, 0x6e,
+	0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d,
+	0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x24, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x5c, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61,
+	0x73, 0x6d, 0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescOnce sync.Once
+	file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescData = file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDesc
+)
+
+func file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescGZIP() []byte {
+	file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescOnce.Do(func() {
+		file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescData = protoimpl.X.CompressGZIP(file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescData)
+	})
+	return file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDescData
+}
+
+var file_miniwasm_tokenfactory_v1_authority_metadata_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_miniwasm_tokenfactory_v1_authority_metadata_proto_goTypes = []interface{}{
+	(DenomRole)(0),                 // 0: miniwasm.tokenfactory.v1.DenomRole
+	(*DenomAuthorityMetadata)(nil), // 1: miniwasm.tokenfactory.v1.DenomAuthorityMetadata
+	(*DenomRoleGrant)(nil),         // 2: miniwasm.tokenfactory.v1.DenomRoleGrant
+	(*MintAllowance)(nil),          // 3: miniwasm.tokenfactory.v1.MintAllowance
+	(*PendingAdminTransfer)(nil),   // 4: miniwasm.tokenfactory.v1.PendingAdminTransfer
+	(*BeforeSendHookFailures)(nil), // 5: miniwasm.tokenfactory.v1.BeforeSendHookFailures
+	(*timestamppb.Timestamp)(nil),  // 6: google.protobuf.Timestamp
+}
+var file_miniwasm_tokenfactory_v1_authority_metadata_proto_depIdxs = []int32{
+	0, // 0: miniwasm.tokenfactory.v1.DenomRoleGrant.role:type_name -> miniwasm.tokenfactory.v1.DenomRole
+	6, // 1: miniwasm.tokenfactory.v1.PendingAdminTransfer.expires_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_miniwasm_tokenfactory_v1_authority_metadata_proto_init() }
+func file_miniwasm_tokenfactory_v1_authority_metadata_proto_init() {
+	if File_miniwasm_tokenfactory_v1_authority_metadata_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenomAuthorityMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenomRoleGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintAllowance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingAdminTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeforeSendHookFailures); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -566,13 +3058,14 @@ func file_miniwasm_tokenfactory_v1_authority_metadata_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_miniwasm_tokenfactory_v1_authority_metadata_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_miniwasm_tokenfactory_v1_authority_metadata_proto_goTypes,
 		DependencyIndexes: file_miniwasm_tokenfactory_v1_authority_metadata_proto_depIdxs,
+		EnumInfos:         file_miniwasm_tokenfactory_v1_authority_metadata_proto_enumTypes,
 		MessageInfos:      file_miniwasm_tokenfactory_v1_authority_metadata_proto_msgTypes,
 	}.Build()
 	File_miniwasm_tokenfactory_v1_authority_metadata_proto = out.File
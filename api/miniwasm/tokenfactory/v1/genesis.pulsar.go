@@ -3,6 +3,7 @@ package tokenfactoryv1
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -586,10 +587,214 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_GenesisDenom_3_list)(nil)
+
+type _GenesisDenom_3_list struct {
+	list *[]string
+}
+
+func (x *_GenesisDenom_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisDenom_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisDenom_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisDenom_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisDenom_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisDenom at list field BeforeSendHooks as it is not of Message kind"))
+}
+
+func (x *_GenesisDenom_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisDenom_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisDenom_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisDenom_5_list)(nil)
+
+type _GenesisDenom_5_list struct {
+	list *[]*DenomRoleGrant
+}
+
+func (x *_GenesisDenom_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisDenom_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisDenom_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomRoleGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisDenom_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomRoleGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisDenom_5_list) AppendMutable() protoreflect.Value {
+	v := new(DenomRoleGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisDenom_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisDenom_5_list) NewElement() protoreflect.Value {
+	v := new(DenomRoleGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisDenom_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisDenom_6_list)(nil)
+
+type _GenesisDenom_6_list struct {
+	list *[]*MintAllowance
+}
+
+func (x *_GenesisDenom_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisDenom_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisDenom_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintAllowance)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisDenom_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintAllowance)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisDenom_6_list) AppendMutable() protoreflect.Value {
+	v := new(MintAllowance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisDenom_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisDenom_6_list) NewElement() protoreflect.Value {
+	v := new(MintAllowance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisDenom_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisDenom_10_list)(nil)
+
+type _GenesisDenom_10_list struct {
+	list *[]string
+}
+
+func (x *_GenesisDenom_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisDenom_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisDenom_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisDenom_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisDenom_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisDenom at list field FrozenAccounts as it is not of Message kind"))
+}
+
+func (x *_GenesisDenom_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisDenom_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisDenom_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisDenom                    protoreflect.MessageDescriptor
-	fd_GenesisDenom_denom              protoreflect.FieldDescriptor
-	fd_GenesisDenom_authority_metadata protoreflect.FieldDescriptor
+	md_GenesisDenom                            protoreflect.MessageDescriptor
+	fd_GenesisDenom_denom                      protoreflect.FieldDescriptor
+	fd_GenesisDenom_authority_metadata         protoreflect.FieldDescriptor
+	fd_GenesisDenom_before_send_hooks          protoreflect.FieldDescriptor
+	fd_GenesisDenom_before_send_hook_gas_limit protoreflect.FieldDescriptor
+	fd_GenesisDenom_roles                      protoreflect.FieldDescriptor
+	fd_GenesisDenom_mint_allowances            protoreflect.FieldDescriptor
+	fd_GenesisDenom_supply_cap                 protoreflect.FieldDescriptor
+	fd_GenesisDenom_pending_admin_transfer     protoreflect.FieldDescriptor
+	fd_GenesisDenom_paused                     protoreflect.FieldDescriptor
+	fd_GenesisDenom_frozen_accounts            protoreflect.FieldDescriptor
+	fd_GenesisDenom_strict_mode                protoreflect.FieldDescriptor
+	fd_GenesisDenom_before_send_hook_failures  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -597,6 +802,16 @@ func init() {
 	md_GenesisDenom = File_miniwasm_tokenfactory_v1_genesis_proto.Messages().ByName("GenesisDenom")
 	fd_GenesisDenom_denom = md_GenesisDenom.Fields().ByName("denom")
 	fd_GenesisDenom_authority_metadata = md_GenesisDenom.Fields().ByName("authority_metadata")
+	fd_GenesisDenom_before_send_hooks = md_GenesisDenom.Fields().ByName("before_send_hooks")
+	fd_GenesisDenom_before_send_hook_gas_limit = md_GenesisDenom.Fields().ByName("before_send_hook_gas_limit")
+	fd_GenesisDenom_roles = md_GenesisDenom.Fields().ByName("roles")
+	fd_GenesisDenom_mint_allowances = md_GenesisDenom.Fields().ByName("mint_allowances")
+	fd_GenesisDenom_supply_cap = md_GenesisDenom.Fields().ByName("supply_cap")
+	fd_GenesisDenom_pending_admin_transfer = md_GenesisDenom.Fields().ByName("pending_admin_transfer")
+	fd_GenesisDenom_paused = md_GenesisDenom.Fields().ByName("paused")
+	fd_GenesisDenom_frozen_accounts = md_GenesisDenom.Fields().ByName("frozen_accounts")
+	fd_GenesisDenom_strict_mode = md_GenesisDenom.Fields().ByName("strict_mode")
+	fd_GenesisDenom_before_send_hook_failures = md_GenesisDenom.Fields().ByName("before_send_hook_failures")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisDenom)(nil)
@@ -676,6 +891,66 @@ func (x *fastReflection_GenesisDenom) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.BeforeSendHooks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisDenom_3_list{list: &x.BeforeSendHooks})
+		if !f(fd_GenesisDenom_before_send_hooks, value) {
+			return
+		}
+	}
+	if x.BeforeSendHookGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BeforeSendHookGasLimit)
+		if !f(fd_GenesisDenom_before_send_hook_gas_limit, value) {
+			return
+		}
+	}
+	if len(x.Roles) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisDenom_5_list{list: &x.Roles})
+		if !f(fd_GenesisDenom_roles, value) {
+			return
+		}
+	}
+	if len(x.MintAllowances) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisDenom_6_list{list: &x.MintAllowances})
+		if !f(fd_GenesisDenom_mint_allowances, value) {
+			return
+		}
+	}
+	if x.SupplyCap != "" {
+		value := protoreflect.ValueOfString(x.SupplyCap)
+		if !f(fd_GenesisDenom_supply_cap, value) {
+			return
+		}
+	}
+	if x.PendingAdminTransfer != nil {
+		value := protoreflect.ValueOfMessage(x.PendingAdminTransfer.ProtoReflect())
+		if !f(fd_GenesisDenom_pending_admin_transfer, value) {
+			return
+		}
+	}
+	if x.Paused != false {
+		value := protoreflect.ValueOfBool(x.Paused)
+		if !f(fd_GenesisDenom_paused, value) {
+			return
+		}
+	}
+	if len(x.FrozenAccounts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisDenom_10_list{list: &x.FrozenAccounts})
+		if !f(fd_GenesisDenom_frozen_accounts, value) {
+			return
+		}
+	}
+	if x.StrictMode != false {
+		value := protoreflect.ValueOfBool(x.StrictMode)
+		if !f(fd_GenesisDenom_strict_mode, value) {
+			return
+		}
+	}
+	if x.BeforeSendHookFailures != nil {
+		value := protoreflect.ValueOfMessage(x.BeforeSendHookFailures.ProtoReflect())
+		if !f(fd_GenesisDenom_before_send_hook_failures, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -695,6 +970,26 @@ func (x *fastReflection_GenesisDenom) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Denom != ""
 	case "miniwasm.tokenfactory.v1.GenesisDenom.authority_metadata":
 		return x.AuthorityMetadata != nil
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hooks":
+		return len(x.BeforeSendHooks) != 0
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hook_gas_limit":
+		return x.BeforeSendHookGasLimit != uint64(0)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.roles":
+		return len(x.Roles) != 0
+	case "miniwasm.tokenfactory.v1.GenesisDenom.mint_allowances":
+		return len(x.MintAllowances) != 0
+	case "miniwasm.tokenfactory.v1.GenesisDenom.supply_cap":
+		return x.SupplyCap != ""
+	case "miniwasm.tokenfactory.v1.GenesisDenom.pending_admin_transfer":
+		return x.PendingAdminTransfer != nil
+	case "miniwasm.tokenfactory.v1.GenesisDenom.paused":
+		return x.Paused != false
+	case "miniwasm.tokenfactory.v1.GenesisDenom.frozen_accounts":
+		return len(x.FrozenAccounts) != 0
+	case "miniwasm.tokenfactory.v1.GenesisDenom.strict_mode":
+		return x.StrictMode != false
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hook_failures":
+		return x.BeforeSendHookFailures != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.GenesisDenom"))
@@ -715,6 +1010,26 @@ func (x *fastReflection_GenesisDenom) Clear(fd protoreflect.FieldDescriptor) {
 		x.Denom = ""
 	case "miniwasm.tokenfactory.v1.GenesisDenom.authority_metadata":
 		x.AuthorityMetadata = nil
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hooks":
+		x.BeforeSendHooks = nil
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hook_gas_limit":
+		x.BeforeSendHookGasLimit = uint64(0)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.roles":
+		x.Roles = nil
+	case "miniwasm.tokenfactory.v1.GenesisDenom.mint_allowances":
+		x.MintAllowances = nil
+	case "miniwasm.tokenfactory.v1.GenesisDenom.supply_cap":
+		x.SupplyCap = ""
+	case "miniwasm.tokenfactory.v1.GenesisDenom.pending_admin_transfer":
+		x.PendingAdminTransfer = nil
+	case "miniwasm.tokenfactory.v1.GenesisDenom.paused":
+		x.Paused = false
+	case "miniwasm.tokenfactory.v1.GenesisDenom.frozen_accounts":
+		x.FrozenAccounts = nil
+	case "miniwasm.tokenfactory.v1.GenesisDenom.strict_mode":
+		x.StrictMode = false
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hook_failures":
+		x.BeforeSendHookFailures = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.GenesisDenom"))
@@ -737,6 +1052,48 @@ func (x *fastReflection_GenesisDenom) Get(descriptor protoreflect.FieldDescripto
 	case "miniwasm.tokenfactory.v1.GenesisDenom.authority_metadata":
 		value := x.AuthorityMetadata
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hooks":
+		if len(x.BeforeSendHooks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisDenom_3_list{})
+		}
+		listValue := &_GenesisDenom_3_list{list: &x.BeforeSendHooks}
+		return protoreflect.ValueOfList(listValue)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hook_gas_limit":
+		value := x.BeforeSendHookGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.roles":
+		if len(x.Roles) == 0 {
+			return protoreflect.ValueOfList(&_GenesisDenom_5_list{})
+		}
+		listValue := &_GenesisDenom_5_list{list: &x.Roles}
+		return protoreflect.ValueOfList(listValue)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.mint_allowances":
+		if len(x.MintAllowances) == 0 {
+			return protoreflect.ValueOfList(&_GenesisDenom_6_list{})
+		}
+		listValue := &_GenesisDenom_6_list{list: &x.MintAllowances}
+		return protoreflect.ValueOfList(listValue)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.supply_cap":
+		value := x.SupplyCap
+		return protoreflect.ValueOfString(value)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.pending_admin_transfer":
+		value := x.PendingAdminTransfer
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "miniwasm.tokenfactory.v1.GenesisDenom.paused":
+		value := x.Paused
+		return protoreflect.ValueOfBool(value)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.frozen_accounts":
+		if len(x.FrozenAccounts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisDenom_10_list{})
+		}
+		listValue := &_GenesisDenom_10_list{list: &x.FrozenAccounts}
+		return protoreflect.ValueOfList(listValue)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.strict_mode":
+		value := x.StrictMode
+		return protoreflect.ValueOfBool(value)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hook_failures":
+		value := x.BeforeSendHookFailures
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.GenesisDenom"))
@@ -761,6 +1118,34 @@ func (x *fastReflection_GenesisDenom) Set(fd protoreflect.FieldDescriptor, value
 		x.Denom = value.Interface().(string)
 	case "miniwasm.tokenfactory.v1.GenesisDenom.authority_metadata":
 		x.AuthorityMetadata = value.Message().Interface().(*DenomAuthorityMetadata)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hooks":
+		lv := value.List()
+		clv := lv.(*_GenesisDenom_3_list)
+		x.BeforeSendHooks = *clv.list
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hook_gas_limit":
+		x.BeforeSendHookGasLimit = value.Uint()
+	case "miniwasm.tokenfactory.v1.GenesisDenom.roles":
+		lv := value.List()
+		clv := lv.(*_GenesisDenom_5_list)
+		x.Roles = *clv.list
+	case "miniwasm.tokenfactory.v1.GenesisDenom.mint_allowances":
+		lv := value.List()
+		clv := lv.(*_GenesisDenom_6_list)
+		x.MintAllowances = *clv.list
+	case "miniwasm.tokenfactory.v1.GenesisDenom.supply_cap":
+		x.SupplyCap = value.Interface().(string)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.pending_admin_transfer":
+		x.PendingAdminTransfer = value.Message().Interface().(*PendingAdminTransfer)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.paused":
+		x.Paused = value.Bool()
+	case "miniwasm.tokenfactory.v1.GenesisDenom.frozen_accounts":
+		lv := value.List()
+		clv := lv.(*_GenesisDenom_10_list)
+		x.FrozenAccounts = *clv.list
+	case "miniwasm.tokenfactory.v1.GenesisDenom.strict_mode":
+		x.StrictMode = value.Bool()
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hook_failures":
+		x.BeforeSendHookFailures = value.Message().Interface().(*BeforeSendHookFailures)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.GenesisDenom"))
@@ -786,8 +1171,50 @@ func (x *fastReflection_GenesisDenom) Mutable(fd protoreflect.FieldDescriptor) p
 			x.AuthorityMetadata = new(DenomAuthorityMetadata)
 		}
 		return protoreflect.ValueOfMessage(x.AuthorityMetadata.ProtoReflect())
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hooks":
+		if x.BeforeSendHooks == nil {
+			x.BeforeSendHooks = []string{}
+		}
+		value := &_GenesisDenom_3_list{list: &x.BeforeSendHooks}
+		return protoreflect.ValueOfList(value)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.roles":
+		if x.Roles == nil {
+			x.Roles = []*DenomRoleGrant{}
+		}
+		value := &_GenesisDenom_5_list{list: &x.Roles}
+		return protoreflect.ValueOfList(value)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.mint_allowances":
+		if x.MintAllowances == nil {
+			x.MintAllowances = []*MintAllowance{}
+		}
+		value := &_GenesisDenom_6_list{list: &x.MintAllowances}
+		return protoreflect.ValueOfList(value)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.pending_admin_transfer":
+		if x.PendingAdminTransfer == nil {
+			x.PendingAdminTransfer = new(PendingAdminTransfer)
+		}
+		return protoreflect.ValueOfMessage(x.PendingAdminTransfer.ProtoReflect())
+	case "miniwasm.tokenfactory.v1.GenesisDenom.frozen_accounts":
+		if x.FrozenAccounts == nil {
+			x.FrozenAccounts = []string{}
+		}
+		value := &_GenesisDenom_10_list{list: &x.FrozenAccounts}
+		return protoreflect.ValueOfList(value)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hook_failures":
+		if x.BeforeSendHookFailures == nil {
+			x.BeforeSendHookFailures = new(BeforeSendHookFailures)
+		}
+		return protoreflect.ValueOfMessage(x.BeforeSendHookFailures.ProtoReflect())
 	case "miniwasm.tokenfactory.v1.GenesisDenom.denom":
 		panic(fmt.Errorf("field denom of message miniwasm.tokenfactory.v1.GenesisDenom is not mutable"))
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hook_gas_limit":
+		panic(fmt.Errorf("field before_send_hook_gas_limit of message miniwasm.tokenfactory.v1.GenesisDenom is not mutable"))
+	case "miniwasm.tokenfactory.v1.GenesisDenom.supply_cap":
+		panic(fmt.Errorf("field supply_cap of message miniwasm.tokenfactory.v1.GenesisDenom is not mutable"))
+	case "miniwasm.tokenfactory.v1.GenesisDenom.paused":
+		panic(fmt.Errorf("field paused of message miniwasm.tokenfactory.v1.GenesisDenom is not mutable"))
+	case "miniwasm.tokenfactory.v1.GenesisDenom.strict_mode":
+		panic(fmt.Errorf("field strict_mode of message miniwasm.tokenfactory.v1.GenesisDenom is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.GenesisDenom"))
@@ -806,6 +1233,32 @@ func (x *fastReflection_GenesisDenom) NewField(fd protoreflect.FieldDescriptor)
 	case "miniwasm.tokenfactory.v1.GenesisDenom.authority_metadata":
 		m := new(DenomAuthorityMetadata)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hooks":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisDenom_3_list{list: &list})
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hook_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "miniwasm.tokenfactory.v1.GenesisDenom.roles":
+		list := []*DenomRoleGrant{}
+		return protoreflect.ValueOfList(&_GenesisDenom_5_list{list: &list})
+	case "miniwasm.tokenfactory.v1.GenesisDenom.mint_allowances":
+		list := []*MintAllowance{}
+		return protoreflect.ValueOfList(&_GenesisDenom_6_list{list: &list})
+	case "miniwasm.tokenfactory.v1.GenesisDenom.supply_cap":
+		return protoreflect.ValueOfString("")
+	case "miniwasm.tokenfactory.v1.GenesisDenom.pending_admin_transfer":
+		m := new(PendingAdminTransfer)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "miniwasm.tokenfactory.v1.GenesisDenom.paused":
+		return protoreflect.ValueOfBool(false)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.frozen_accounts":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisDenom_10_list{list: &list})
+	case "miniwasm.tokenfactory.v1.GenesisDenom.strict_mode":
+		return protoreflect.ValueOfBool(false)
+	case "miniwasm.tokenfactory.v1.GenesisDenom.before_send_hook_failures":
+		m := new(BeforeSendHookFailures)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.GenesisDenom"))
@@ -883,6 +1336,51 @@ func (x *fastReflection_GenesisDenom) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.AuthorityMetadata)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.BeforeSendHooks) > 0 {
+			for _, s := range x.BeforeSendHooks {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BeforeSendHookGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.BeforeSendHookGasLimit))
+		}
+		if len(x.Roles) > 0 {
+			for _, e := range x.Roles {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MintAllowances) > 0 {
+			for _, e := range x.MintAllowances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.SupplyCap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingAdminTransfer != nil {
+			l = options.Size(x.PendingAdminTransfer)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Paused {
+			n += 2
+		}
+		if len(x.FrozenAccounts) > 0 {
+			for _, s := range x.FrozenAccounts {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.StrictMode {
+			n += 2
+		}
+		if x.BeforeSendHookFailures != nil {
+			l = options.Size(x.BeforeSendHookFailures)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -912,8 +1410,8 @@ func (x *fastReflection_GenesisDenom) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.AuthorityMetadata != nil {
-			encoded, err := options.Marshal(x.AuthorityMetadata)
+		if x.BeforeSendHookFailures != nil {
+			encoded, err := options.Marshal(x.BeforeSendHookFailures)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -924,50 +1422,160 @@ func (x *fastReflection_GenesisDenom) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x62
 		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if x.StrictMode {
 			i--
-			dAtA[i] = 0xa
+			if x.StrictMode {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if len(x.FrozenAccounts) > 0 {
+			for iNdEx := len(x.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.FrozenAccounts[iNdEx])
+				copy(dAtA[i:], x.FrozenAccounts[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FrozenAccounts[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisDenom)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+		if x.Paused {
+			i--
+			if x.Paused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
 		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
+		if x.PendingAdminTransfer != nil {
+			encoded, err := options.Marshal(x.PendingAdminTransfer)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.SupplyCap) > 0 {
+			i -= len(x.SupplyCap)
+			copy(dAtA[i:], x.SupplyCap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SupplyCap)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.MintAllowances) > 0 {
+			for iNdEx := len(x.MintAllowances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintAllowances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Roles) > 0 {
+			for iNdEx := len(x.Roles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Roles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.BeforeSendHookGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BeforeSendHookGasLimit))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.BeforeSendHooks) > 0 {
+			for iNdEx := len(x.BeforeSendHooks) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BeforeSendHooks[iNdEx])
+				copy(dAtA[i:], x.BeforeSendHooks[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BeforeSendHooks[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.AuthorityMetadata != nil {
+			encoded, err := options.Marshal(x.AuthorityMetadata)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisDenom)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
 				wire |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
@@ -1050,6 +1658,301 @@ func (x *fastReflection_GenesisDenom) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHooks", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BeforeSendHooks = append(x.BeforeSendHooks, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookGasLimit", wireType)
+				}
+				x.BeforeSendHookGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BeforeSendHookGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Roles = append(x.Roles, &DenomRoleGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Roles[len(x.Roles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintAllowances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintAllowances = append(x.MintAllowances, &MintAllowance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintAllowances[len(x.MintAllowances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SupplyCap = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingAdminTransfer", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PendingAdminTransfer == nil {
+					x.PendingAdminTransfer = &PendingAdminTransfer{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingAdminTransfer); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Paused = bool(v != 0)
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FrozenAccounts = append(x.FrozenAccounts, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StrictMode", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.StrictMode = bool(v != 0)
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookFailures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BeforeSendHookFailures == nil {
+					x.BeforeSendHookFailures = &BeforeSendHookFailures{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BeforeSendHookFailures); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1153,6 +2056,29 @@ type GenesisDenom struct {
 
 	Denom             string                  `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	AuthorityMetadata *DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata,omitempty"`
+	// before_send_hooks are the ordered before send hook contracts of the denom.
+	BeforeSendHooks []string `protobuf:"bytes,3,rep,name=before_send_hooks,json=beforeSendHooks,proto3" json:"before_send_hooks,omitempty"`
+	// before_send_hook_gas_limit is the per-denom gas limit of a hook call,
+	// zero uses the default gas limit.
+	BeforeSendHookGasLimit uint64 `protobuf:"varint,4,opt,name=before_send_hook_gas_limit,json=beforeSendHookGasLimit,proto3" json:"before_send_hook_gas_limit,omitempty"`
+	// roles are the roles granted for the denom.
+	Roles []*DenomRoleGrant `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
+	// mint_allowances are the mint allowances of the limited minters.
+	MintAllowances []*MintAllowance `protobuf:"bytes,6,rep,name=mint_allowances,json=mintAllowances,proto3" json:"mint_allowances,omitempty"`
+	// supply_cap is the max supply of the denom, unset when uncapped.
+	SupplyCap string `protobuf:"bytes,7,opt,name=supply_cap,json=supplyCap,proto3" json:"supply_cap,omitempty"`
+	// pending_admin_transfer is the pending admin transfer of the denom.
+	PendingAdminTransfer *PendingAdminTransfer `protobuf:"bytes,8,opt,name=pending_admin_transfer,json=pendingAdminTransfer,proto3" json:"pending_admin_transfer,omitempty"`
+	// paused is true when the transfers of the denom are paused.
+	Paused bool `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty"`
+	// frozen_accounts are the accounts which cannot send or receive the denom.
+	FrozenAccounts []string `protobuf:"bytes,10,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts,omitempty"`
+	// strict_mode is true when the module to module sends of the denom go
+	// through the blocking checks.
+	StrictMode bool `protobuf:"varint,11,opt,name=strict_mode,json=strictMode,proto3" json:"strict_mode,omitempty"`
+	// before_send_hook_failures is the failure counter of the before send
+	// hooks, unset when no hook call failed.
+	BeforeSendHookFailures *BeforeSendHookFailures `protobuf:"bytes,12,opt,name=before_send_hook_failures,json=beforeSendHookFailures,proto3" json:"before_send_hook_failures,omitempty"`
 }
 
 func (x *GenesisDenom) Reset() {
@@ -1189,6 +2115,76 @@ func (x *GenesisDenom) GetAuthorityMetadata() *DenomAuthorityMetadata {
 	return nil
 }
 
+func (x *GenesisDenom) GetBeforeSendHooks() []string {
+	if x != nil {
+		return x.BeforeSendHooks
+	}
+	return nil
+}
+
+func (x *GenesisDenom) GetBeforeSendHookGasLimit() uint64 {
+	if x != nil {
+		return x.BeforeSendHookGasLimit
+	}
+	return 0
+}
+
+func (x *GenesisDenom) GetRoles() []*DenomRoleGrant {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *GenesisDenom) GetMintAllowances() []*MintAllowance {
+	if x != nil {
+		return x.MintAllowances
+	}
+	return nil
+}
+
+func (x *GenesisDenom) GetSupplyCap() string {
+	if x != nil {
+		return x.SupplyCap
+	}
+	return ""
+}
+
+func (x *GenesisDenom) GetPendingAdminTransfer() *PendingAdminTransfer {
+	if x != nil {
+		return x.PendingAdminTransfer
+	}
+	return nil
+}
+
+func (x *GenesisDenom) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GenesisDenom) GetFrozenAccounts() []string {
+	if x != nil {
+		return x.FrozenAccounts
+	}
+	return nil
+}
+
+func (x *GenesisDenom) GetStrictMode() bool {
+	if x != nil {
+		return x.StrictMode
+	}
+	return false
+}
+
+func (x *GenesisDenom) GetBeforeSendHookFailures() *BeforeSendHookFailures {
+	if x != nil {
+		return x.BeforeSendHookFailures
+	}
+	return nil
+}
+
 var File_miniwasm_tokenfactory_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_miniwasm_tokenfactory_v1_genesis_proto_rawDesc = []byte{
@@ -1196,54 +2192,114 @@ var file_miniwasm_tokenfactory_v1_genesis_proto_rawDesc = []byte{
 	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73,
 	0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61,
 	0x73, 0x6d, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x31, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61,
-	0x73, 0x6d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f,
-	0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x6d, 0x69, 0x6e,
-	0x69, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xbc, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x6c, 0x0a, 0x0e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x64,
-	0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x69,
-	0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x44, 0x65,
-	0x6e, 0x6f, 0x6d, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x73, 0x22, 0x52, 0x0d, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
-	0x73, 0x22, 0xc1, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x44, 0x65, 0x6e,
-	0x6f, 0x6d, 0x12, 0x26, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x22, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61,
+	0x76, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67,
+	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x31, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d,
+	0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x01,
+	0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3e,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x6c,
+	0x0a, 0x0e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73,
+	0x6d, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x1d,
+	0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x22, 0x52, 0x0d, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x22, 0xde, 0x08, 0x0a,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x26, 0x0a,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xf2, 0xde,
+	0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x42, 0x21, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x22, 0x52, 0x0f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x48,
+	0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x61, 0x0a, 0x1a, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x52,
+	0x16, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x47,
+	0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x54, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73,
+	0x6d, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x42, 0x14, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x70, 0x0a,
+	0x0f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73,
+	0x6d, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x42,
+	0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d,
+	0x69, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x52,
+	0x0e, 0x6d, 0x69, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x5f, 0x0a, 0x0a, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x40, 0xc8, 0xde, 0x1f, 0x01, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x5f, 0x63, 0x61, 0x70, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x61, 0x70,
+	0x12, 0x87, 0x01, 0x0a, 0x16, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x22, 0x52, 0x14, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x52, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1a,
+	0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x0e, 0x66, 0x72, 0x6f, 0x7a,
+	0x65, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x19, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61,
 	0x73, 0x6d, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x21, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
-	0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x52, 0x11, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a,
-	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xfb, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69,
-	0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d,
-	0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6e, 0x69,
-	0x77, 0x61, 0x73, 0x6d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x54, 0x58, 0xaa, 0x02, 0x18, 0x4d, 0x69, 0x6e, 0x69,
-	0x77, 0x61, 0x73, 0x6d, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x5c,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x24, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73,
-	0x6d, 0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x31, 0x2e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x48, 0x6f, 0x6f,
+	0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x42, 0x24, 0xf2, 0xde, 0x1f, 0x20, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x52,
+	0x16, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xfb, 0x01,
+	0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0c,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x54,
+	0x58, 0xaa, 0x02, 0x18, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x4d,
+	0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61,
+	0x73, 0x6d, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x1a, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1264,16 +2320,24 @@ var file_miniwasm_tokenfactory_v1_genesis_proto_goTypes = []interface{}{
 	(*GenesisDenom)(nil),           // 1: miniwasm.tokenfactory.v1.GenesisDenom
 	(*Params)(nil),                 // 2: miniwasm.tokenfactory.v1.Params
 	(*DenomAuthorityMetadata)(nil), // 3: miniwasm.tokenfactory.v1.DenomAuthorityMetadata
+	(*DenomRoleGrant)(nil),         // 4: miniwasm.tokenfactory.v1.DenomRoleGrant
+	(*MintAllowance)(nil),          // 5: miniwasm.tokenfactory.v1.MintAllowance
+	(*PendingAdminTransfer)(nil),   // 6: miniwasm.tokenfactory.v1.PendingAdminTransfer
+	(*BeforeSendHookFailures)(nil), // 7: miniwasm.tokenfactory.v1.BeforeSendHookFailures
 }
 var file_miniwasm_tokenfactory_v1_genesis_proto_depIdxs = []int32{
 	2, // 0: miniwasm.tokenfactory.v1.GenesisState.params:type_name -> miniwasm.tokenfactory.v1.Params
 	1, // 1: miniwasm.tokenfactory.v1.GenesisState.factory_denoms:type_name -> miniwasm.tokenfactory.v1.GenesisDenom
 	3, // 2: miniwasm.tokenfactory.v1.GenesisDenom.authority_metadata:type_name -> miniwasm.tokenfactory.v1.DenomAuthorityMetadata
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: miniwasm.tokenfactory.v1.GenesisDenom.roles:type_name -> miniwasm.tokenfactory.v1.DenomRoleGrant
+	5, // 4: miniwasm.tokenfactory.v1.GenesisDenom.mint_allowances:type_name -> miniwasm.tokenfactory.v1.MintAllowance
+	6, // 5: miniwasm.tokenfactory.v1.GenesisDenom.pending_admin_transfer:type_name -> miniwasm.tokenfactory.v1.PendingAdminTransfer
+	7, // 6: miniwasm.tokenfactory.v1.GenesisDenom.before_send_hook_failures:type_name -> miniwasm.tokenfactory.v1.BeforeSendHookFailures
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_miniwasm_tokenfactory_v1_genesis_proto_init() }
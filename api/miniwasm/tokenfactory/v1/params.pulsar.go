@@ -4,6 +4,7 @@ package tokenfactoryv1
 import (
 	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -66,9 +67,13 @@ func (x *_Params_1_list) IsValid() bool {
 }
 
 var (
-	md_Params                            protoreflect.MessageDescriptor
-	fd_Params_denom_creation_fee         protoreflect.FieldDescriptor
-	fd_Params_denom_creation_gas_consume protoreflect.FieldDescriptor
+	md_Params                                protoreflect.MessageDescriptor
+	fd_Params_denom_creation_fee             protoreflect.FieldDescriptor
+	fd_Params_denom_creation_gas_consume     protoreflect.FieldDescriptor
+	fd_Params_max_before_send_hook_gas_limit protoreflect.FieldDescriptor
+	fd_Params_denom_creation_fee_destination protoreflect.FieldDescriptor
+	fd_Params_denom_creation_fee_recipient   protoreflect.FieldDescriptor
+	fd_Params_max_denoms_per_creator         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -76,6 +81,10 @@ func init() {
 	md_Params = File_miniwasm_tokenfactory_v1_params_proto.Messages().ByName("Params")
 	fd_Params_denom_creation_fee = md_Params.Fields().ByName("denom_creation_fee")
 	fd_Params_denom_creation_gas_consume = md_Params.Fields().ByName("denom_creation_gas_consume")
+	fd_Params_max_before_send_hook_gas_limit = md_Params.Fields().ByName("max_before_send_hook_gas_limit")
+	fd_Params_denom_creation_fee_destination = md_Params.Fields().ByName("denom_creation_fee_destination")
+	fd_Params_denom_creation_fee_recipient = md_Params.Fields().ByName("denom_creation_fee_recipient")
+	fd_Params_max_denoms_per_creator = md_Params.Fields().ByName("max_denoms_per_creator")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -155,6 +164,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxBeforeSendHookGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxBeforeSendHookGasLimit)
+		if !f(fd_Params_max_before_send_hook_gas_limit, value) {
+			return
+		}
+	}
+	if x.DenomCreationFeeDestination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.DenomCreationFeeDestination))
+		if !f(fd_Params_denom_creation_fee_destination, value) {
+			return
+		}
+	}
+	if x.DenomCreationFeeRecipient != "" {
+		value := protoreflect.ValueOfString(x.DenomCreationFeeRecipient)
+		if !f(fd_Params_denom_creation_fee_recipient, value) {
+			return
+		}
+	}
+	if x.MaxDenomsPerCreator != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxDenomsPerCreator)
+		if !f(fd_Params_max_denoms_per_creator, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -174,6 +207,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.DenomCreationFee) != 0
 	case "miniwasm.tokenfactory.v1.Params.denom_creation_gas_consume":
 		return x.DenomCreationGasConsume != uint64(0)
+	case "miniwasm.tokenfactory.v1.Params.max_before_send_hook_gas_limit":
+		return x.MaxBeforeSendHookGasLimit != uint64(0)
+	case "miniwasm.tokenfactory.v1.Params.denom_creation_fee_destination":
+		return x.DenomCreationFeeDestination != 0
+	case "miniwasm.tokenfactory.v1.Params.denom_creation_fee_recipient":
+		return x.DenomCreationFeeRecipient != ""
+	case "miniwasm.tokenfactory.v1.Params.max_denoms_per_creator":
+		return x.MaxDenomsPerCreator != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.Params"))
@@ -194,6 +235,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.DenomCreationFee = nil
 	case "miniwasm.tokenfactory.v1.Params.denom_creation_gas_consume":
 		x.DenomCreationGasConsume = uint64(0)
+	case "miniwasm.tokenfactory.v1.Params.max_before_send_hook_gas_limit":
+		x.MaxBeforeSendHookGasLimit = uint64(0)
+	case "miniwasm.tokenfactory.v1.Params.denom_creation_fee_destination":
+		x.DenomCreationFeeDestination = 0
+	case "miniwasm.tokenfactory.v1.Params.denom_creation_fee_recipient":
+		x.DenomCreationFeeRecipient = ""
+	case "miniwasm.tokenfactory.v1.Params.max_denoms_per_creator":
+		x.MaxDenomsPerCreator = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.Params"))
@@ -219,6 +268,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "miniwasm.tokenfactory.v1.Params.denom_creation_gas_consume":
 		value := x.DenomCreationGasConsume
 		return protoreflect.ValueOfUint64(value)
+	case "miniwasm.tokenfactory.v1.Params.max_before_send_hook_gas_limit":
+		value := x.MaxBeforeSendHookGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "miniwasm.tokenfactory.v1.Params.denom_creation_fee_destination":
+		value := x.DenomCreationFeeDestination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "miniwasm.tokenfactory.v1.Params.denom_creation_fee_recipient":
+		value := x.DenomCreationFeeRecipient
+		return protoreflect.ValueOfString(value)
+	case "miniwasm.tokenfactory.v1.Params.max_denoms_per_creator":
+		value := x.MaxDenomsPerCreator
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.Params"))
@@ -245,6 +306,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.DenomCreationFee = *clv.list
 	case "miniwasm.tokenfactory.v1.Params.denom_creation_gas_consume":
 		x.DenomCreationGasConsume = value.Uint()
+	case "miniwasm.tokenfactory.v1.Params.max_before_send_hook_gas_limit":
+		x.MaxBeforeSendHookGasLimit = value.Uint()
+	case "miniwasm.tokenfactory.v1.Params.denom_creation_fee_destination":
+		x.DenomCreationFeeDestination = (FeeDestination)(value.Enum())
+	case "miniwasm.tokenfactory.v1.Params.denom_creation_fee_recipient":
+		x.DenomCreationFeeRecipient = value.Interface().(string)
+	case "miniwasm.tokenfactory.v1.Params.max_denoms_per_creator":
+		x.MaxDenomsPerCreator = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.Params"))
@@ -273,6 +342,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfList(value)
 	case "miniwasm.tokenfactory.v1.Params.denom_creation_gas_consume":
 		panic(fmt.Errorf("field denom_creation_gas_consume of message miniwasm.tokenfactory.v1.Params is not mutable"))
+	case "miniwasm.tokenfactory.v1.Params.max_before_send_hook_gas_limit":
+		panic(fmt.Errorf("field max_before_send_hook_gas_limit of message miniwasm.tokenfactory.v1.Params is not mutable"))
+	case "miniwasm.tokenfactory.v1.Params.denom_creation_fee_destination":
+		panic(fmt.Errorf("field denom_creation_fee_destination of message miniwasm.tokenfactory.v1.Params is not mutable"))
+	case "miniwasm.tokenfactory.v1.Params.denom_creation_fee_recipient":
+		panic(fmt.Errorf("field denom_creation_fee_recipient of message miniwasm.tokenfactory.v1.Params is not mutable"))
+	case "miniwasm.tokenfactory.v1.Params.max_denoms_per_creator":
+		panic(fmt.Errorf("field max_denoms_per_creator of message miniwasm.tokenfactory.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.Params"))
@@ -291,6 +368,14 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
 	case "miniwasm.tokenfactory.v1.Params.denom_creation_gas_consume":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "miniwasm.tokenfactory.v1.Params.max_before_send_hook_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "miniwasm.tokenfactory.v1.Params.denom_creation_fee_destination":
+		return protoreflect.ValueOfEnum(0)
+	case "miniwasm.tokenfactory.v1.Params.denom_creation_fee_recipient":
+		return protoreflect.ValueOfString("")
+	case "miniwasm.tokenfactory.v1.Params.max_denoms_per_creator":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.tokenfactory.v1.Params"))
@@ -369,6 +454,19 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.DenomCreationGasConsume != 0 {
 			n += 1 + runtime.Sov(uint64(x.DenomCreationGasConsume))
 		}
+		if x.MaxBeforeSendHookGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBeforeSendHookGasLimit))
+		}
+		if x.DenomCreationFeeDestination != 0 {
+			n += 1 + runtime.Sov(uint64(x.DenomCreationFeeDestination))
+		}
+		l = len(x.DenomCreationFeeRecipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxDenomsPerCreator != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxDenomsPerCreator))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -398,6 +496,28 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxDenomsPerCreator != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxDenomsPerCreator))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.DenomCreationFeeRecipient) > 0 {
+			i -= len(x.DenomCreationFeeRecipient)
+			copy(dAtA[i:], x.DenomCreationFeeRecipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DenomCreationFeeRecipient)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.DenomCreationFeeDestination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DenomCreationFeeDestination))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxBeforeSendHookGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBeforeSendHookGasLimit))
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.DenomCreationGasConsume != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.DenomCreationGasConsume))
 			i--
@@ -521,6 +641,95 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBeforeSendHookGasLimit", wireType)
+				}
+				x.MaxBeforeSendHookGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBeforeSendHookGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFeeDestination", wireType)
+				}
+				x.DenomCreationFeeDestination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DenomCreationFeeDestination |= FeeDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFeeRecipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomCreationFeeRecipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDenomsPerCreator", wireType)
+				}
+				x.MaxDenomsPerCreator = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxDenomsPerCreator |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -569,6 +778,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// FeeDestination defines where the denom creation fee is sent.
+type FeeDestination int32
+
+const (
+	// FEE_DESTINATION_FEE_COLLECTOR sends the fee to the fee collector.
+	FeeDestination_FEE_DESTINATION_FEE_COLLECTOR FeeDestination = 0
+	// FEE_DESTINATION_BURN burns the fee.
+	FeeDestination_FEE_DESTINATION_BURN FeeDestination = 1
+	// FEE_DESTINATION_ADDRESS sends the fee to denom_creation_fee_recipient.
+	FeeDestination_FEE_DESTINATION_ADDRESS FeeDestination = 2
+)
+
+// Enum value maps for FeeDestination.
+var (
+	FeeDestination_name = map[int32]string{
+		0: "FEE_DESTINATION_FEE_COLLECTOR",
+		1: "FEE_DESTINATION_BURN",
+		2: "FEE_DESTINATION_ADDRESS",
+	}
+	FeeDestination_value = map[string]int32{
+		"FEE_DESTINATION_FEE_COLLECTOR": 0,
+		"FEE_DESTINATION_BURN":          1,
+		"FEE_DESTINATION_ADDRESS":       2,
+	}
+)
+
+func (x FeeDestination) Enum() *FeeDestination {
+	p := new(FeeDestination)
+	*p = x
+	return p
+}
+
+func (x FeeDestination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeeDestination) Descriptor() protoreflect.EnumDescriptor {
+	return file_miniwasm_tokenfactory_v1_params_proto_enumTypes[0].Descriptor()
+}
+
+func (FeeDestination) Type() protoreflect.EnumType {
+	return &file_miniwasm_tokenfactory_v1_params_proto_enumTypes[0]
+}
+
+func (x FeeDestination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeeDestination.Descriptor instead.
+func (FeeDestination) EnumDescriptor() ([]byte, []int) {
+	return file_miniwasm_tokenfactory_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
 // Params defines the parameters for the tokenfactory module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -584,6 +846,19 @@ type Params struct {
 	//
 	// See: https://github.com/CosmWasm/token-factory/issues/11
 	DenomCreationGasConsume uint64 `protobuf:"varint,2,opt,name=denom_creation_gas_consume,json=denomCreationGasConsume,proto3" json:"denom_creation_gas_consume,omitempty"`
+	// MaxBeforeSendHookGasLimit defines the upper bound of the gas limit a denom
+	// admin can set for the before send hook of a denom. Zero disables per-denom
+	// gas limits.
+	MaxBeforeSendHookGasLimit uint64 `protobuf:"varint,3,opt,name=max_before_send_hook_gas_limit,json=maxBeforeSendHookGasLimit,proto3" json:"max_before_send_hook_gas_limit,omitempty"`
+	// DenomCreationFeeDestination defines where the denom creation fee goes.
+	DenomCreationFeeDestination FeeDestination `protobuf:"varint,4,opt,name=denom_creation_fee_destination,json=denomCreationFeeDestination,proto3,enum=miniwasm.tokenfactory.v1.FeeDestination" json:"denom_creation_fee_destination,omitempty"`
+	// DenomCreationFeeRecipient defines the address receiving the denom
+	// creation fee when the destination is FEE_DESTINATION_ADDRESS.
+	DenomCreationFeeRecipient string `protobuf:"bytes,5,opt,name=denom_creation_fee_recipient,json=denomCreationFeeRecipient,proto3" json:"denom_creation_fee_recipient,omitempty"`
+	// MaxDenomsPerCreator defines the maximum number of denoms an account can
+	// create. Zero means no limit. Accounts on the opchild fee whitelist are
+	// exempted from the limit and the denom creation fee.
+	MaxDenomsPerCreator uint64 `protobuf:"varint,6,opt,name=max_denoms_per_creator,json=maxDenomsPerCreator,proto3" json:"max_denoms_per_creator,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -620,6 +895,34 @@ func (x *Params) GetDenomCreationGasConsume() uint64 {
 	return 0
 }
 
+func (x *Params) GetMaxBeforeSendHookGasLimit() uint64 {
+	if x != nil {
+		return x.MaxBeforeSendHookGasLimit
+	}
+	return 0
+}
+
+func (x *Params) GetDenomCreationFeeDestination() FeeDestination {
+	if x != nil {
+		return x.DenomCreationFeeDestination
+	}
+	return FeeDestination_FEE_DESTINATION_FEE_COLLECTOR
+}
+
+func (x *Params) GetDenomCreationFeeRecipient() string {
+	if x != nil {
+		return x.DenomCreationFeeRecipient
+	}
+	return ""
+}
+
+func (x *Params) GetMaxDenomsPerCreator() uint64 {
+	if x != nil {
+		return x.MaxDenomsPerCreator
+	}
+	return 0
+}
+
 var File_miniwasm_tokenfactory_v1_params_proto protoreflect.FileDescriptor
 
 var file_miniwasm_tokenfactory_v1_params_proto_rawDesc = []byte{
@@ -629,41 +932,85 @@ var file_miniwasm_tokenfactory_v1_params_proto_rawDesc = []byte{
 	0x6d, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76,
 	0x31, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76,
 	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x96, 0x01, 0x0a, 0x12, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x4d, 0xc8, 0xde, 0x1f, 0x00,
-	0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x22, 0xaa, 0xdf, 0x1f,
-	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x10, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x66, 0x0a, 0x1a, 0x64,
-	0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61,
-	0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x29, 0xc8, 0xde, 0x1f, 0x01, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64,
-	0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61,
-	0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x22, 0x52, 0x17, 0x64, 0x65, 0x6e, 0x6f,
-	0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73,
-	0x75, 0x6d, 0x65, 0x42, 0xfa, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69,
-	0x77, 0x61, 0x73, 0x6d, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6d, 0x69, 0x6e, 0x69,
-	0x77, 0x61, 0x73, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73,
-	0x6d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76,
-	0x31, 0x3b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x4d, 0x54, 0x58, 0xaa, 0x02, 0x18, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73,
-	0x6d, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x18, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x5c, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x4d,
-	0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x3a, 0x3a,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xed, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x96, 0x01,
+	0x0a, 0x12, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x4d, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x19, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x10, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x66, 0x0a, 0x1a, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x29, 0xc8, 0xde, 0x1f, 0x01,
+	0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x22, 0x52, 0x17, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x6c,
+	0x0a, 0x1e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x25, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x6e, 0x64,
+	0x48, 0x6f, 0x6f, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x98, 0x01, 0x0a,
+	0x1e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d,
+	0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x29, 0xf2, 0xde, 0x1f, 0x25, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x1b, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x80, 0x01, 0x0a, 0x1c, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f,
+	0xf2, 0xde, 0x1f, 0x23, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x19, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x16, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x52, 0x13, 0x6d,
+	0x61, 0x78, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x50, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x2a, 0xc3, 0x01, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x1d, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53,
+	0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x43, 0x4f, 0x4c,
+	0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x00, 0x1a, 0x1e, 0x8a, 0x9d, 0x20, 0x1a, 0x46, 0x65,
+	0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x46, 0x45, 0x45, 0x5f,
+	0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x52, 0x4e,
+	0x10, 0x01, 0x1a, 0x16, 0x8a, 0x9d, 0x20, 0x12, 0x46, 0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x46, 0x45,
+	0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x44,
+	0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x1a, 0x19, 0x8a, 0x9d, 0x20, 0x15, 0x46, 0x65, 0x65,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xfa, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69,
+	0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x54, 0x58, 0xaa, 0x02, 0x18, 0x4d, 0x69,
+	0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73,
+	0x6d, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x24, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x5c, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x4d, 0x69, 0x6e, 0x69, 0x77,
+	0x61, 0x73, 0x6d, 0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -678,18 +1025,21 @@ func file_miniwasm_tokenfactory_v1_params_proto_rawDescGZIP() []byte {
 	return file_miniwasm_tokenfactory_v1_params_proto_rawDescData
 }
 
+var file_miniwasm_tokenfactory_v1_params_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_miniwasm_tokenfactory_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_miniwasm_tokenfactory_v1_params_proto_goTypes = []interface{}{
-	(*Params)(nil),       // 0: miniwasm.tokenfactory.v1.Params
-	(*v1beta1.Coin)(nil), // 1: cosmos.base.v1beta1.Coin
+	(FeeDestination)(0),  // 0: miniwasm.tokenfactory.v1.FeeDestination
+	(*Params)(nil),       // 1: miniwasm.tokenfactory.v1.Params
+	(*v1beta1.Coin)(nil), // 2: cosmos.base.v1beta1.Coin
 }
 var file_miniwasm_tokenfactory_v1_params_proto_depIdxs = []int32{
-	1, // 0: miniwasm.tokenfactory.v1.Params.denom_creation_fee:type_name -> cosmos.base.v1beta1.Coin
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: miniwasm.tokenfactory.v1.Params.denom_creation_fee:type_name -> cosmos.base.v1beta1.Coin
+	0, // 1: miniwasm.tokenfactory.v1.Params.denom_creation_fee_destination:type_name -> miniwasm.tokenfactory.v1.FeeDestination
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_miniwasm_tokenfactory_v1_params_proto_init() }
@@ -716,13 +1066,14 @@ func file_miniwasm_tokenfactory_v1_params_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_miniwasm_tokenfactory_v1_params_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_miniwasm_tokenfactory_v1_params_proto_goTypes,
 		DependencyIndexes: file_miniwasm_tokenfactory_v1_params_proto_depIdxs,
+		EnumInfos:         file_miniwasm_tokenfactory_v1_params_proto_enumTypes,
 		MessageInfos:      file_miniwasm_tokenfactory_v1_params_proto_msgTypes,
 	}.Build()
 	File_miniwasm_tokenfactory_v1_params_proto = out.File
@@ -23,6 +23,19 @@ const (
 	Query_DenomAuthorityMetadata_FullMethodName = "/miniwasm.tokenfactory.v1.Query/DenomAuthorityMetadata"
 	Query_DenomsFromCreator_FullMethodName      = "/miniwasm.tokenfactory.v1.Query/DenomsFromCreator"
 	Query_BeforeSendHookAddress_FullMethodName  = "/miniwasm.tokenfactory.v1.Query/BeforeSendHookAddress"
+	Query_DenomRoles_FullMethodName             = "/miniwasm.tokenfactory.v1.Query/DenomRoles"
+	Query_MintAllowance_FullMethodName          = "/miniwasm.tokenfactory.v1.Query/MintAllowance"
+	Query_SupplyCap_FullMethodName              = "/miniwasm.tokenfactory.v1.Query/SupplyCap"
+	Query_AllDenoms_FullMethodName              = "/miniwasm.tokenfactory.v1.Query/AllDenoms"
+	Query_DenomsByAdmin_FullMethodName          = "/miniwasm.tokenfactory.v1.Query/DenomsByAdmin"
+	Query_DenomsByHookContract_FullMethodName   = "/miniwasm.tokenfactory.v1.Query/DenomsByHookContract"
+	Query_DenomPaused_FullMethodName            = "/miniwasm.tokenfactory.v1.Query/DenomPaused"
+	Query_AccountFrozen_FullMethodName          = "/miniwasm.tokenfactory.v1.Query/AccountFrozen"
+	Query_FrozenAccounts_FullMethodName         = "/miniwasm.tokenfactory.v1.Query/FrozenAccounts"
+	Query_DenomStrictMode_FullMethodName        = "/miniwasm.tokenfactory.v1.Query/DenomStrictMode"
+	Query_BeforeSendHookFailures_FullMethodName = "/miniwasm.tokenfactory.v1.Query/BeforeSendHookFailures"
+	Query_PendingAdminTransfer_FullMethodName   = "/miniwasm.tokenfactory.v1.Query/PendingAdminTransfer"
+	Query_BeforeSendHooks_FullMethodName        = "/miniwasm.tokenfactory.v1.Query/BeforeSendHooks"
 )
 
 // QueryClient is the client API for Query service.
@@ -43,6 +56,44 @@ type QueryClient interface {
 	// BeforeSendHookAddress defines a gRPC query method for
 	// getting the address registered for the before send hook.
 	BeforeSendHookAddress(ctx context.Context, in *QueryBeforeSendHookAddressRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookAddressResponse, error)
+	// DenomRoles defines a gRPC query method for fetching the roles granted for
+	// a denom.
+	DenomRoles(ctx context.Context, in *QueryDenomRolesRequest, opts ...grpc.CallOption) (*QueryDenomRolesResponse, error)
+	// MintAllowance defines a gRPC query method for fetching the mint allowance
+	// of a minter.
+	MintAllowance(ctx context.Context, in *QueryMintAllowanceRequest, opts ...grpc.CallOption) (*QueryMintAllowanceResponse, error)
+	// SupplyCap defines a gRPC query method for fetching the max supply of a
+	// denom.
+	SupplyCap(ctx context.Context, in *QuerySupplyCapRequest, opts ...grpc.CallOption) (*QuerySupplyCapResponse, error)
+	// AllDenoms defines a gRPC query method for listing all the factory denoms.
+	AllDenoms(ctx context.Context, in *QueryAllDenomsRequest, opts ...grpc.CallOption) (*QueryAllDenomsResponse, error)
+	// DenomsByAdmin defines a gRPC query method for listing the denoms of which
+	// the given address is the admin.
+	DenomsByAdmin(ctx context.Context, in *QueryDenomsByAdminRequest, opts ...grpc.CallOption) (*QueryDenomsByAdminResponse, error)
+	// DenomsByHookContract defines a gRPC query method for listing the denoms
+	// which have the given contract registered as a before send hook.
+	DenomsByHookContract(ctx context.Context, in *QueryDenomsByHookContractRequest, opts ...grpc.CallOption) (*QueryDenomsByHookContractResponse, error)
+	// DenomPaused defines a gRPC query method for checking whether the transfers
+	// of a denom are paused.
+	DenomPaused(ctx context.Context, in *QueryDenomPausedRequest, opts ...grpc.CallOption) (*QueryDenomPausedResponse, error)
+	// AccountFrozen defines a gRPC query method for checking whether an account
+	// is frozen for a denom.
+	AccountFrozen(ctx context.Context, in *QueryAccountFrozenRequest, opts ...grpc.CallOption) (*QueryAccountFrozenResponse, error)
+	// FrozenAccounts defines a gRPC query method for listing the frozen
+	// accounts of a denom.
+	FrozenAccounts(ctx context.Context, in *QueryFrozenAccountsRequest, opts ...grpc.CallOption) (*QueryFrozenAccountsResponse, error)
+	// DenomStrictMode defines a gRPC query method for checking whether the
+	// module to module sends of a denom go through the blocking checks.
+	DenomStrictMode(ctx context.Context, in *QueryDenomStrictModeRequest, opts ...grpc.CallOption) (*QueryDenomStrictModeResponse, error)
+	// BeforeSendHookFailures defines a gRPC query method for fetching the
+	// failure counter of the before send hooks of a denom.
+	BeforeSendHookFailures(ctx context.Context, in *QueryBeforeSendHookFailuresRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookFailuresResponse, error)
+	// PendingAdminTransfer defines a gRPC query method for fetching the pending
+	// admin transfer of a denom.
+	PendingAdminTransfer(ctx context.Context, in *QueryPendingAdminTransferRequest, opts ...grpc.CallOption) (*QueryPendingAdminTransferResponse, error)
+	// BeforeSendHooks defines a gRPC query method for getting the ordered list
+	// of addresses registered for the before send hooks.
+	BeforeSendHooks(ctx context.Context, in *QueryBeforeSendHooksRequest, opts ...grpc.CallOption) (*QueryBeforeSendHooksResponse, error)
 }
 
 type queryClient struct {
@@ -93,6 +144,136 @@ func (c *queryClient) BeforeSendHookAddress(ctx context.Context, in *QueryBefore
 	return out, nil
 }
 
+func (c *queryClient) DenomRoles(ctx context.Context, in *QueryDenomRolesRequest, opts ...grpc.CallOption) (*QueryDenomRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomRolesResponse)
+	err := c.cc.Invoke(ctx, Query_DenomRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintAllowance(ctx context.Context, in *QueryMintAllowanceRequest, opts ...grpc.CallOption) (*QueryMintAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMintAllowanceResponse)
+	err := c.cc.Invoke(ctx, Query_MintAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyCap(ctx context.Context, in *QuerySupplyCapRequest, opts ...grpc.CallOption) (*QuerySupplyCapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySupplyCapResponse)
+	err := c.cc.Invoke(ctx, Query_SupplyCap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllDenoms(ctx context.Context, in *QueryAllDenomsRequest, opts ...grpc.CallOption) (*QueryAllDenomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllDenomsResponse)
+	err := c.cc.Invoke(ctx, Query_AllDenoms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomsByAdmin(ctx context.Context, in *QueryDenomsByAdminRequest, opts ...grpc.CallOption) (*QueryDenomsByAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomsByAdminResponse)
+	err := c.cc.Invoke(ctx, Query_DenomsByAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomsByHookContract(ctx context.Context, in *QueryDenomsByHookContractRequest, opts ...grpc.CallOption) (*QueryDenomsByHookContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomsByHookContractResponse)
+	err := c.cc.Invoke(ctx, Query_DenomsByHookContract_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomPaused(ctx context.Context, in *QueryDenomPausedRequest, opts ...grpc.CallOption) (*QueryDenomPausedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomPausedResponse)
+	err := c.cc.Invoke(ctx, Query_DenomPaused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountFrozen(ctx context.Context, in *QueryAccountFrozenRequest, opts ...grpc.CallOption) (*QueryAccountFrozenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountFrozenResponse)
+	err := c.cc.Invoke(ctx, Query_AccountFrozen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenAccounts(ctx context.Context, in *QueryFrozenAccountsRequest, opts ...grpc.CallOption) (*QueryFrozenAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFrozenAccountsResponse)
+	err := c.cc.Invoke(ctx, Query_FrozenAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomStrictMode(ctx context.Context, in *QueryDenomStrictModeRequest, opts ...grpc.CallOption) (*QueryDenomStrictModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomStrictModeResponse)
+	err := c.cc.Invoke(ctx, Query_DenomStrictMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BeforeSendHookFailures(ctx context.Context, in *QueryBeforeSendHookFailuresRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookFailuresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBeforeSendHookFailuresResponse)
+	err := c.cc.Invoke(ctx, Query_BeforeSendHookFailures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingAdminTransfer(ctx context.Context, in *QueryPendingAdminTransferRequest, opts ...grpc.CallOption) (*QueryPendingAdminTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingAdminTransferResponse)
+	err := c.cc.Invoke(ctx, Query_PendingAdminTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BeforeSendHooks(ctx context.Context, in *QueryBeforeSendHooksRequest, opts ...grpc.CallOption) (*QueryBeforeSendHooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBeforeSendHooksResponse)
+	err := c.cc.Invoke(ctx, Query_BeforeSendHooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -111,6 +292,44 @@ type QueryServer interface {
 	// BeforeSendHookAddress defines a gRPC query method for
 	// getting the address registered for the before send hook.
 	BeforeSendHookAddress(context.Context, *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error)
+	// DenomRoles defines a gRPC query method for fetching the roles granted for
+	// a denom.
+	DenomRoles(context.Context, *QueryDenomRolesRequest) (*QueryDenomRolesResponse, error)
+	// MintAllowance defines a gRPC query method for fetching the mint allowance
+	// of a minter.
+	MintAllowance(context.Context, *QueryMintAllowanceRequest) (*QueryMintAllowanceResponse, error)
+	// SupplyCap defines a gRPC query method for fetching the max supply of a
+	// denom.
+	SupplyCap(context.Context, *QuerySupplyCapRequest) (*QuerySupplyCapResponse, error)
+	// AllDenoms defines a gRPC query method for listing all the factory denoms.
+	AllDenoms(context.Context, *QueryAllDenomsRequest) (*QueryAllDenomsResponse, error)
+	// DenomsByAdmin defines a gRPC query method for listing the denoms of which
+	// the given address is the admin.
+	DenomsByAdmin(context.Context, *QueryDenomsByAdminRequest) (*QueryDenomsByAdminResponse, error)
+	// DenomsByHookContract defines a gRPC query method for listing the denoms
+	// which have the given contract registered as a before send hook.
+	DenomsByHookContract(context.Context, *QueryDenomsByHookContractRequest) (*QueryDenomsByHookContractResponse, error)
+	// DenomPaused defines a gRPC query method for checking whether the transfers
+	// of a denom are paused.
+	DenomPaused(context.Context, *QueryDenomPausedRequest) (*QueryDenomPausedResponse, error)
+	// AccountFrozen defines a gRPC query method for checking whether an account
+	// is frozen for a denom.
+	AccountFrozen(context.Context, *QueryAccountFrozenRequest) (*QueryAccountFrozenResponse, error)
+	// FrozenAccounts defines a gRPC query method for listing the frozen
+	// accounts of a denom.
+	FrozenAccounts(context.Context, *QueryFrozenAccountsRequest) (*QueryFrozenAccountsResponse, error)
+	// DenomStrictMode defines a gRPC query method for checking whether the
+	// module to module sends of a denom go through the blocking checks.
+	DenomStrictMode(context.Context, *QueryDenomStrictModeRequest) (*QueryDenomStrictModeResponse, error)
+	// BeforeSendHookFailures defines a gRPC query method for fetching the
+	// failure counter of the before send hooks of a denom.
+	BeforeSendHookFailures(context.Context, *QueryBeforeSendHookFailuresRequest) (*QueryBeforeSendHookFailuresResponse, error)
+	// PendingAdminTransfer defines a gRPC query method for fetching the pending
+	// admin transfer of a denom.
+	PendingAdminTransfer(context.Context, *QueryPendingAdminTransferRequest) (*QueryPendingAdminTransferResponse, error)
+	// BeforeSendHooks defines a gRPC query method for getting the ordered list
+	// of addresses registered for the before send hooks.
+	BeforeSendHooks(context.Context, *QueryBeforeSendHooksRequest) (*QueryBeforeSendHooksResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -133,6 +352,45 @@ func (UnimplementedQueryServer) DenomsFromCreator(context.Context, *QueryDenomsF
 func (UnimplementedQueryServer) BeforeSendHookAddress(context.Context, *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BeforeSendHookAddress not implemented")
 }
+func (UnimplementedQueryServer) DenomRoles(context.Context, *QueryDenomRolesRequest) (*QueryDenomRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DenomRoles not implemented")
+}
+func (UnimplementedQueryServer) MintAllowance(context.Context, *QueryMintAllowanceRequest) (*QueryMintAllowanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MintAllowance not implemented")
+}
+func (UnimplementedQueryServer) SupplyCap(context.Context, *QuerySupplyCapRequest) (*QuerySupplyCapResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SupplyCap not implemented")
+}
+func (UnimplementedQueryServer) AllDenoms(context.Context, *QueryAllDenomsRequest) (*QueryAllDenomsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AllDenoms not implemented")
+}
+func (UnimplementedQueryServer) DenomsByAdmin(context.Context, *QueryDenomsByAdminRequest) (*QueryDenomsByAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DenomsByAdmin not implemented")
+}
+func (UnimplementedQueryServer) DenomsByHookContract(context.Context, *QueryDenomsByHookContractRequest) (*QueryDenomsByHookContractResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DenomsByHookContract not implemented")
+}
+func (UnimplementedQueryServer) DenomPaused(context.Context, *QueryDenomPausedRequest) (*QueryDenomPausedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DenomPaused not implemented")
+}
+func (UnimplementedQueryServer) AccountFrozen(context.Context, *QueryAccountFrozenRequest) (*QueryAccountFrozenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AccountFrozen not implemented")
+}
+func (UnimplementedQueryServer) FrozenAccounts(context.Context, *QueryFrozenAccountsRequest) (*QueryFrozenAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FrozenAccounts not implemented")
+}
+func (UnimplementedQueryServer) DenomStrictMode(context.Context, *QueryDenomStrictModeRequest) (*QueryDenomStrictModeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DenomStrictMode not implemented")
+}
+func (UnimplementedQueryServer) BeforeSendHookFailures(context.Context, *QueryBeforeSendHookFailuresRequest) (*QueryBeforeSendHookFailuresResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BeforeSendHookFailures not implemented")
+}
+func (UnimplementedQueryServer) PendingAdminTransfer(context.Context, *QueryPendingAdminTransferRequest) (*QueryPendingAdminTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PendingAdminTransfer not implemented")
+}
+func (UnimplementedQueryServer) BeforeSendHooks(context.Context, *QueryBeforeSendHooksRequest) (*QueryBeforeSendHooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BeforeSendHooks not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -226,6 +484,240 @@ func _Query_BeforeSendHookAddress_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomRoles(ctx, req.(*QueryDenomRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintAllowance(ctx, req.(*QueryMintAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplyCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyCap(ctx, req.(*QuerySupplyCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllDenoms(ctx, req.(*QueryAllDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomsByAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsByAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsByAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomsByAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsByAdmin(ctx, req.(*QueryDenomsByAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomsByHookContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsByHookContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsByHookContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomsByHookContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsByHookContract(ctx, req.(*QueryDenomsByHookContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomPaused(ctx, req.(*QueryDenomPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountFrozenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountFrozen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountFrozen(ctx, req.(*QueryAccountFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FrozenAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAccounts(ctx, req.(*QueryFrozenAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomStrictMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomStrictModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomStrictMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomStrictMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomStrictMode(ctx, req.(*QueryDenomStrictModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BeforeSendHookFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBeforeSendHookFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BeforeSendHookFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BeforeSendHookFailures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BeforeSendHookFailures(ctx, req.(*QueryBeforeSendHookFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingAdminTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingAdminTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingAdminTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingAdminTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingAdminTransfer(ctx, req.(*QueryPendingAdminTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BeforeSendHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBeforeSendHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BeforeSendHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BeforeSendHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BeforeSendHooks(ctx, req.(*QueryBeforeSendHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -249,6 +741,58 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BeforeSendHookAddress",
 			Handler:    _Query_BeforeSendHookAddress_Handler,
 		},
+		{
+			MethodName: "DenomRoles",
+			Handler:    _Query_DenomRoles_Handler,
+		},
+		{
+			MethodName: "MintAllowance",
+			Handler:    _Query_MintAllowance_Handler,
+		},
+		{
+			MethodName: "SupplyCap",
+			Handler:    _Query_SupplyCap_Handler,
+		},
+		{
+			MethodName: "AllDenoms",
+			Handler:    _Query_AllDenoms_Handler,
+		},
+		{
+			MethodName: "DenomsByAdmin",
+			Handler:    _Query_DenomsByAdmin_Handler,
+		},
+		{
+			MethodName: "DenomsByHookContract",
+			Handler:    _Query_DenomsByHookContract_Handler,
+		},
+		{
+			MethodName: "DenomPaused",
+			Handler:    _Query_DenomPaused_Handler,
+		},
+		{
+			MethodName: "AccountFrozen",
+			Handler:    _Query_AccountFrozen_Handler,
+		},
+		{
+			MethodName: "FrozenAccounts",
+			Handler:    _Query_FrozenAccounts_Handler,
+		},
+		{
+			MethodName: "DenomStrictMode",
+			Handler:    _Query_DenomStrictMode_Handler,
+		},
+		{
+			MethodName: "BeforeSendHookFailures",
+			Handler:    _Query_BeforeSendHookFailures_Handler,
+		},
+		{
+			MethodName: "PendingAdminTransfer",
+			Handler:    _Query_PendingAdminTransfer_Handler,
+		},
+		{
+			MethodName: "BeforeSendHooks",
+			Handler:    _Query_BeforeSendHooks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "miniwasm/tokenfactory/v1/query.proto",
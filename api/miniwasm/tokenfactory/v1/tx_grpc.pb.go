@@ -19,13 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_CreateDenom_FullMethodName       = "/miniwasm.tokenfactory.v1.Msg/CreateDenom"
-	Msg_Mint_FullMethodName              = "/miniwasm.tokenfactory.v1.Msg/Mint"
-	Msg_Burn_FullMethodName              = "/miniwasm.tokenfactory.v1.Msg/Burn"
-	Msg_ChangeAdmin_FullMethodName       = "/miniwasm.tokenfactory.v1.Msg/ChangeAdmin"
-	Msg_SetDenomMetadata_FullMethodName  = "/miniwasm.tokenfactory.v1.Msg/SetDenomMetadata"
-	Msg_SetBeforeSendHook_FullMethodName = "/miniwasm.tokenfactory.v1.Msg/SetBeforeSendHook"
-	Msg_UpdateParams_FullMethodName      = "/miniwasm.tokenfactory.v1.Msg/UpdateParams"
+	Msg_CreateDenom_FullMethodName              = "/miniwasm.tokenfactory.v1.Msg/CreateDenom"
+	Msg_Mint_FullMethodName                     = "/miniwasm.tokenfactory.v1.Msg/Mint"
+	Msg_Burn_FullMethodName                     = "/miniwasm.tokenfactory.v1.Msg/Burn"
+	Msg_BurnFrom_FullMethodName                 = "/miniwasm.tokenfactory.v1.Msg/BurnFrom"
+	Msg_ForceTransfer_FullMethodName            = "/miniwasm.tokenfactory.v1.Msg/ForceTransfer"
+	Msg_DisableDenomCapabilities_FullMethodName = "/miniwasm.tokenfactory.v1.Msg/DisableDenomCapabilities"
+	Msg_GrantRole_FullMethodName                = "/miniwasm.tokenfactory.v1.Msg/GrantRole"
+	Msg_RevokeRole_FullMethodName               = "/miniwasm.tokenfactory.v1.Msg/RevokeRole"
+	Msg_SetMintAllowance_FullMethodName         = "/miniwasm.tokenfactory.v1.Msg/SetMintAllowance"
+	Msg_SetSupplyCap_FullMethodName             = "/miniwasm.tokenfactory.v1.Msg/SetSupplyCap"
+	Msg_SetDenomPaused_FullMethodName           = "/miniwasm.tokenfactory.v1.Msg/SetDenomPaused"
+	Msg_SetAccountFrozen_FullMethodName         = "/miniwasm.tokenfactory.v1.Msg/SetAccountFrozen"
+	Msg_SetDenomStrictMode_FullMethodName       = "/miniwasm.tokenfactory.v1.Msg/SetDenomStrictMode"
+	Msg_ProposeAdmin_FullMethodName             = "/miniwasm.tokenfactory.v1.Msg/ProposeAdmin"
+	Msg_AcceptAdmin_FullMethodName              = "/miniwasm.tokenfactory.v1.Msg/AcceptAdmin"
+	Msg_CancelAdminTransfer_FullMethodName      = "/miniwasm.tokenfactory.v1.Msg/CancelAdminTransfer"
+	Msg_RenounceAdmin_FullMethodName            = "/miniwasm.tokenfactory.v1.Msg/RenounceAdmin"
+	Msg_ChangeAdmin_FullMethodName              = "/miniwasm.tokenfactory.v1.Msg/ChangeAdmin"
+	Msg_SetDenomMetadata_FullMethodName         = "/miniwasm.tokenfactory.v1.Msg/SetDenomMetadata"
+	Msg_SetBeforeSendHook_FullMethodName        = "/miniwasm.tokenfactory.v1.Msg/SetBeforeSendHook"
+	Msg_SetBeforeSendHooks_FullMethodName       = "/miniwasm.tokenfactory.v1.Msg/SetBeforeSendHooks"
+	Msg_UpdateParams_FullMethodName             = "/miniwasm.tokenfactory.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -40,6 +55,46 @@ type MsgClient interface {
 	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
 	// Burn defines a gRPC service method for burning a token.
 	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	// BurnFrom defines a gRPC service method for burning a token from any
+	// account.
+	BurnFrom(ctx context.Context, in *MsgBurnFrom, opts ...grpc.CallOption) (*MsgBurnFromResponse, error)
+	// ForceTransfer defines a gRPC service method for transferring a token
+	// between any accounts.
+	ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error)
+	// DisableDenomCapabilities defines a gRPC service method for turning off the
+	// burn from and force transfer capabilities of a denom.
+	DisableDenomCapabilities(ctx context.Context, in *MsgDisableDenomCapabilities, opts ...grpc.CallOption) (*MsgDisableDenomCapabilitiesResponse, error)
+	// GrantRole defines a gRPC service method for granting a role of a denom.
+	GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error)
+	// RevokeRole defines a gRPC service method for revoking a role of a denom.
+	RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error)
+	// SetMintAllowance defines a gRPC service method for setting the mint
+	// allowance of a minter.
+	SetMintAllowance(ctx context.Context, in *MsgSetMintAllowance, opts ...grpc.CallOption) (*MsgSetMintAllowanceResponse, error)
+	// SetSupplyCap defines a gRPC service method for setting or lowering the
+	// max supply of a denom.
+	SetSupplyCap(ctx context.Context, in *MsgSetSupplyCap, opts ...grpc.CallOption) (*MsgSetSupplyCapResponse, error)
+	// SetDenomPaused defines a gRPC service method for pausing or unpausing the
+	// transfers of a denom.
+	SetDenomPaused(ctx context.Context, in *MsgSetDenomPaused, opts ...grpc.CallOption) (*MsgSetDenomPausedResponse, error)
+	// SetAccountFrozen defines a gRPC service method for freezing or unfreezing
+	// the transfers of a denom from and to an account.
+	SetAccountFrozen(ctx context.Context, in *MsgSetAccountFrozen, opts ...grpc.CallOption) (*MsgSetAccountFrozenResponse, error)
+	// SetDenomStrictMode defines a gRPC service method for opting a denom in or
+	// out of the blocking checks on module to module sends.
+	SetDenomStrictMode(ctx context.Context, in *MsgSetDenomStrictMode, opts ...grpc.CallOption) (*MsgSetDenomStrictModeResponse, error)
+	// ProposeAdmin defines a gRPC service method for proposing a new admin of a
+	// denom, which takes effect once accepted.
+	ProposeAdmin(ctx context.Context, in *MsgProposeAdmin, opts ...grpc.CallOption) (*MsgProposeAdminResponse, error)
+	// AcceptAdmin defines a gRPC service method for accepting a pending admin
+	// transfer.
+	AcceptAdmin(ctx context.Context, in *MsgAcceptAdmin, opts ...grpc.CallOption) (*MsgAcceptAdminResponse, error)
+	// CancelAdminTransfer defines a gRPC service method for cancelling a pending
+	// admin transfer.
+	CancelAdminTransfer(ctx context.Context, in *MsgCancelAdminTransfer, opts ...grpc.CallOption) (*MsgCancelAdminTransferResponse, error)
+	// RenounceAdmin defines a gRPC service method for permanently giving up the
+	// admin of a denom.
+	RenounceAdmin(ctx context.Context, in *MsgRenounceAdmin, opts ...grpc.CallOption) (*MsgRenounceAdminResponse, error)
 	// ChangeAdmin defines a gRPC service method for changing the admin of a
 	// denom.
 	ChangeAdmin(ctx context.Context, in *MsgChangeAdmin, opts ...grpc.CallOption) (*MsgChangeAdminResponse, error)
@@ -49,6 +104,9 @@ type MsgClient interface {
 	// SetBeforeSendHook defines a gRPC service method for setting the before send
 	// hook of a denom.
 	SetBeforeSendHook(ctx context.Context, in *MsgSetBeforeSendHook, opts ...grpc.CallOption) (*MsgSetBeforeSendHookResponse, error)
+	// SetBeforeSendHooks defines a gRPC service method for setting the ordered
+	// list of before send hooks of a denom.
+	SetBeforeSendHooks(ctx context.Context, in *MsgSetBeforeSendHooks, opts ...grpc.CallOption) (*MsgSetBeforeSendHooksResponse, error)
 	// UpdateParams defines an operation for updating the x/tokenfactory module
 	// parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -92,6 +150,146 @@ func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) BurnFrom(ctx context.Context, in *MsgBurnFrom, opts ...grpc.CallOption) (*MsgBurnFromResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBurnFromResponse)
+	err := c.cc.Invoke(ctx, Msg_BurnFrom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgForceTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_ForceTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableDenomCapabilities(ctx context.Context, in *MsgDisableDenomCapabilities, opts ...grpc.CallOption) (*MsgDisableDenomCapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDisableDenomCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, Msg_DisableDenomCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMintAllowance(ctx context.Context, in *MsgSetMintAllowance, opts ...grpc.CallOption) (*MsgSetMintAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetMintAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMintAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSupplyCap(ctx context.Context, in *MsgSetSupplyCap, opts ...grpc.CallOption) (*MsgSetSupplyCapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetSupplyCapResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSupplyCap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomPaused(ctx context.Context, in *MsgSetDenomPaused, opts ...grpc.CallOption) (*MsgSetDenomPausedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDenomPausedResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomPaused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAccountFrozen(ctx context.Context, in *MsgSetAccountFrozen, opts ...grpc.CallOption) (*MsgSetAccountFrozenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetAccountFrozenResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAccountFrozen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomStrictMode(ctx context.Context, in *MsgSetDenomStrictMode, opts ...grpc.CallOption) (*MsgSetDenomStrictModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDenomStrictModeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomStrictMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeAdmin(ctx context.Context, in *MsgProposeAdmin, opts ...grpc.CallOption) (*MsgProposeAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgProposeAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_ProposeAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptAdmin(ctx context.Context, in *MsgAcceptAdmin, opts ...grpc.CallOption) (*MsgAcceptAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAcceptAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAdminTransfer(ctx context.Context, in *MsgCancelAdminTransfer, opts ...grpc.CallOption) (*MsgCancelAdminTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelAdminTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelAdminTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenounceAdmin(ctx context.Context, in *MsgRenounceAdmin, opts ...grpc.CallOption) (*MsgRenounceAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRenounceAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_RenounceAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ChangeAdmin(ctx context.Context, in *MsgChangeAdmin, opts ...grpc.CallOption) (*MsgChangeAdminResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgChangeAdminResponse)
@@ -122,6 +320,16 @@ func (c *msgClient) SetBeforeSendHook(ctx context.Context, in *MsgSetBeforeSendH
 	return out, nil
 }
 
+func (c *msgClient) SetBeforeSendHooks(ctx context.Context, in *MsgSetBeforeSendHooks, opts ...grpc.CallOption) (*MsgSetBeforeSendHooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetBeforeSendHooksResponse)
+	err := c.cc.Invoke(ctx, Msg_SetBeforeSendHooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateParamsResponse)
@@ -144,6 +352,46 @@ type MsgServer interface {
 	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
 	// Burn defines a gRPC service method for burning a token.
 	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
+	// BurnFrom defines a gRPC service method for burning a token from any
+	// account.
+	BurnFrom(context.Context, *MsgBurnFrom) (*MsgBurnFromResponse, error)
+	// ForceTransfer defines a gRPC service method for transferring a token
+	// between any accounts.
+	ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error)
+	// DisableDenomCapabilities defines a gRPC service method for turning off the
+	// burn from and force transfer capabilities of a denom.
+	DisableDenomCapabilities(context.Context, *MsgDisableDenomCapabilities) (*MsgDisableDenomCapabilitiesResponse, error)
+	// GrantRole defines a gRPC service method for granting a role of a denom.
+	GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error)
+	// RevokeRole defines a gRPC service method for revoking a role of a denom.
+	RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error)
+	// SetMintAllowance defines a gRPC service method for setting the mint
+	// allowance of a minter.
+	SetMintAllowance(context.Context, *MsgSetMintAllowance) (*MsgSetMintAllowanceResponse, error)
+	// SetSupplyCap defines a gRPC service method for setting or lowering the
+	// max supply of a denom.
+	SetSupplyCap(context.Context, *MsgSetSupplyCap) (*MsgSetSupplyCapResponse, error)
+	// SetDenomPaused defines a gRPC service method for pausing or unpausing the
+	// transfers of a denom.
+	SetDenomPaused(context.Context, *MsgSetDenomPaused) (*MsgSetDenomPausedResponse, error)
+	// SetAccountFrozen defines a gRPC service method for freezing or unfreezing
+	// the transfers of a denom from and to an account.
+	SetAccountFrozen(context.Context, *MsgSetAccountFrozen) (*MsgSetAccountFrozenResponse, error)
+	// SetDenomStrictMode defines a gRPC service method for opting a denom in or
+	// out of the blocking checks on module to module sends.
+	SetDenomStrictMode(context.Context, *MsgSetDenomStrictMode) (*MsgSetDenomStrictModeResponse, error)
+	// ProposeAdmin defines a gRPC service method for proposing a new admin of a
+	// denom, which takes effect once accepted.
+	ProposeAdmin(context.Context, *MsgProposeAdmin) (*MsgProposeAdminResponse, error)
+	// AcceptAdmin defines a gRPC service method for accepting a pending admin
+	// transfer.
+	AcceptAdmin(context.Context, *MsgAcceptAdmin) (*MsgAcceptAdminResponse, error)
+	// CancelAdminTransfer defines a gRPC service method for cancelling a pending
+	// admin transfer.
+	CancelAdminTransfer(context.Context, *MsgCancelAdminTransfer) (*MsgCancelAdminTransferResponse, error)
+	// RenounceAdmin defines a gRPC service method for permanently giving up the
+	// admin of a denom.
+	RenounceAdmin(context.Context, *MsgRenounceAdmin) (*MsgRenounceAdminResponse, error)
 	// ChangeAdmin defines a gRPC service method for changing the admin of a
 	// denom.
 	ChangeAdmin(context.Context, *MsgChangeAdmin) (*MsgChangeAdminResponse, error)
@@ -153,6 +401,9 @@ type MsgServer interface {
 	// SetBeforeSendHook defines a gRPC service method for setting the before send
 	// hook of a denom.
 	SetBeforeSendHook(context.Context, *MsgSetBeforeSendHook) (*MsgSetBeforeSendHookResponse, error)
+	// SetBeforeSendHooks defines a gRPC service method for setting the ordered
+	// list of before send hooks of a denom.
+	SetBeforeSendHooks(context.Context, *MsgSetBeforeSendHooks) (*MsgSetBeforeSendHooksResponse, error)
 	// UpdateParams defines an operation for updating the x/tokenfactory module
 	// parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -175,6 +426,48 @@ func (UnimplementedMsgServer) Mint(context.Context, *MsgMint) (*MsgMintResponse,
 func (UnimplementedMsgServer) Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Burn not implemented")
 }
+func (UnimplementedMsgServer) BurnFrom(context.Context, *MsgBurnFrom) (*MsgBurnFromResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BurnFrom not implemented")
+}
+func (UnimplementedMsgServer) ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForceTransfer not implemented")
+}
+func (UnimplementedMsgServer) DisableDenomCapabilities(context.Context, *MsgDisableDenomCapabilities) (*MsgDisableDenomCapabilitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisableDenomCapabilities not implemented")
+}
+func (UnimplementedMsgServer) GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (UnimplementedMsgServer) RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (UnimplementedMsgServer) SetMintAllowance(context.Context, *MsgSetMintAllowance) (*MsgSetMintAllowanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMintAllowance not implemented")
+}
+func (UnimplementedMsgServer) SetSupplyCap(context.Context, *MsgSetSupplyCap) (*MsgSetSupplyCapResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSupplyCap not implemented")
+}
+func (UnimplementedMsgServer) SetDenomPaused(context.Context, *MsgSetDenomPaused) (*MsgSetDenomPausedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDenomPaused not implemented")
+}
+func (UnimplementedMsgServer) SetAccountFrozen(context.Context, *MsgSetAccountFrozen) (*MsgSetAccountFrozenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAccountFrozen not implemented")
+}
+func (UnimplementedMsgServer) SetDenomStrictMode(context.Context, *MsgSetDenomStrictMode) (*MsgSetDenomStrictModeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDenomStrictMode not implemented")
+}
+func (UnimplementedMsgServer) ProposeAdmin(context.Context, *MsgProposeAdmin) (*MsgProposeAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ProposeAdmin not implemented")
+}
+func (UnimplementedMsgServer) AcceptAdmin(context.Context, *MsgAcceptAdmin) (*MsgAcceptAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptAdmin not implemented")
+}
+func (UnimplementedMsgServer) CancelAdminTransfer(context.Context, *MsgCancelAdminTransfer) (*MsgCancelAdminTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelAdminTransfer not implemented")
+}
+func (UnimplementedMsgServer) RenounceAdmin(context.Context, *MsgRenounceAdmin) (*MsgRenounceAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenounceAdmin not implemented")
+}
 func (UnimplementedMsgServer) ChangeAdmin(context.Context, *MsgChangeAdmin) (*MsgChangeAdminResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ChangeAdmin not implemented")
 }
@@ -184,6 +477,9 @@ func (UnimplementedMsgServer) SetDenomMetadata(context.Context, *MsgSetDenomMeta
 func (UnimplementedMsgServer) SetBeforeSendHook(context.Context, *MsgSetBeforeSendHook) (*MsgSetBeforeSendHookResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetBeforeSendHook not implemented")
 }
+func (UnimplementedMsgServer) SetBeforeSendHooks(context.Context, *MsgSetBeforeSendHooks) (*MsgSetBeforeSendHooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBeforeSendHooks not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -262,6 +558,258 @@ func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BurnFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnFrom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BurnFrom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnFrom(ctx, req.(*MsgBurnFrom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ForceTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceTransfer(ctx, req.(*MsgForceTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableDenomCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableDenomCapabilities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableDenomCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DisableDenomCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableDenomCapabilities(ctx, req.(*MsgDisableDenomCapabilities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMintAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMintAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintAllowance(ctx, req.(*MsgSetMintAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSupplyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSupplyCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSupplyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSupplyCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSupplyCap(ctx, req.(*MsgSetSupplyCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomPaused(ctx, req.(*MsgSetDenomPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAccountFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAccountFrozen)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAccountFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAccountFrozen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAccountFrozen(ctx, req.(*MsgSetAccountFrozen))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomStrictMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomStrictMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomStrictMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomStrictMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomStrictMode(ctx, req.(*MsgSetDenomStrictMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ProposeAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeAdmin(ctx, req.(*MsgProposeAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAdmin(ctx, req.(*MsgAcceptAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAdminTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAdminTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAdminTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelAdminTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAdminTransfer(ctx, req.(*MsgCancelAdminTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenounceAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenounceAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenounceAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RenounceAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenounceAdmin(ctx, req.(*MsgRenounceAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ChangeAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgChangeAdmin)
 	if err := dec(in); err != nil {
@@ -316,6 +864,24 @@ func _Msg_SetBeforeSendHook_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetBeforeSendHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBeforeSendHooks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBeforeSendHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetBeforeSendHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBeforeSendHooks(ctx, req.(*MsgSetBeforeSendHooks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -353,6 +919,62 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Burn",
 			Handler:    _Msg_Burn_Handler,
 		},
+		{
+			MethodName: "BurnFrom",
+			Handler:    _Msg_BurnFrom_Handler,
+		},
+		{
+			MethodName: "ForceTransfer",
+			Handler:    _Msg_ForceTransfer_Handler,
+		},
+		{
+			MethodName: "DisableDenomCapabilities",
+			Handler:    _Msg_DisableDenomCapabilities_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+		{
+			MethodName: "SetMintAllowance",
+			Handler:    _Msg_SetMintAllowance_Handler,
+		},
+		{
+			MethodName: "SetSupplyCap",
+			Handler:    _Msg_SetSupplyCap_Handler,
+		},
+		{
+			MethodName: "SetDenomPaused",
+			Handler:    _Msg_SetDenomPaused_Handler,
+		},
+		{
+			MethodName: "SetAccountFrozen",
+			Handler:    _Msg_SetAccountFrozen_Handler,
+		},
+		{
+			MethodName: "SetDenomStrictMode",
+			Handler:    _Msg_SetDenomStrictMode_Handler,
+		},
+		{
+			MethodName: "ProposeAdmin",
+			Handler:    _Msg_ProposeAdmin_Handler,
+		},
+		{
+			MethodName: "AcceptAdmin",
+			Handler:    _Msg_AcceptAdmin_Handler,
+		},
+		{
+			MethodName: "CancelAdminTransfer",
+			Handler:    _Msg_CancelAdminTransfer_Handler,
+		},
+		{
+			MethodName: "RenounceAdmin",
+			Handler:    _Msg_RenounceAdmin_Handler,
+		},
 		{
 			MethodName: "ChangeAdmin",
 			Handler:    _Msg_ChangeAdmin_Handler,
@@ -365,6 +987,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetBeforeSendHook",
 			Handler:    _Msg_SetBeforeSendHook_Handler,
 		},
+		{
+			MethodName: "SetBeforeSendHooks",
+			Handler:    _Msg_SetBeforeSendHooks_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
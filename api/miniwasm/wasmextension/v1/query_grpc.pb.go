@@ -0,0 +1,375 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: miniwasm/wasmextension/v1/query.proto
+
+package wasmextensionv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Query_AcceptedStargateMsgs_FullMethodName = "/miniwasm.wasmextension.v1.Query/AcceptedStargateMsgs"
+	Query_AdminCodes_FullMethodName           = "/miniwasm.wasmextension.v1.Query/AdminCodes"
+	Query_AdminCode_FullMethodName            = "/miniwasm.wasmextension.v1.Query/AdminCode"
+	Query_RegisteredChecksums_FullMethodName  = "/miniwasm.wasmextension.v1.Query/RegisteredChecksums"
+	Query_RegisteredChecksum_FullMethodName   = "/miniwasm.wasmextension.v1.Query/RegisteredChecksum"
+	Query_FailedCallbacks_FullMethodName      = "/miniwasm.wasmextension.v1.Query/FailedCallbacks"
+	Query_FailedCallback_FullMethodName       = "/miniwasm.wasmextension.v1.Query/FailedCallback"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Query defines the gRPC querier service.
+type QueryClient interface {
+	// AcceptedStargateMsgs returns the msg type urls contracts are allowed to
+	// dispatch as stargate messages.
+	AcceptedStargateMsgs(ctx context.Context, in *QueryAcceptedStargateMsgsRequest, opts ...grpc.CallOption) (*QueryAcceptedStargateMsgsResponse, error)
+	// AdminCodes returns the codes stored with admin permission.
+	AdminCodes(ctx context.Context, in *QueryAdminCodesRequest, opts ...grpc.CallOption) (*QueryAdminCodesResponse, error)
+	// AdminCode returns the admin store record of a code.
+	AdminCode(ctx context.Context, in *QueryAdminCodeRequest, opts ...grpc.CallOption) (*QueryAdminCodeResponse, error)
+	// RegisteredChecksums returns the code checksums allowed to be stored with
+	// admin permission.
+	RegisteredChecksums(ctx context.Context, in *QueryRegisteredChecksumsRequest, opts ...grpc.CallOption) (*QueryRegisteredChecksumsResponse, error)
+	// RegisteredChecksum returns the provenance metadata of a registered code
+	// checksum.
+	RegisteredChecksum(ctx context.Context, in *QueryRegisteredChecksumRequest, opts ...grpc.CallOption) (*QueryRegisteredChecksumResponse, error)
+	// FailedCallbacks returns the failed ibc lifecycle callbacks pending to be
+	// retried.
+	FailedCallbacks(ctx context.Context, in *QueryFailedCallbacksRequest, opts ...grpc.CallOption) (*QueryFailedCallbacksResponse, error)
+	// FailedCallback returns a failed ibc lifecycle callback.
+	FailedCallback(ctx context.Context, in *QueryFailedCallbackRequest, opts ...grpc.CallOption) (*QueryFailedCallbackResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AcceptedStargateMsgs(ctx context.Context, in *QueryAcceptedStargateMsgsRequest, opts ...grpc.CallOption) (*QueryAcceptedStargateMsgsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAcceptedStargateMsgsResponse)
+	err := c.cc.Invoke(ctx, Query_AcceptedStargateMsgs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdminCodes(ctx context.Context, in *QueryAdminCodesRequest, opts ...grpc.CallOption) (*QueryAdminCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAdminCodesResponse)
+	err := c.cc.Invoke(ctx, Query_AdminCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdminCode(ctx context.Context, in *QueryAdminCodeRequest, opts ...grpc.CallOption) (*QueryAdminCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAdminCodeResponse)
+	err := c.cc.Invoke(ctx, Query_AdminCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegisteredChecksums(ctx context.Context, in *QueryRegisteredChecksumsRequest, opts ...grpc.CallOption) (*QueryRegisteredChecksumsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRegisteredChecksumsResponse)
+	err := c.cc.Invoke(ctx, Query_RegisteredChecksums_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegisteredChecksum(ctx context.Context, in *QueryRegisteredChecksumRequest, opts ...grpc.CallOption) (*QueryRegisteredChecksumResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRegisteredChecksumResponse)
+	err := c.cc.Invoke(ctx, Query_RegisteredChecksum_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedCallbacks(ctx context.Context, in *QueryFailedCallbacksRequest, opts ...grpc.CallOption) (*QueryFailedCallbacksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFailedCallbacksResponse)
+	err := c.cc.Invoke(ctx, Query_FailedCallbacks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedCallback(ctx context.Context, in *QueryFailedCallbackRequest, opts ...grpc.CallOption) (*QueryFailedCallbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFailedCallbackResponse)
+	err := c.cc.Invoke(ctx, Query_FailedCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility.
+//
+// Query defines the gRPC querier service.
+type QueryServer interface {
+	// AcceptedStargateMsgs returns the msg type urls contracts are allowed to
+	// dispatch as stargate messages.
+	AcceptedStargateMsgs(context.Context, *QueryAcceptedStargateMsgsRequest) (*QueryAcceptedStargateMsgsResponse, error)
+	// AdminCodes returns the codes stored with admin permission.
+	AdminCodes(context.Context, *QueryAdminCodesRequest) (*QueryAdminCodesResponse, error)
+	// AdminCode returns the admin store record of a code.
+	AdminCode(context.Context, *QueryAdminCodeRequest) (*QueryAdminCodeResponse, error)
+	// RegisteredChecksums returns the code checksums allowed to be stored with
+	// admin permission.
+	RegisteredChecksums(context.Context, *QueryRegisteredChecksumsRequest) (*QueryRegisteredChecksumsResponse, error)
+	// RegisteredChecksum returns the provenance metadata of a registered code
+	// checksum.
+	RegisteredChecksum(context.Context, *QueryRegisteredChecksumRequest) (*QueryRegisteredChecksumResponse, error)
+	// FailedCallbacks returns the failed ibc lifecycle callbacks pending to be
+	// retried.
+	FailedCallbacks(context.Context, *QueryFailedCallbacksRequest) (*QueryFailedCallbacksResponse, error)
+	// FailedCallback returns a failed ibc lifecycle callback.
+	FailedCallback(context.Context, *QueryFailedCallbackRequest) (*QueryFailedCallbackResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQueryServer struct{}
+
+func (UnimplementedQueryServer) AcceptedStargateMsgs(context.Context, *QueryAcceptedStargateMsgsRequest) (*QueryAcceptedStargateMsgsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptedStargateMsgs not implemented")
+}
+func (UnimplementedQueryServer) AdminCodes(context.Context, *QueryAdminCodesRequest) (*QueryAdminCodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminCodes not implemented")
+}
+func (UnimplementedQueryServer) AdminCode(context.Context, *QueryAdminCodeRequest) (*QueryAdminCodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminCode not implemented")
+}
+func (UnimplementedQueryServer) RegisteredChecksums(context.Context, *QueryRegisteredChecksumsRequest) (*QueryRegisteredChecksumsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisteredChecksums not implemented")
+}
+func (UnimplementedQueryServer) RegisteredChecksum(context.Context, *QueryRegisteredChecksumRequest) (*QueryRegisteredChecksumResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisteredChecksum not implemented")
+}
+func (UnimplementedQueryServer) FailedCallbacks(context.Context, *QueryFailedCallbacksRequest) (*QueryFailedCallbacksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FailedCallbacks not implemented")
+}
+func (UnimplementedQueryServer) FailedCallback(context.Context, *QueryFailedCallbackRequest) (*QueryFailedCallbackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FailedCallback not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+func (UnimplementedQueryServer) testEmbeddedByValue()               {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	// If the following call panics, it indicates UnimplementedQueryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_AcceptedStargateMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAcceptedStargateMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AcceptedStargateMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AcceptedStargateMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AcceptedStargateMsgs(ctx, req.(*QueryAcceptedStargateMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdminCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AdminCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminCodes(ctx, req.(*QueryAdminCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdminCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AdminCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminCode(ctx, req.(*QueryAdminCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredChecksums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredChecksumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredChecksums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RegisteredChecksums_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredChecksums(ctx, req.(*QueryRegisteredChecksumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredChecksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredChecksumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredChecksum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RegisteredChecksum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredChecksum(ctx, req.(*QueryRegisteredChecksumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FailedCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedCallbacks(ctx, req.(*QueryFailedCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FailedCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedCallback(ctx, req.(*QueryFailedCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "miniwasm.wasmextension.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AcceptedStargateMsgs",
+			Handler:    _Query_AcceptedStargateMsgs_Handler,
+		},
+		{
+			MethodName: "AdminCodes",
+			Handler:    _Query_AdminCodes_Handler,
+		},
+		{
+			MethodName: "AdminCode",
+			Handler:    _Query_AdminCode_Handler,
+		},
+		{
+			MethodName: "RegisteredChecksums",
+			Handler:    _Query_RegisteredChecksums_Handler,
+		},
+		{
+			MethodName: "RegisteredChecksum",
+			Handler:    _Query_RegisteredChecksum_Handler,
+		},
+		{
+			MethodName: "FailedCallbacks",
+			Handler:    _Query_FailedCallbacks_Handler,
+		},
+		{
+			MethodName: "FailedCallback",
+			Handler:    _Query_FailedCallback_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "miniwasm/wasmextension/v1/query.proto",
+}
@@ -19,7 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_StoreCodeAdmin_FullMethodName = "/miniwasm.wasmextension.v1.Msg/StoreCodeAdmin"
+	Msg_StoreCodeAdmin_FullMethodName              = "/miniwasm.wasmextension.v1.Msg/StoreCodeAdmin"
+	Msg_StoreCodesAdmin_FullMethodName             = "/miniwasm.wasmextension.v1.Msg/StoreCodesAdmin"
+	Msg_StoreAndInstantiateAdmin_FullMethodName    = "/miniwasm.wasmextension.v1.Msg/StoreAndInstantiateAdmin"
+	Msg_UpdateCodeAccessConfigAdmin_FullMethodName = "/miniwasm.wasmextension.v1.Msg/UpdateCodeAccessConfigAdmin"
+	Msg_MigrateContractAdmin_FullMethodName        = "/miniwasm.wasmextension.v1.Msg/MigrateContractAdmin"
+	Msg_SetContractAdminAdmin_FullMethodName       = "/miniwasm.wasmextension.v1.Msg/SetContractAdminAdmin"
+	Msg_RegisterChecksums_FullMethodName           = "/miniwasm.wasmextension.v1.Msg/RegisterChecksums"
+	Msg_UnregisterChecksums_FullMethodName         = "/miniwasm.wasmextension.v1.Msg/UnregisterChecksums"
+	Msg_UpdateParams_FullMethodName                = "/miniwasm.wasmextension.v1.Msg/UpdateParams"
+	Msg_AddAcceptedStargateMsgs_FullMethodName     = "/miniwasm.wasmextension.v1.Msg/AddAcceptedStargateMsgs"
+	Msg_RemoveAcceptedStargateMsgs_FullMethodName  = "/miniwasm.wasmextension.v1.Msg/RemoveAcceptedStargateMsgs"
+	Msg_RetryFailedCallback_FullMethodName         = "/miniwasm.wasmextension.v1.Msg/RetryFailedCallback"
 )
 
 // MsgClient is the client API for Msg service.
@@ -30,6 +41,38 @@ const (
 type MsgClient interface {
 	// StoreCodeAdmin to submit Wasm code to the system with admin permission
 	StoreCodeAdmin(ctx context.Context, in *MsgStoreCodeAdmin, opts ...grpc.CallOption) (*MsgStoreCodeAdminResponse, error)
+	// StoreCodesAdmin to submit multiple Wasm codes to the system with admin
+	// permission
+	StoreCodesAdmin(ctx context.Context, in *MsgStoreCodesAdmin, opts ...grpc.CallOption) (*MsgStoreCodesAdminResponse, error)
+	// StoreAndInstantiateAdmin to submit Wasm code to the system and instantiate
+	// a contract from it with admin permission
+	StoreAndInstantiateAdmin(ctx context.Context, in *MsgStoreAndInstantiateAdmin, opts ...grpc.CallOption) (*MsgStoreAndInstantiateAdminResponse, error)
+	// UpdateCodeAccessConfigAdmin to update the instantiate permission of a code
+	// with admin permission
+	UpdateCodeAccessConfigAdmin(ctx context.Context, in *MsgUpdateCodeAccessConfigAdmin, opts ...grpc.CallOption) (*MsgUpdateCodeAccessConfigAdminResponse, error)
+	// MigrateContractAdmin to migrate a contract to a new code version with
+	// admin permission
+	MigrateContractAdmin(ctx context.Context, in *MsgMigrateContractAdmin, opts ...grpc.CallOption) (*MsgMigrateContractAdminResponse, error)
+	// SetContractAdminAdmin to set or clear the admin of a contract with admin
+	// permission
+	SetContractAdminAdmin(ctx context.Context, in *MsgSetContractAdminAdmin, opts ...grpc.CallOption) (*MsgSetContractAdminAdminResponse, error)
+	// RegisterChecksums registers code checksums which are allowed to be stored
+	// with admin permission
+	RegisterChecksums(ctx context.Context, in *MsgRegisterChecksums, opts ...grpc.CallOption) (*MsgRegisterChecksumsResponse, error)
+	// UnregisterChecksums removes code checksums from the registry
+	UnregisterChecksums(ctx context.Context, in *MsgUnregisterChecksums, opts ...grpc.CallOption) (*MsgUnregisterChecksumsResponse, error)
+	// UpdateParams defines an operation for updating the wasmextension module
+	// parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AddAcceptedStargateMsgs adds msg type urls to the list of messages
+	// contracts are allowed to dispatch as stargate messages
+	AddAcceptedStargateMsgs(ctx context.Context, in *MsgAddAcceptedStargateMsgs, opts ...grpc.CallOption) (*MsgAddAcceptedStargateMsgsResponse, error)
+	// RemoveAcceptedStargateMsgs removes msg type urls from the list of messages
+	// contracts are allowed to dispatch as stargate messages
+	RemoveAcceptedStargateMsgs(ctx context.Context, in *MsgRemoveAcceptedStargateMsgs, opts ...grpc.CallOption) (*MsgRemoveAcceptedStargateMsgsResponse, error)
+	// RetryFailedCallback retries a failed ibc lifecycle callback. It can be
+	// sent by any account.
+	RetryFailedCallback(ctx context.Context, in *MsgRetryFailedCallback, opts ...grpc.CallOption) (*MsgRetryFailedCallbackResponse, error)
 }
 
 type msgClient struct {
@@ -50,6 +93,116 @@ func (c *msgClient) StoreCodeAdmin(ctx context.Context, in *MsgStoreCodeAdmin, o
 	return out, nil
 }
 
+func (c *msgClient) StoreCodesAdmin(ctx context.Context, in *MsgStoreCodesAdmin, opts ...grpc.CallOption) (*MsgStoreCodesAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgStoreCodesAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_StoreCodesAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StoreAndInstantiateAdmin(ctx context.Context, in *MsgStoreAndInstantiateAdmin, opts ...grpc.CallOption) (*MsgStoreAndInstantiateAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgStoreAndInstantiateAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_StoreAndInstantiateAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCodeAccessConfigAdmin(ctx context.Context, in *MsgUpdateCodeAccessConfigAdmin, opts ...grpc.CallOption) (*MsgUpdateCodeAccessConfigAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateCodeAccessConfigAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateCodeAccessConfigAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateContractAdmin(ctx context.Context, in *MsgMigrateContractAdmin, opts ...grpc.CallOption) (*MsgMigrateContractAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgMigrateContractAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_MigrateContractAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetContractAdminAdmin(ctx context.Context, in *MsgSetContractAdminAdmin, opts ...grpc.CallOption) (*MsgSetContractAdminAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetContractAdminAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_SetContractAdminAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterChecksums(ctx context.Context, in *MsgRegisterChecksums, opts ...grpc.CallOption) (*MsgRegisterChecksumsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRegisterChecksumsResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterChecksums_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnregisterChecksums(ctx context.Context, in *MsgUnregisterChecksums, opts ...grpc.CallOption) (*MsgUnregisterChecksumsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnregisterChecksumsResponse)
+	err := c.cc.Invoke(ctx, Msg_UnregisterChecksums_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAcceptedStargateMsgs(ctx context.Context, in *MsgAddAcceptedStargateMsgs, opts ...grpc.CallOption) (*MsgAddAcceptedStargateMsgsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddAcceptedStargateMsgsResponse)
+	err := c.cc.Invoke(ctx, Msg_AddAcceptedStargateMsgs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAcceptedStargateMsgs(ctx context.Context, in *MsgRemoveAcceptedStargateMsgs, opts ...grpc.CallOption) (*MsgRemoveAcceptedStargateMsgsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveAcceptedStargateMsgsResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAcceptedStargateMsgs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetryFailedCallback(ctx context.Context, in *MsgRetryFailedCallback, opts ...grpc.CallOption) (*MsgRetryFailedCallbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRetryFailedCallbackResponse)
+	err := c.cc.Invoke(ctx, Msg_RetryFailedCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -58,6 +211,38 @@ func (c *msgClient) StoreCodeAdmin(ctx context.Context, in *MsgStoreCodeAdmin, o
 type MsgServer interface {
 	// StoreCodeAdmin to submit Wasm code to the system with admin permission
 	StoreCodeAdmin(context.Context, *MsgStoreCodeAdmin) (*MsgStoreCodeAdminResponse, error)
+	// StoreCodesAdmin to submit multiple Wasm codes to the system with admin
+	// permission
+	StoreCodesAdmin(context.Context, *MsgStoreCodesAdmin) (*MsgStoreCodesAdminResponse, error)
+	// StoreAndInstantiateAdmin to submit Wasm code to the system and instantiate
+	// a contract from it with admin permission
+	StoreAndInstantiateAdmin(context.Context, *MsgStoreAndInstantiateAdmin) (*MsgStoreAndInstantiateAdminResponse, error)
+	// UpdateCodeAccessConfigAdmin to update the instantiate permission of a code
+	// with admin permission
+	UpdateCodeAccessConfigAdmin(context.Context, *MsgUpdateCodeAccessConfigAdmin) (*MsgUpdateCodeAccessConfigAdminResponse, error)
+	// MigrateContractAdmin to migrate a contract to a new code version with
+	// admin permission
+	MigrateContractAdmin(context.Context, *MsgMigrateContractAdmin) (*MsgMigrateContractAdminResponse, error)
+	// SetContractAdminAdmin to set or clear the admin of a contract with admin
+	// permission
+	SetContractAdminAdmin(context.Context, *MsgSetContractAdminAdmin) (*MsgSetContractAdminAdminResponse, error)
+	// RegisterChecksums registers code checksums which are allowed to be stored
+	// with admin permission
+	RegisterChecksums(context.Context, *MsgRegisterChecksums) (*MsgRegisterChecksumsResponse, error)
+	// UnregisterChecksums removes code checksums from the registry
+	UnregisterChecksums(context.Context, *MsgUnregisterChecksums) (*MsgUnregisterChecksumsResponse, error)
+	// UpdateParams defines an operation for updating the wasmextension module
+	// parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AddAcceptedStargateMsgs adds msg type urls to the list of messages
+	// contracts are allowed to dispatch as stargate messages
+	AddAcceptedStargateMsgs(context.Context, *MsgAddAcceptedStargateMsgs) (*MsgAddAcceptedStargateMsgsResponse, error)
+	// RemoveAcceptedStargateMsgs removes msg type urls from the list of messages
+	// contracts are allowed to dispatch as stargate messages
+	RemoveAcceptedStargateMsgs(context.Context, *MsgRemoveAcceptedStargateMsgs) (*MsgRemoveAcceptedStargateMsgsResponse, error)
+	// RetryFailedCallback retries a failed ibc lifecycle callback. It can be
+	// sent by any account.
+	RetryFailedCallback(context.Context, *MsgRetryFailedCallback) (*MsgRetryFailedCallbackResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -71,6 +256,39 @@ type UnimplementedMsgServer struct{}
 func (UnimplementedMsgServer) StoreCodeAdmin(context.Context, *MsgStoreCodeAdmin) (*MsgStoreCodeAdminResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method StoreCodeAdmin not implemented")
 }
+func (UnimplementedMsgServer) StoreCodesAdmin(context.Context, *MsgStoreCodesAdmin) (*MsgStoreCodesAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StoreCodesAdmin not implemented")
+}
+func (UnimplementedMsgServer) StoreAndInstantiateAdmin(context.Context, *MsgStoreAndInstantiateAdmin) (*MsgStoreAndInstantiateAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StoreAndInstantiateAdmin not implemented")
+}
+func (UnimplementedMsgServer) UpdateCodeAccessConfigAdmin(context.Context, *MsgUpdateCodeAccessConfigAdmin) (*MsgUpdateCodeAccessConfigAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCodeAccessConfigAdmin not implemented")
+}
+func (UnimplementedMsgServer) MigrateContractAdmin(context.Context, *MsgMigrateContractAdmin) (*MsgMigrateContractAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MigrateContractAdmin not implemented")
+}
+func (UnimplementedMsgServer) SetContractAdminAdmin(context.Context, *MsgSetContractAdminAdmin) (*MsgSetContractAdminAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetContractAdminAdmin not implemented")
+}
+func (UnimplementedMsgServer) RegisterChecksums(context.Context, *MsgRegisterChecksums) (*MsgRegisterChecksumsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterChecksums not implemented")
+}
+func (UnimplementedMsgServer) UnregisterChecksums(context.Context, *MsgUnregisterChecksums) (*MsgUnregisterChecksumsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnregisterChecksums not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) AddAcceptedStargateMsgs(context.Context, *MsgAddAcceptedStargateMsgs) (*MsgAddAcceptedStargateMsgsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddAcceptedStargateMsgs not implemented")
+}
+func (UnimplementedMsgServer) RemoveAcceptedStargateMsgs(context.Context, *MsgRemoveAcceptedStargateMsgs) (*MsgRemoveAcceptedStargateMsgsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveAcceptedStargateMsgs not implemented")
+}
+func (UnimplementedMsgServer) RetryFailedCallback(context.Context, *MsgRetryFailedCallback) (*MsgRetryFailedCallbackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryFailedCallback not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -110,6 +328,204 @@ func _Msg_StoreCodeAdmin_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StoreCodesAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStoreCodesAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StoreCodesAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StoreCodesAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StoreCodesAdmin(ctx, req.(*MsgStoreCodesAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StoreAndInstantiateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStoreAndInstantiateAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StoreAndInstantiateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StoreAndInstantiateAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StoreAndInstantiateAdmin(ctx, req.(*MsgStoreAndInstantiateAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCodeAccessConfigAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCodeAccessConfigAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCodeAccessConfigAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateCodeAccessConfigAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCodeAccessConfigAdmin(ctx, req.(*MsgUpdateCodeAccessConfigAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateContractAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateContractAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateContractAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MigrateContractAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateContractAdmin(ctx, req.(*MsgMigrateContractAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetContractAdminAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetContractAdminAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetContractAdminAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetContractAdminAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetContractAdminAdmin(ctx, req.(*MsgSetContractAdminAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterChecksums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterChecksums)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterChecksums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterChecksums_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterChecksums(ctx, req.(*MsgRegisterChecksums))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnregisterChecksums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterChecksums)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterChecksums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnregisterChecksums_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterChecksums(ctx, req.(*MsgUnregisterChecksums))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAcceptedStargateMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAcceptedStargateMsgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAcceptedStargateMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddAcceptedStargateMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAcceptedStargateMsgs(ctx, req.(*MsgAddAcceptedStargateMsgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAcceptedStargateMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAcceptedStargateMsgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAcceptedStargateMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAcceptedStargateMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAcceptedStargateMsgs(ctx, req.(*MsgRemoveAcceptedStargateMsgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetryFailedCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryFailedCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryFailedCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RetryFailedCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryFailedCallback(ctx, req.(*MsgRetryFailedCallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -121,6 +537,50 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StoreCodeAdmin",
 			Handler:    _Msg_StoreCodeAdmin_Handler,
 		},
+		{
+			MethodName: "StoreCodesAdmin",
+			Handler:    _Msg_StoreCodesAdmin_Handler,
+		},
+		{
+			MethodName: "StoreAndInstantiateAdmin",
+			Handler:    _Msg_StoreAndInstantiateAdmin_Handler,
+		},
+		{
+			MethodName: "UpdateCodeAccessConfigAdmin",
+			Handler:    _Msg_UpdateCodeAccessConfigAdmin_Handler,
+		},
+		{
+			MethodName: "MigrateContractAdmin",
+			Handler:    _Msg_MigrateContractAdmin_Handler,
+		},
+		{
+			MethodName: "SetContractAdminAdmin",
+			Handler:    _Msg_SetContractAdminAdmin_Handler,
+		},
+		{
+			MethodName: "RegisterChecksums",
+			Handler:    _Msg_RegisterChecksums_Handler,
+		},
+		{
+			MethodName: "UnregisterChecksums",
+			Handler:    _Msg_UnregisterChecksums_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddAcceptedStargateMsgs",
+			Handler:    _Msg_AddAcceptedStargateMsgs_Handler,
+		},
+		{
+			MethodName: "RemoveAcceptedStargateMsgs",
+			Handler:    _Msg_RemoveAcceptedStargateMsgs_Handler,
+		},
+		{
+			MethodName: "RetryFailedCallback",
+			Handler:    _Msg_RetryFailedCallback_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "miniwasm/wasmextension/v1/tx.proto",
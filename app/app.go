@@ -69,7 +69,7 @@ import (
 
 	// local imports
 	"github.com/initia-labs/miniwasm/app/keepers"
-	"github.com/initia-labs/miniwasm/app/upgrades/v1_3_0"
+	"github.com/initia-labs/miniwasm/app/upgrades/v1_4_0"
 
 	// memiavl store
 	initiastore "github.com/initia-labs/store"
@@ -255,7 +255,7 @@ func NewMinitiaApp(
 	// The cosmos upgrade handler attempts to create ${HOME}/.minitia/data to check for upgrade info,
 	// but this isn't required during initial encoding config setup.
 	if loadLatest {
-		v1_3_0.RegisterUpgradeHandlers(app)
+		v1_4_0.RegisterUpgradeHandlers(app)
 	}
 
 	// register executor change plans for later use
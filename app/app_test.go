@@ -120,6 +120,27 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 	}
 }
 
+func TestAcceptedStargateMsgsOnGenesis(t *testing.T) {
+	app := SetupWithGenesisAccounts(t.TempDir(), nil, nil)
+
+	// the curated msgs are accepted by default
+	ctx := app.NewContext(true)
+	accepted, err := app.WasmExtensionKeeper.IsAcceptedStargateMsg(ctx, "/cosmos.bank.v1beta1.MsgSend")
+	require.NoError(t, err)
+	require.True(t, accepted)
+
+	// the msgs wrapping other msgs are not
+	for _, typeURL := range []string{
+		"/cosmos.authz.v1beta1.MsgExec",
+		"/cosmos.group.v1.MsgSubmitProposal",
+		"/ibc.applications.interchain_accounts.controller.v1.MsgSendTx",
+	} {
+		accepted, err := app.WasmExtensionKeeper.IsAcceptedStargateMsg(ctx, typeURL)
+		require.NoError(t, err)
+		require.False(t, accepted, typeURL)
+	}
+}
+
 func TestGetKey(t *testing.T) {
 	db := dbm.NewMemDB()
 	app := NewMinitiaApp(
@@ -22,6 +22,8 @@ import (
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+
+	wasmextensiontypes "github.com/initia-labs/miniwasm/x/wasmextension/types"
 )
 
 // GenesisState - The genesis state of the blockchain is represented here as a map of raw json
@@ -39,6 +41,7 @@ func NewDefaultGenesisState(cdc codec.Codec, mbm module.BasicManager, denom stri
 		ConfigureMinGasPrices(cdc).
 		ConfigureICA(cdc).
 		ConfigureIBCAllowedClients(cdc).
+		ConfigureAcceptedStargateMsgs(cdc).
 		AddMarketData(cdc, cdc.InterfaceRegistry().SigningContext().AddressCodec())
 }
 
@@ -115,6 +118,17 @@ func (genState GenesisState) ConfigureMinGasPrices(cdc codec.JSONCodec) GenesisS
 	return genState
 }
 
+func (genState GenesisState) ConfigureAcceptedStargateMsgs(cdc codec.Codec) GenesisState {
+	var wasmExtensionGenState wasmextensiontypes.GenesisState
+	cdc.MustUnmarshalJSON(genState[wasmextensiontypes.ModuleName], &wasmExtensionGenState)
+
+	// accept the curated stargate msgs by default
+	wasmExtensionGenState.AcceptedStargateMsgs = wasmextensiontypes.DefaultAcceptedStargateMsgs(cdc.InterfaceRegistry())
+	genState[wasmextensiontypes.ModuleName] = cdc.MustMarshalJSON(&wasmExtensionGenState)
+
+	return genState
+}
+
 func (genState GenesisState) ConfigureICA(cdc codec.JSONCodec) GenesisState {
 	// create ICS27 Controller submodule params
 	controllerParams := icacontrollertypes.Params{
@@ -2,10 +2,8 @@ package wasm_hooks
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 
-	"cosmossdk.io/collections"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
@@ -22,9 +20,15 @@ func (h WasmHooks) onAckIcs20Packet(
 	packet channeltypes.Packet,
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
-	_ transfertypes.FungibleTokenPacketData,
+	data transfertypes.FungibleTokenPacketData,
 ) error {
-	return h.handleOnAck(ctx, im, packet, acknowledgement, relayer)
+	if err := h.handleOnAck(ctx, im, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	h.refundForwardFunds(ctx, packet, data, isAckError(h.codec, acknowledgement))
+
+	return nil
 }
 
 func (h WasmHooks) onAckIcs721Packet(
@@ -49,61 +53,16 @@ func (h WasmHooks) handleOnAck(
 		return err
 	}
 
-	// if no async callback, return early
-	bz, err := im.HooksKeeper.GetAsyncCallback(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
-	if err != nil && errors.Is(err, collections.ErrNotFound) {
-		return nil
-	} else if err != nil {
-		h.wasmKeeper.Logger(ctx).Error("failed to get async callback", "error", err)
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeHookFailed,
-			sdk.NewAttribute(types.AttributeKeyReason, "failed to get async callback"),
-			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
-		))
-
+	// if no async callback to invoke, return early
+	contractAddr, ok := h.popAsyncCallback(ctx, im, packet)
+	if !ok {
 		return nil
 	}
 
-	// ignore error on removal; it should not happen
-	_ = im.HooksKeeper.RemoveAsyncCallback(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
-
-	asyncCallback := string(bz)
-
 	// create a new cache context to ignore errors during
 	// the execution of the callback
 	cacheCtx, write := ctx.CacheContext()
 
-	if allowed, err := h.checkACL(im, cacheCtx, asyncCallback); err != nil {
-		h.wasmKeeper.Logger(cacheCtx).Error("failed to check ACL", "error", err)
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeHookFailed,
-			sdk.NewAttribute(types.AttributeKeyReason, "failed to check ACL"),
-			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
-		))
-
-		return nil
-	} else if !allowed {
-		h.wasmKeeper.Logger(cacheCtx).Error("failed to check ACL", "reason", "not allowed")
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeHookFailed,
-			sdk.NewAttribute(types.AttributeKeyReason, "failed to check ACL"),
-			sdk.NewAttribute(types.AttributeKeyError, "not allowed"),
-		))
-
-		return nil
-	}
-
-	contractAddr, err := h.ac.StringToBytes(asyncCallback)
-	if err != nil {
-		h.wasmKeeper.Logger(cacheCtx).Error("invalid contract address", "error", err)
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeHookFailed,
-			sdk.NewAttribute(types.AttributeKeyReason, "invalid contract address"),
-			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
-		))
-		return nil
-	}
-
 	success := "false"
 	if !isAckError(h.codec, acknowledgement) {
 		success = "true"
@@ -133,6 +92,9 @@ func (h WasmHooks) handleOnAck(
 			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
 		))
 
+		// keep the callback to be retried
+		h.enqueueFailedCallback(ctx, contractAddr, sudoMsg, err)
+
 		return nil
 	}
 
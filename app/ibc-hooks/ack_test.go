@@ -11,6 +11,7 @@ import (
 
 	"cosmossdk.io/collections"
 
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	nfttransfertypes "github.com/initia-labs/initia/x/ibc/nft-transfer/types"
@@ -312,3 +313,185 @@ func Test_OnAckPacket_memo_ICS721(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "100", string(queryRes))
 }
+
+func Test_OnAckPacket_memo_ICS27(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+	sourcePort := "icacontroller-owner"
+	sourceChannel := "channel-0"
+	sequence := uint64(99)
+
+	code, err := os.ReadFile("./contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	wasmMsgServer := wasmkeeper.NewMsgServerImpl(&input.WasmKeeper)
+	storeRes, err := wasmMsgServer.StoreCode(ctx, &wasmtypes.MsgStoreCode{
+		Sender:       addr.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	instantiateRes, err := wasmMsgServer.InstantiateContract(ctx, &wasmtypes.MsgInstantiateContract{
+		Sender: addr.String(),
+		Admin:  addr.String(),
+		CodeID: storeRes.CodeID,
+		Label:  "Counter",
+		Msg:    []byte("{}"),
+		Funds:  nil,
+	})
+	require.NoError(t, err)
+
+	contractAddrBech32 := instantiateRes.Address
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBech32)
+	require.NoError(t, err)
+	require.NoError(t, input.IBCHooksKeeper.SetAllowed(ctx, contractAddr, true))
+
+	data := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+	require.NoError(t, input.IBCHooksKeeper.SetAsyncCallback(ctx, sourcePort, sourceChannel, sequence, []byte(contractAddrBech32)))
+
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, channeltypes.Packet{
+		Data:          data.GetBytes(),
+		SourcePort:    sourcePort,
+		SourceChannel: sourceChannel,
+		Sequence:      sequence,
+	}, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), addr)
+	require.NoError(t, err)
+
+	// check the contract state
+	queryRes, err := input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "99", string(queryRes))
+
+	// the callback is removed
+	_, err = input.IBCHooksKeeper.GetAsyncCallback(ctx, sourcePort, sourceChannel, sequence)
+	require.True(t, errors.Is(err, collections.ErrNotFound))
+}
+
+func Test_OnAckPacket_memo_sender_binding(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+	sourcePort := "transfer"
+	sourceChannel := "channel-0"
+	sequence := uint64(99)
+
+	code, err := os.ReadFile("./contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	wasmMsgServer := wasmkeeper.NewMsgServerImpl(&input.WasmKeeper)
+	storeRes, err := wasmMsgServer.StoreCode(ctx, &wasmtypes.MsgStoreCode{
+		Sender:       addr.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	instantiateRes, err := wasmMsgServer.InstantiateContract(ctx, &wasmtypes.MsgInstantiateContract{
+		Sender: addr.String(),
+		Admin:  addr.String(),
+		CodeID: storeRes.CodeID,
+		Label:  "Counter",
+		Msg:    []byte("{}"),
+		Funds:  nil,
+	})
+	require.NoError(t, err)
+
+	contractAddrBech32 := instantiateRes.Address
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBech32)
+	require.NoError(t, err)
+
+	newPacket := func(sender string) channeltypes.Packet {
+		data := transfertypes.FungibleTokenPacketData{
+			Denom:    "foo",
+			Amount:   "10000",
+			Sender:   sender,
+			Receiver: addr.String(),
+		}
+
+		dataBz, err := json.Marshal(&data)
+		require.NoError(t, err)
+
+		return channeltypes.Packet{
+			Data:          dataBz,
+			SourcePort:    sourcePort,
+			SourceChannel: sourceChannel,
+			Sequence:      sequence,
+		}
+	}
+	successAckBz := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+	// the callback of the other sender is dropped, and removed from the store
+	require.NoError(t, input.IBCHooksKeeper.SetAsyncCallback(ctx, sourcePort, sourceChannel, sequence, []byte(contractAddrBech32)))
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, newPacket(addr.String()), successAckBz, addr)
+	require.NoError(t, err)
+
+	queryRes, err := input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "0", string(queryRes))
+
+	_, err = input.IBCHooksKeeper.GetAsyncCallback(ctx, sourcePort, sourceChannel, sequence)
+	require.True(t, errors.Is(err, collections.ErrNotFound))
+
+	// the contract receives the callback of its own packet without acl
+	require.NoError(t, input.IBCHooksKeeper.SetAsyncCallback(ctx, sourcePort, sourceChannel, sequence, []byte(contractAddrBech32)))
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, newPacket(contractAddrBech32), successAckBz, addr)
+	require.NoError(t, err)
+
+	queryRes, err = input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "99", string(queryRes))
+
+	_, err = input.IBCHooksKeeper.GetAsyncCallback(ctx, sourcePort, sourceChannel, sequence)
+	require.True(t, errors.Is(err, collections.ErrNotFound))
+}
+
+func Test_OnAckPacket_forward_refund(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, intermediateSender := keyPubAddr()
+	_, _, refundAddr := keyPubAddr()
+
+	// the packet denom is refunded as the local ibc denom
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    "transfer/channel-2/uatom",
+		Amount:   "10000",
+		Sender:   intermediateSender.String(),
+		Receiver: "receiver",
+	}
+	dataBz, err := json.Marshal(&data)
+	require.NoError(t, err)
+
+	localDenom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	input.Faucet.Fund(ctx, intermediateSender, sdk.NewInt64Coin(localDenom, 20000))
+
+	newPacket := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.Packet{
+			Data:          dataBz,
+			Sequence:      sequence,
+			SourcePort:    "transfer",
+			SourceChannel: "channel-1",
+		}
+	}
+
+	// the refund address is removed without a refund on the success ack
+	input.RefundKeeper.RefundAddresses["transfer/channel-1/1"] = refundAddr.String()
+	successAckBz := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, newPacket(1), successAckBz, intermediateSender)
+	require.NoError(t, err)
+	require.Empty(t, input.RefundKeeper.RefundAddresses)
+	require.True(t, input.BankKeeper.GetBalance(ctx, refundAddr, localDenom).IsZero())
+
+	// the refunded funds are sent to the refund address on the error ack
+	input.RefundKeeper.RefundAddresses["transfer/channel-1/2"] = refundAddr.String()
+	failedAckBz := channeltypes.NewErrorAcknowledgement(errors.New("failed")).Acknowledgement()
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, newPacket(2), failedAckBz, intermediateSender)
+	require.NoError(t, err)
+	require.Empty(t, input.RefundKeeper.RefundAddresses)
+	require.Equal(t, sdk.NewInt64Coin(localDenom, 10000), input.BankKeeper.GetBalance(ctx, refundAddr, localDenom))
+	require.Equal(t, sdk.NewInt64Coin(localDenom, 10000), input.BankKeeper.GetBalance(ctx, intermediateSender, localDenom))
+
+	// the packets not forwarded by the hooks are not refunded
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, newPacket(3), failedAckBz, intermediateSender)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(localDenom, 10000), input.BankKeeper.GetBalance(ctx, intermediateSender, localDenom))
+}
@@ -0,0 +1,138 @@
+package wasm_hooks
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	ibchooks "github.com/initia-labs/initia/x/ibc-hooks"
+	ibchookskeeper "github.com/initia-labs/initia/x/ibc-hooks/keeper"
+	"github.com/initia-labs/initia/x/ibc-hooks/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+)
+
+// packetSender returns the sender of an outbound packet, which is the sender of
+// a transfer packet, the owner of an interchain account controller port, or the
+// contract of a contract port. It returns empty string if the sender is unknown.
+func packetSender(sourcePort string, packetData []byte) string {
+	if isIcs20, data := isIcs20Packet(packetData); isIcs20 {
+		return data.Sender
+	}
+
+	if isIcs721, data := isIcs721Packet(packetData); isIcs721 {
+		return data.Sender
+	}
+
+	if owner, found := strings.CutPrefix(sourcePort, icatypes.ControllerPortPrefix); found {
+		return owner
+	}
+
+	if contractAddr, err := wasmkeeper.ContractFromPortID(sourcePort); err == nil {
+		return contractAddr.String()
+	}
+
+	return ""
+}
+
+// isSenderCallback checks whether the async callback is bound to the sender of
+// the packet, i.e. the contract receives the callback of its own packet.
+func (h WasmHooks) isSenderCallback(sourcePort string, packetData []byte, callbackAddr sdk.AccAddress) bool {
+	senderAddr, err := h.ac.StringToBytes(packetSender(sourcePort, packetData))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(senderAddr, callbackAddr)
+}
+
+// checkCallbackACL checks whether the async callback can be invoked. The
+// callback bound to the packet sender is always allowed, and the other
+// callbacks must be allowed by the ACL.
+func (h WasmHooks) checkCallbackACL(
+	hooksKeeper *ibchookskeeper.Keeper,
+	ctx sdk.Context,
+	sourcePort string,
+	packetData []byte,
+	callbackAddr sdk.AccAddress,
+) (bool, error) {
+	if h.isSenderCallback(sourcePort, packetData, callbackAddr) {
+		return true, nil
+	}
+
+	return hooksKeeper.GetAllowed(ctx, callbackAddr)
+}
+
+// popAsyncCallback loads the async callback of the packet and removes it from
+// the store, so the callback is cleaned up even if it is dropped. It returns
+// false if there is no callback to invoke.
+func (h WasmHooks) popAsyncCallback(
+	ctx sdk.Context,
+	im ibchooks.IBCMiddleware,
+	packet channeltypes.Packet,
+) (sdk.AccAddress, bool) {
+	bz, err := im.HooksKeeper.GetAsyncCallback(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, false
+	} else if err != nil {
+		h.emitHookFailed(ctx, "failed to get async callback", err)
+		h.removeAsyncCallback(ctx, im, packet)
+		return nil, false
+	}
+
+	h.removeAsyncCallback(ctx, im, packet)
+
+	asyncCallback := string(bz)
+	contractAddr, err := h.ac.StringToBytes(asyncCallback)
+	if err != nil {
+		h.emitHookFailed(ctx, "invalid contract address", err)
+		return nil, false
+	}
+
+	if !h.wasmKeeper.HasContractInfo(ctx, contractAddr) {
+		h.emitHookFailed(ctx, "invalid contract address", errors.New("not a contract"))
+		return nil, false
+	}
+
+	if allowed, err := h.checkCallbackACL(im.HooksKeeper, ctx, packet.GetSourcePort(), packet.GetData(), contractAddr); err != nil {
+		h.emitHookFailed(ctx, "failed to check ACL", err)
+		return nil, false
+	} else if !allowed {
+		h.emitHookFailed(ctx, "failed to check ACL", errors.New("not allowed"))
+		return nil, false
+	}
+
+	return contractAddr, true
+}
+
+// removeAsyncCallback removes the async callback of the packet. The failure is
+// reported, but does not affect the packet handling.
+func (h WasmHooks) removeAsyncCallback(ctx sdk.Context, im ibchooks.IBCMiddleware, packet channeltypes.Packet) {
+	if err := im.HooksKeeper.RemoveAsyncCallback(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()); err != nil {
+		h.emitHookFailed(ctx, "failed to remove async callback", err)
+	}
+}
+
+// enqueueFailedCallback keeps the failed callback in the retry queue, so the
+// contract can still be notified of the packet result later.
+func (h WasmHooks) enqueueFailedCallback(ctx sdk.Context, contractAddr sdk.AccAddress, sudoMsg []byte, execErr error) {
+	if _, _, err := h.callbackKeeper.EnqueueFailedCallback(ctx, contractAddr, sudoMsg, execErr); err != nil {
+		h.emitHookFailed(ctx, "failed to enqueue failed callback", err)
+	}
+}
+
+func (h WasmHooks) emitHookFailed(ctx sdk.Context, reason string, err error) {
+	h.wasmKeeper.Logger(ctx).Error(reason, "error", err)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeHookFailed,
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+		sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+	))
+}
@@ -2,7 +2,9 @@ package wasm_hooks_test
 
 import (
 	"context"
+	"fmt"
 	"encoding/binary"
+	"os"
 	"slices"
 	"testing"
 	"time"
@@ -43,6 +45,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v8/modules/core"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -181,6 +184,9 @@ type TestKeepers struct {
 	IBCHooksMiddleware ibchooks.IBCMiddleware
 	WasmKeeper         wasmkeeper.Keeper
 	OPChildKeeper      *MockOPChildKeeper
+	TransferKeeper     *MockTransferKeeper
+	CallbackKeeper     *MockCallbackRetryKeeper
+	RefundKeeper       *MockForwardRefundKeeper
 	MockIBCMiddleware  *mockIBCMiddleware
 
 	EncodingConfig EncodingConfig
@@ -214,6 +220,22 @@ func keyPubAddr() (crypto.PrivKey, crypto.PubKey, sdk.AccAddress) {
 	return key, pub, addr
 }
 
+// instantiateContract stores the wasm code of the path and instantiates it
+// with an empty message, and returns the contract address.
+func instantiateContract(t testing.TB, ctx sdk.Context, input TestKeepers, creator sdk.AccAddress, path string) sdk.AccAddress {
+	code, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	permissionKeeper := wasmkeeper.NewDefaultPermissionKeeper(&input.WasmKeeper)
+	codeID, _, err := permissionKeeper.Create(ctx, creator, code, nil)
+	require.NoError(t, err)
+
+	contractAddr, _, err := permissionKeeper.Instantiate(ctx, codeID, creator, creator, []byte("{}"), "contract", nil)
+	require.NoError(t, err)
+
+	return contractAddr
+}
+
 // encoders can be nil to accept the defaults, or set it to override some of the message handlers (like default)
 func _createTestInput(
 	t testing.TB,
@@ -322,7 +344,12 @@ func _createTestInput(
 	mockOPChildKeeper := &MockOPChildKeeper{
 		IBCToL2DenomMap: map[string]string{},
 	}
-	wasmHooks := wasmhooks.NewWasmHooks(appCodec, ac, &wasmKeeper, mockOPChildKeeper)
+	mockTransferKeeper := &MockTransferKeeper{}
+	mockCallbackKeeper := &MockCallbackRetryKeeper{}
+	mockRefundKeeper := &MockForwardRefundKeeper{
+		RefundAddresses: map[string]string{},
+	}
+	wasmHooks := wasmhooks.NewWasmHooks(appCodec, ac, &wasmKeeper, bankKeeper, mockTransferKeeper, mockCallbackKeeper, mockOPChildKeeper, mockRefundKeeper)
 
 	middleware := ibchooks.NewICS4Middleware(mockIBCMiddleware, ibcHooksKeeper, wasmHooks)
 	ibcHookMiddleware := ibchooks.NewIBCMiddleware(mockIBCMiddleware, middleware, ibcHooksKeeper)
@@ -334,6 +361,9 @@ func _createTestInput(
 		WasmKeeper:         wasmKeeper,
 		BankKeeper:         bankKeeper,
 		OPChildKeeper:      mockOPChildKeeper,
+		TransferKeeper:     mockTransferKeeper,
+		CallbackKeeper:     mockCallbackKeeper,
+		RefundKeeper:       mockRefundKeeper,
 		MockIBCMiddleware:  mockIBCMiddleware,
 		EncodingConfig:     encodingConfig,
 		Faucet:             faucet,
@@ -433,3 +463,42 @@ func (k *MockOPChildKeeper) HasIBCToL2DenomMap(ctx context.Context, ibcDenom str
 	_, ok := k.IBCToL2DenomMap[ibcDenom]
 	return ok, nil
 }
+
+// MockTransferKeeper is a mock implementation of the TransferKeeper interface which records the transfers.
+type MockTransferKeeper struct {
+	Msgs []*transfertypes.MsgTransfer
+}
+
+func (k *MockTransferKeeper) Transfer(ctx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	k.Msgs = append(k.Msgs, msg)
+	return &transfertypes.MsgTransferResponse{Sequence: uint64(len(k.Msgs))}, nil
+}
+
+// MockCallbackRetryKeeper is a mock implementation of the CallbackRetryKeeper interface which records the failed callbacks.
+type MockCallbackRetryKeeper struct {
+	Contracts []sdk.AccAddress
+	Msgs      [][]byte
+}
+
+func (k *MockCallbackRetryKeeper) EnqueueFailedCallback(ctx context.Context, contractAddr sdk.AccAddress, msg []byte, execErr error) (uint64, bool, error) {
+	k.Contracts = append(k.Contracts, contractAddr)
+	k.Msgs = append(k.Msgs, msg)
+	return uint64(len(k.Msgs)), true, nil
+}
+
+// MockForwardRefundKeeper is a mock implementation of the ForwardRefundKeeper interface which keeps the refund addresses in memory.
+type MockForwardRefundKeeper struct {
+	RefundAddresses map[string]string
+}
+
+func (k *MockForwardRefundKeeper) SetForwardRefundAddress(ctx context.Context, portID, channelID string, sequence uint64, refundAddr string) error {
+	k.RefundAddresses[fmt.Sprintf("%s/%s/%d", portID, channelID, sequence)] = refundAddr
+	return nil
+}
+
+func (k *MockForwardRefundKeeper) PopForwardRefundAddress(ctx context.Context, portID, channelID string, sequence uint64) (string, bool, error) {
+	key := fmt.Sprintf("%s/%s/%d", portID, channelID, sequence)
+	refundAddr, found := k.RefundAddresses[key]
+	delete(k.RefundAddresses, key)
+	return refundAddr, found, nil
+}
@@ -1,8 +1,32 @@
 package wasm_hooks
 
-import "context"
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
 
 type OPChildKeeper interface {
 	GetIBCToL2DenomMap(ctx context.Context, ibcDenom string) (string, error)
 	HasIBCToL2DenomMap(ctx context.Context, ibcDenom string) (bool, error)
 }
+
+type BankKeeper interface {
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+type CallbackRetryKeeper interface {
+	EnqueueFailedCallback(ctx context.Context, contractAddr sdk.AccAddress, msg []byte, execErr error) (uint64, bool, error)
+}
+
+type ForwardRefundKeeper interface {
+	SetForwardRefundAddress(ctx context.Context, portID, channelID string, sequence uint64, refundAddr string) error
+	PopForwardRefundAddress(ctx context.Context, portID, channelID string, sequence uint64) (string, bool, error)
+}
+
+type TransferKeeper interface {
+	Transfer(ctx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+}
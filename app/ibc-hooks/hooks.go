@@ -22,18 +22,28 @@ var (
 )
 
 type WasmHooks struct {
-	codec         codec.Codec
-	ac            address.Codec
-	wasmKeeper    *wasmkeeper.Keeper
-	opchildKeeper OPChildKeeper
+	codec          codec.Codec
+	ac             address.Codec
+	wasmKeeper     *wasmkeeper.Keeper
+	bankKeeper     BankKeeper
+	transferKeeper TransferKeeper
+	callbackKeeper CallbackRetryKeeper
+	opchildKeeper  OPChildKeeper
+
+	forwardRefundKeeper ForwardRefundKeeper
 }
 
-func NewWasmHooks(codec codec.Codec, ac address.Codec, wasmKeeper *wasmkeeper.Keeper, opchildKeeper OPChildKeeper) *WasmHooks {
+func NewWasmHooks(codec codec.Codec, ac address.Codec, wasmKeeper *wasmkeeper.Keeper, bankKeeper BankKeeper, transferKeeper TransferKeeper, callbackKeeper CallbackRetryKeeper, opchildKeeper OPChildKeeper, forwardRefundKeeper ForwardRefundKeeper) *WasmHooks {
 	return &WasmHooks{
-		codec:         codec,
-		ac:            ac,
-		wasmKeeper:    wasmKeeper,
-		opchildKeeper: opchildKeeper,
+		codec:          codec,
+		ac:             ac,
+		wasmKeeper:     wasmKeeper,
+		bankKeeper:     bankKeeper,
+		transferKeeper: transferKeeper,
+		callbackKeeper: callbackKeeper,
+		opchildKeeper:  opchildKeeper,
+
+		forwardRefundKeeper: forwardRefundKeeper,
 	}
 }
 
@@ -46,6 +56,9 @@ func (h WasmHooks) OnRecvPacketOverride(im ibchooks.IBCMiddleware, ctx sdk.Conte
 		return h.onRecvIcs721Packet(ctx, im, packet, relayer, ics721Data)
 	}
 
+	// the hook messages are not executed on receive of the other packets: the
+	// ics27 host executes the messages of the interchain account itself, and
+	// the packets of a contract port are handled by the receiving contract.
 	return im.App.OnRecvPacket(ctx, packet, relayer)
 }
 
@@ -58,6 +71,14 @@ func (h WasmHooks) SendPacketOverride(im ibchooks.ICS4Middleware, ctx sdk.Contex
 		return h.sendIcs721Packet(ctx, im, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, ics721Data)
 	}
 
+	if isIcs27, ics27Data := isIcs27Packet(data); isIcs27 {
+		return h.sendIcs27Packet(ctx, im, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, ics27Data)
+	}
+
+	if isWasm, wasmData := isWasmPacket(sourcePort, data); isWasm {
+		return h.sendWasmPacket(ctx, im, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, wasmData)
+	}
+
 	return im.ICS4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 }
 
@@ -70,7 +91,9 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im ibchooks.IBCMiddleware, ct
 		return h.onAckIcs721Packet(ctx, im, packet, acknowledgement, relayer, ics721Data)
 	}
 
-	return im.App.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	// the async callbacks of the other packets, such as ics27 or contract port
+	// packets, are handled regardless of their data.
+	return h.handleOnAck(ctx, im, packet, acknowledgement, relayer)
 }
 
 func (h WasmHooks) OnTimeoutPacketOverride(im ibchooks.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
@@ -82,7 +105,9 @@ func (h WasmHooks) OnTimeoutPacketOverride(im ibchooks.IBCMiddleware, ctx sdk.Co
 		return h.onTimeoutIcs721Packet(ctx, im, packet, relayer, ics721Data)
 	}
 
-	return im.App.OnTimeoutPacket(ctx, packet, relayer)
+	// the async callbacks of the other packets, such as ics27 or contract port
+	// packets, are handled regardless of their data.
+	return h.handleOnTimeout(ctx, im, packet, relayer)
 }
 
 func (h WasmHooks) checkACL(im ibchooks.IBCMiddleware, ctx sdk.Context, addrStr string) (bool, error) {
@@ -1,12 +1,25 @@
 package wasm_hooks
 
 import (
+	"errors"
+	"time"
+
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
 	// The memo key is used to parse ics-20 or ics-712 memo fields.
 	wasmHookMemoKey = "wasm"
+
+	// defaultForwardPort is the port used to forward the funds when the
+	// source port is not specified.
+	defaultForwardPort = "transfer"
+
+	// defaultForwardTimeout is the relative timeout of the forward transfer
+	// when the timeout timestamp is not specified.
+	defaultForwardTimeout = 10 * time.Minute
 )
 
 // HookData defines a wrapper for wasm execute message
@@ -16,6 +29,128 @@ type HookData struct {
 	// at `OnRecvPacket` of receiver chain.
 	Message *wasmtypes.MsgExecuteContract `json:"message,omitempty"`
 
+	// Messages is an ordered list of wasm execute messages which will be
+	// executed atomically at `OnRecvPacket` of receiver chain. It cannot be
+	// used together with Message.
+	Messages []*wasmtypes.MsgExecuteContract `json:"messages,omitempty"`
+
+	// Forward is an optional ics20 transfer which sends the resulting balance
+	// of the intermediate sender onward after the messages are executed.
+	Forward *ForwardData `json:"forward,omitempty"`
+
 	// AsyncCallback is a contract address
 	AsyncCallback string `json:"async_callback,omitempty"`
+
+	// RecoveryAddress is an optional address which receives the funds of an
+	// ics20 packet when the execution of the message fails, instead of
+	// returning an error acknowledgement which refunds the sender.
+	RecoveryAddress string `json:"recovery_address,omitempty"`
+
+	// ReturnContractAck makes the success acknowledgement of a single message
+	// the json encoded ContractAck. Otherwise the single message keeps the
+	// acknowledgement of the underlying application.
+	ReturnContractAck bool `json:"return_contract_ack,omitempty"`
+}
+
+// ForwardData defines an ics20 transfer which is sent after the execution of
+// the hook messages.
+type ForwardData struct {
+	// SourcePort is the port of the transfer, "transfer" by default.
+	SourcePort string `json:"source_port,omitempty"`
+	// SourceChannel is the channel of the transfer.
+	SourceChannel string `json:"source_channel"`
+	// Receiver is the receiver of the transfer on the counterparty chain.
+	Receiver string `json:"receiver"`
+	// Denom is the denom of which the whole balance of the intermediate
+	// sender is transferred.
+	Denom string `json:"denom"`
+	// TimeoutTimestamp is the absolute timeout of the transfer in unix
+	// nanoseconds, block time plus 10 minutes by default.
+	TimeoutTimestamp uint64 `json:"timeout_timestamp,omitempty"`
+	// Memo is the memo of the transfer.
+	Memo string `json:"memo,omitempty"`
+	// RefundAddress is the address which receives the funds refunded to the
+	// intermediate sender when the transfer fails or times out. The
+	// recovery_address of the hook is used when it is empty.
+	RefundAddress string `json:"refund_address,omitempty"`
+}
+
+// Validate performs stateless validation of the forward data.
+func (f ForwardData) Validate() error {
+	if f.SourceChannel == "" {
+		return errors.New("forward source_channel is empty")
+	} else if f.Receiver == "" {
+		return errors.New("forward receiver is empty")
+	} else if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ExecuteMessages returns the messages to be executed in order, and validates
+// the combination of the hook fields.
+func (h HookData) ExecuteMessages() ([]*wasmtypes.MsgExecuteContract, error) {
+	var msgs []*wasmtypes.MsgExecuteContract
+	if h.Message != nil && len(h.Messages) > 0 {
+		return nil, errors.New("message and messages cannot be used together")
+	} else if h.Message != nil {
+		msgs = []*wasmtypes.MsgExecuteContract{h.Message}
+	} else {
+		msgs = h.Messages
+	}
+
+	for _, msg := range msgs {
+		if msg == nil {
+			return nil, errors.New("empty message")
+		}
+	}
+
+	if h.Forward != nil {
+		if len(msgs) == 0 {
+			return nil, errors.New("forward requires at least one message")
+		} else if err := h.Forward.Validate(); err != nil {
+			return nil, err
+		} else if h.ForwardRefundAddress() == "" {
+			return nil, errors.New("forward requires refund_address or recovery_address")
+		}
+	}
+
+	return msgs, nil
+}
+
+// ForwardRefundAddress returns the address which receives the refund of a
+// failed forward transfer, since the intermediate sender has no key to move
+// the refunded funds.
+func (h HookData) ForwardRefundAddress() string {
+	if h.Forward != nil && h.Forward.RefundAddress != "" {
+		return h.Forward.RefundAddress
+	}
+
+	return h.RecoveryAddress
+}
+
+// UsesContractAck returns true if the success acknowledgement is the json
+// encoded ContractAck. It is always used with messages and forward, while the
+// single message has to opt in, so that the counterparties parsing the
+// acknowledgement of the underlying application keep working.
+func (h HookData) UsesContractAck() bool {
+	return h.Message == nil || h.Forward != nil || h.ReturnContractAck
+}
+
+// ContractAck is the result of the success acknowledgement of a packet of
+// which the hook message was handled.
+type ContractAck struct {
+	// ContractResult is the data of the execute response of the contract.
+	ContractResult []byte `json:"contract_result,omitempty"`
+	// ContractResults are the data of the execute responses of the contracts
+	// in the order of the messages, when the messages field is used.
+	ContractResults [][]byte `json:"contract_results,omitempty"`
+	// ForwardSequence is the packet sequence of the forward transfer.
+	ForwardSequence uint64 `json:"forward_sequence,omitempty"`
+	// IbcAck is the acknowledgement of the underlying application.
+	IbcAck []byte `json:"ibc_ack"`
+	// RecoveryAddress is set when the execution failed and the funds were
+	// sent to the recovery address.
+	RecoveryAddress string `json:"recovery_address,omitempty"`
 }
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 
@@ -87,22 +88,47 @@ func (h WasmHooks) handleOnReceive(
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	} else if err != nil {
 		return newEmitErrorAcknowledgement(err)
-	} else if hookData == nil || hookData.Message == nil {
+	} else if hookData == nil {
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
 
-	msg := hookData.Message
-	if allowed, err := h.checkACL(im, ctx, msg.Contract); err != nil {
+	msgs, err := hookData.ExecuteMessages()
+	if err != nil {
 		return newEmitErrorAcknowledgement(err)
-	} else if !allowed {
-		return newEmitErrorAcknowledgement(fmt.Errorf("contract `%s` is not allowed to be used in ibchooks", msg.Contract))
+	} else if len(msgs) == 0 {
+		return im.App.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	for _, msg := range msgs {
+		if allowed, err := h.checkACL(im, ctx, msg.Contract); err != nil {
+			return newEmitErrorAcknowledgement(err)
+		} else if !allowed {
+			return newEmitErrorAcknowledgement(fmt.Errorf("contract `%s` is not allowed to be used in ibchooks", msg.Contract))
+		}
 	}
 
-	// Validate whether the receiver is correctly specified or not.
-	if err := validateReceiver(msg, data.GetReceiver()); err != nil {
+	// Validate whether the receiver is correctly specified or not. The packet
+	// is received by the contract of the first message.
+	if err := validateReceiver(msgs[0], data.GetReceiver()); err != nil {
 		return newEmitErrorAcknowledgement(err)
 	}
 
+	// Only the funds of an ics20 packet can be recovered or forwarded.
+	if hookData.RecoveryAddress != "" {
+		if computeFunds == nil {
+			return newEmitErrorAcknowledgement(fmt.Errorf("recovery_address is only supported for ics20 packets"))
+		} else if _, err := h.ac.StringToBytes(hookData.RecoveryAddress); err != nil {
+			return newEmitErrorAcknowledgement(fmt.Errorf("invalid recovery_address: %w", err))
+		}
+	}
+	if hookData.Forward != nil {
+		if computeFunds == nil {
+			return newEmitErrorAcknowledgement(fmt.Errorf("forward is only supported for ics20 packets"))
+		} else if _, err := h.ac.StringToBytes(hookData.ForwardRefundAddress()); err != nil {
+			return newEmitErrorAcknowledgement(fmt.Errorf("invalid forward refund_address: %w", err))
+		}
+	}
+
 	// Calculate the receiver / contract caller based on the packet's channel and sender
 	intermediateSender := DeriveIntermediateSender(packet.GetDestChannel(), data.GetSender())
 
@@ -129,11 +155,207 @@ func (h WasmHooks) handleOnReceive(
 		}
 	}
 
-	msg.Sender = intermediateSender
-	msg.Funds = funds
-	if _, err := h.execMsg(ctx, msg); err != nil {
+	// The packet funds are attached to the first message, and the following
+	// messages spend their own funds from the balance of the intermediate sender.
+	for _, msg := range msgs {
+		msg.Sender = intermediateSender
+	}
+	msgs[0].Funds = funds
+
+	// execute the messages and the forward in a cache context, so that they are
+	// applied atomically and the state changes of a failed execution are
+	// discarded when the funds are recovered
+	cacheCtx, write := ctx.CacheContext()
+	results, forwardSequence, err := h.execHook(cacheCtx, msgs, hookData.Forward, hookData.ForwardRefundAddress())
+	if err != nil && hookData.RecoveryAddress != "" {
+		return h.recoverFunds(ctx, ack, intermediateSender, hookData.RecoveryAddress, funds, err)
+	} else if err != nil {
 		return newEmitErrorAcknowledgement(err)
 	}
 
-	return ack
+	write()
+
+	if !hookData.UsesContractAck() {
+		return ack
+	}
+
+	contractAck := ContractAck{
+		IbcAck:          ack.Acknowledgement(),
+		ForwardSequence: forwardSequence,
+	}
+	if hookData.Message != nil {
+		contractAck.ContractResult = results[0]
+	} else {
+		contractAck.ContractResults = results
+	}
+
+	return newContractAcknowledgement(contractAck)
+}
+
+// execHook executes the messages in order and then sends the forward transfer,
+// returning the data of the execute responses and the forward packet sequence.
+func (h WasmHooks) execHook(
+	ctx sdk.Context,
+	msgs []*wasmtypes.MsgExecuteContract,
+	forward *ForwardData,
+	refundAddress string,
+) ([][]byte, uint64, error) {
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		res, err := h.execMsg(ctx, msg)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		results[i] = res.Data
+	}
+
+	if forward == nil {
+		return results, 0, nil
+	}
+
+	sequence, err := h.forwardFunds(ctx, msgs[0].Sender, forward, refundAddress)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return results, sequence, nil
+}
+
+// forwardFunds transfers the whole balance of the forward denom of the
+// intermediate sender through ics20, and stores the refund address which
+// receives the funds when the transfer fails.
+func (h WasmHooks) forwardFunds(ctx sdk.Context, intermediateSender string, forward *ForwardData, refundAddress string) (uint64, error) {
+	senderAddr, err := h.ac.StringToBytes(intermediateSender)
+	if err != nil {
+		return 0, err
+	}
+
+	balance := h.bankKeeper.GetBalance(ctx, senderAddr, forward.Denom)
+	if balance.IsZero() {
+		return 0, fmt.Errorf("no balance of `%s` to forward", forward.Denom)
+	}
+
+	sourcePort := forward.SourcePort
+	if sourcePort == "" {
+		sourcePort = defaultForwardPort
+	}
+
+	timeoutTimestamp := forward.TimeoutTimestamp
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().Add(defaultForwardTimeout).UnixNano())
+	}
+
+	msg := transfertypes.NewMsgTransfer(
+		sourcePort,
+		forward.SourceChannel,
+		balance,
+		intermediateSender,
+		forward.Receiver,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		forward.Memo,
+	)
+
+	// the msg does not go through the baseapp, so check the identifiers and
+	// the receiver and memo limits here
+	if err := msg.ValidateBasic(); err != nil {
+		return 0, fmt.Errorf("invalid forward: %w", err)
+	}
+
+	res, err := h.transferKeeper.Transfer(ctx, msg)
+	if err != nil {
+		return 0, fmt.Errorf("failed to forward funds: %w", err)
+	}
+
+	if err := h.forwardRefundKeeper.SetForwardRefundAddress(ctx, sourcePort, forward.SourceChannel, res.Sequence, refundAddress); err != nil {
+		return 0, err
+	}
+
+	return res.Sequence, nil
+}
+
+// refundForwardFunds removes the refund address of a forwarded transfer once
+// it is acknowledged or timed out, and sends the funds refunded to the
+// intermediate sender by the transfer app to the refund address when the
+// transfer failed. A failure of the refund is emitted as an event, and the
+// funds are left on the intermediate sender.
+func (h WasmHooks) refundForwardFunds(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, failed bool) {
+	refundAddress, found, err := h.forwardRefundKeeper.PopForwardRefundAddress(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if err == nil && (!found || !failed) {
+		return
+	}
+
+	if err == nil {
+		err = h.sendForwardRefund(ctx, data, refundAddress)
+	}
+	if err != nil {
+		h.wasmKeeper.Logger(ctx).Error("failed to refund forward", "error", err)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			ibchookstypes.EventTypeHookFailed,
+			sdk.NewAttribute(ibchookstypes.AttributeKeyReason, "failed to refund forward"),
+			sdk.NewAttribute(ibchookstypes.AttributeKeyError, err.Error()),
+		))
+	}
+}
+
+// sendForwardRefund sends the refunded tokens of the packet from the
+// intermediate sender to the refund address.
+func (h WasmHooks) sendForwardRefund(ctx sdk.Context, data transfertypes.FungibleTokenPacketData, refundAddress string) error {
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return fmt.Errorf("invalid amount `%s`", data.Amount)
+	}
+
+	// the transfer app refunds the packet denom as the local denom
+	coin := sdk.NewCoin(transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), amount)
+
+	fromAddr, err := h.ac.StringToBytes(data.Sender)
+	if err != nil {
+		return err
+	}
+	toAddr, err := h.ac.StringToBytes(refundAddress)
+	if err != nil {
+		return err
+	}
+
+	return h.bankKeeper.SendCoins(ctx, fromAddr, toAddr, sdk.NewCoins(coin))
+}
+
+// recoverFunds sends the funds received by the intermediate sender to the
+// recovery address, and returns a success acknowledgement so that the funds
+// stay on this chain.
+func (h WasmHooks) recoverFunds(
+	ctx sdk.Context,
+	ack ibcexported.Acknowledgement,
+	intermediateSender string,
+	recoveryAddress string,
+	funds sdk.Coins,
+	execErr error,
+) ibcexported.Acknowledgement {
+	fromAddr, err := h.ac.StringToBytes(intermediateSender)
+	if err != nil {
+		return newEmitErrorAcknowledgement(err)
+	}
+
+	toAddr, err := h.ac.StringToBytes(recoveryAddress)
+	if err != nil {
+		return newEmitErrorAcknowledgement(err)
+	}
+
+	if err := h.bankKeeper.SendCoins(ctx, fromAddr, toAddr, funds); err != nil {
+		return newEmitErrorAcknowledgement(err)
+	}
+
+	h.wasmKeeper.Logger(ctx).Error("failed to execute hook message, funds are recovered", "error", execErr)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		ibchookstypes.EventTypeHookFailed,
+		sdk.NewAttribute(ibchookstypes.AttributeKeyReason, "failed to execute hook message, funds are recovered"),
+		sdk.NewAttribute(ibchookstypes.AttributeKeyError, execErr.Error()),
+	))
+
+	return newContractAcknowledgement(ContractAck{
+		IbcAck:          ack.Acknowledgement(),
+		RecoveryAddress: recoveryAddress,
+	})
 }
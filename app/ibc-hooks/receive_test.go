@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
@@ -111,18 +112,279 @@ func Test_onReceivePacket_memo(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, input.IBCHooksKeeper.SetAllowed(ctx, contractAddr, true))
 
-	// success
+	// success; the single message keeps the ack of the underlying app
 	ack = input.IBCHooksMiddleware.OnRecvPacket(ctx, channeltypes.Packet{
 		Data:               dataBz,
 		DestinationPort:    "wasm",
 		DestinationChannel: "channel-0",
 	}, addr)
 	require.True(t, ack.Success())
+	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack.Acknowledgement())
 
 	// check the contract state
 	queryRes, err := input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
 	require.NoError(t, err)
 	require.Equal(t, "1", string(queryRes))
+
+	// the contract ack is opted in with return_contract_ack
+	data.Memo = fmt.Sprintf(`{
+		"wasm": {
+			"message": {
+				"contract": "%s",
+				"msg": {"increase":{}}
+			},
+			"return_contract_ack": true
+		}
+	}`, contractAddrBech32)
+	dataBz, err = json.Marshal(&data)
+	require.NoError(t, err)
+	input.Faucet.Fund(ctx, intermediateSender, sdk.NewCoin(localDenom, math.NewInt(10000)))
+
+	ack = input.IBCHooksMiddleware.OnRecvPacket(ctx, channeltypes.Packet{
+		Data:               dataBz,
+		DestinationPort:    "wasm",
+		DestinationChannel: "channel-0",
+	}, addr)
+	require.True(t, ack.Success())
+
+	// the result wraps the ack of the underlying app
+	var contractAck ibchooks.ContractAck
+	require.NoError(t, json.Unmarshal(ack.(channeltypes.Acknowledgement).Response.(*channeltypes.Acknowledgement_Result).Result, &contractAck))
+	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), contractAck.IbcAck)
+	require.Empty(t, contractAck.RecoveryAddress)
+}
+
+func Test_onReceivePacket_memo_recovery(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+	_, _, recoveryAddr := keyPubAddr()
+
+	code, err := os.ReadFile("./contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	wasmMsgServer := wasmkeeper.NewMsgServerImpl(&input.WasmKeeper)
+	storeRes, err := wasmMsgServer.StoreCode(ctx, &wasmtypes.MsgStoreCode{
+		Sender:       addr.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	instantiateRes, err := wasmMsgServer.InstantiateContract(ctx, &wasmtypes.MsgInstantiateContract{
+		Sender: addr.String(),
+		Admin:  addr.String(),
+		CodeID: storeRes.CodeID,
+		Label:  "Counter",
+		Msg:    []byte("{}"),
+		Funds:  nil,
+	})
+	require.NoError(t, err)
+
+	contractAddrBech32 := instantiateRes.Address
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBech32)
+	require.NoError(t, err)
+	require.NoError(t, input.IBCHooksKeeper.SetAllowed(ctx, contractAddr, true))
+
+	newPacket := func(recoveryAddress string) (channeltypes.Packet, transfertypes.FungibleTokenPacketData) {
+		data := transfertypes.FungibleTokenPacketData{
+			Denom:    "foo",
+			Amount:   "10000",
+			Sender:   addr.String(),
+			Receiver: contractAddrBech32,
+			Memo: fmt.Sprintf(`{
+				"wasm": {
+					"message": {
+						"contract": "%s",
+						"msg": {"unknown":{}}
+					},
+					"recovery_address": "%s"
+				}
+			}`, contractAddrBech32, recoveryAddress),
+		}
+
+		dataBz, err := json.Marshal(&data)
+		require.NoError(t, err)
+
+		return channeltypes.Packet{
+			Data:               dataBz,
+			DestinationPort:    "wasm",
+			DestinationChannel: "channel-0",
+		}, data
+	}
+
+	// invalid recovery address
+	packet, _ := newPacket("invalid")
+	ack := input.IBCHooksMiddleware.OnRecvPacket(ctx, packet, addr)
+	require.False(t, ack.Success())
+
+	// funds foo coins to the intermediate sender
+	packet, data := newPacket(recoveryAddr.String())
+	intermediateSender, err := sdk.AccAddressFromBech32(ibchooks.DeriveIntermediateSender("channel-0", data.GetSender()))
+	require.NoError(t, err)
+	localDenom := ibchooks.LocalDenom(packet, data.GetDenom())
+	input.Faucet.Fund(ctx, intermediateSender, sdk.NewCoin(localDenom, math.NewInt(10000)))
+
+	// the execution fails, but the funds are kept at the recovery address
+	ack = input.IBCHooksMiddleware.OnRecvPacket(ctx, packet, addr)
+	require.True(t, ack.Success())
+
+	var contractAck ibchooks.ContractAck
+	require.NoError(t, json.Unmarshal(ack.(channeltypes.Acknowledgement).Response.(*channeltypes.Acknowledgement_Result).Result, &contractAck))
+	require.Equal(t, recoveryAddr.String(), contractAck.RecoveryAddress)
+
+	require.Equal(t, math.NewInt(10000), input.BankKeeper.GetBalance(ctx, recoveryAddr, localDenom).Amount)
+	require.True(t, input.BankKeeper.GetBalance(ctx, intermediateSender, localDenom).IsZero())
+}
+
+func Test_onReceivePacket_memo_messages(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+
+	code, err := os.ReadFile("./contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	wasmMsgServer := wasmkeeper.NewMsgServerImpl(&input.WasmKeeper)
+	storeRes, err := wasmMsgServer.StoreCode(ctx, &wasmtypes.MsgStoreCode{
+		Sender:       addr.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	instantiateRes, err := wasmMsgServer.InstantiateContract(ctx, &wasmtypes.MsgInstantiateContract{
+		Sender: addr.String(),
+		Admin:  addr.String(),
+		CodeID: storeRes.CodeID,
+		Label:  "Counter",
+		Msg:    []byte("{}"),
+		Funds:  nil,
+	})
+	require.NoError(t, err)
+
+	contractAddrBech32 := instantiateRes.Address
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBech32)
+	require.NoError(t, err)
+	require.NoError(t, input.IBCHooksKeeper.SetAllowed(ctx, contractAddr, true))
+
+	newPacket := func(hook string) (channeltypes.Packet, transfertypes.FungibleTokenPacketData) {
+		data := transfertypes.FungibleTokenPacketData{
+			Denom:    "foo",
+			Amount:   "10000",
+			Sender:   addr.String(),
+			Receiver: contractAddrBech32,
+			Memo:     fmt.Sprintf(`{"wasm": %s}`, hook),
+		}
+
+		dataBz, err := json.Marshal(&data)
+		require.NoError(t, err)
+
+		return channeltypes.Packet{
+			Data:               dataBz,
+			DestinationPort:    "wasm",
+			DestinationChannel: "channel-0",
+		}, data
+	}
+
+	// funds foo coins to the intermediate sender
+	packet, data := newPacket("{}")
+	intermediateSender, err := sdk.AccAddressFromBech32(ibchooks.DeriveIntermediateSender("channel-0", data.GetSender()))
+	require.NoError(t, err)
+	localDenom := ibchooks.LocalDenom(packet, data.GetDenom())
+	input.Faucet.Fund(ctx, intermediateSender, sdk.NewCoin(localDenom, math.NewInt(30000)), sdk.NewInt64Coin("bar", 500))
+
+	queryCount := func() string {
+		queryRes, err := input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+		require.NoError(t, err)
+		return string(queryRes)
+	}
+
+	// message and messages cannot be used together
+	packet, _ = newPacket(fmt.Sprintf(`{
+		"message": {"contract": "%[1]s", "msg": {"increase":{}}},
+		"messages": [{"contract": "%[1]s", "msg": {"increase":{}}}]
+	}`, contractAddrBech32))
+	ack := input.IBCHooksMiddleware.OnRecvPacket(ctx, packet, addr)
+	require.False(t, ack.Success())
+
+	// the messages are executed atomically
+	packet, _ = newPacket(fmt.Sprintf(`{
+		"messages": [
+			{"contract": "%[1]s", "msg": {"increase":{}}},
+			{"contract": "%[1]s", "msg": {"unknown":{}}}
+		]
+	}`, contractAddrBech32))
+	ack = input.IBCHooksMiddleware.OnRecvPacket(ctx, packet, addr)
+	require.False(t, ack.Success())
+	require.Equal(t, "0", queryCount())
+
+	// success
+	packet, _ = newPacket(fmt.Sprintf(`{
+		"messages": [
+			{"contract": "%[1]s", "msg": {"increase":{}}},
+			{"contract": "%[1]s", "msg": {"increase":{}}}
+		]
+	}`, contractAddrBech32))
+	ack = input.IBCHooksMiddleware.OnRecvPacket(ctx, packet, addr)
+	require.True(t, ack.Success())
+	require.Equal(t, "2", queryCount())
+
+	var contractAck ibchooks.ContractAck
+	require.NoError(t, json.Unmarshal(ack.(channeltypes.Acknowledgement).Response.(*channeltypes.Acknowledgement_Result).Result, &contractAck))
+	require.Len(t, contractAck.ContractResults, 2)
+	require.Zero(t, contractAck.ForwardSequence)
+
+	// the forward requires a refund address
+	packet, _ = newPacket(fmt.Sprintf(`{
+		"messages": [{"contract": "%s", "msg": {"increase":{}}}],
+		"forward": {"source_channel": "channel-1", "receiver": "receiver", "denom": "bar"}
+	}`, contractAddrBech32))
+	ack = input.IBCHooksMiddleware.OnRecvPacket(ctx, packet, addr)
+	require.False(t, ack.Success())
+	require.Equal(t, "2", queryCount())
+	require.Empty(t, input.TransferKeeper.Msgs)
+
+	// the forward fails without balance, and the messages are reverted
+	packet, _ = newPacket(fmt.Sprintf(`{
+		"messages": [{"contract": "%s", "msg": {"increase":{}}}],
+		"forward": {"source_channel": "channel-1", "receiver": "receiver", "denom": "baz", "refund_address": "%s"}
+	}`, contractAddrBech32, addr))
+	ack = input.IBCHooksMiddleware.OnRecvPacket(ctx, packet, addr)
+	require.False(t, ack.Success())
+	require.Equal(t, "2", queryCount())
+	require.Empty(t, input.TransferKeeper.Msgs)
+
+	// the forward fails with an invalid transfer, and the messages are reverted
+	packet, _ = newPacket(fmt.Sprintf(`{
+		"messages": [{"contract": "%s", "msg": {"increase":{}}}],
+		"forward": {"source_channel": "invalid channel", "receiver": "receiver", "denom": "bar", "refund_address": "%s"}
+	}`, contractAddrBech32, addr))
+	ack = input.IBCHooksMiddleware.OnRecvPacket(ctx, packet, addr)
+	require.False(t, ack.Success())
+	require.Equal(t, "2", queryCount())
+	require.Empty(t, input.TransferKeeper.Msgs)
+
+	// forward the balance after the execution
+	packet, _ = newPacket(fmt.Sprintf(`{
+		"messages": [{"contract": "%s", "msg": {"increase":{}}}],
+		"forward": {"source_channel": "channel-1", "receiver": "receiver", "denom": "bar", "memo": "memo", "refund_address": "%s"}
+	}`, contractAddrBech32, addr))
+	ack = input.IBCHooksMiddleware.OnRecvPacket(ctx, packet, addr)
+	require.True(t, ack.Success())
+	require.Equal(t, "3", queryCount())
+
+	require.NoError(t, json.Unmarshal(ack.(channeltypes.Acknowledgement).Response.(*channeltypes.Acknowledgement_Result).Result, &contractAck))
+	require.Equal(t, uint64(1), contractAck.ForwardSequence)
+
+	require.Len(t, input.TransferKeeper.Msgs, 1)
+	transferMsg := input.TransferKeeper.Msgs[0]
+	require.Equal(t, "transfer", transferMsg.SourcePort)
+	require.Equal(t, "channel-1", transferMsg.SourceChannel)
+	require.Equal(t, sdk.NewInt64Coin("bar", 500), transferMsg.Token)
+	require.Equal(t, intermediateSender.String(), transferMsg.Sender)
+	require.Equal(t, "receiver", transferMsg.Receiver)
+	require.Equal(t, "memo", transferMsg.Memo)
+	require.Equal(t, uint64(ctx.BlockTime().Add(10*time.Minute).UnixNano()), transferMsg.TimeoutTimestamp)
+
+	// the refund address of the forward is kept until the ack or timeout
+	require.Equal(t, map[string]string{"transfer/channel-1/1": addr.String()}, input.RefundKeeper.RefundAddresses)
 }
 
 func Test_onReceiveIcs20Packet_memo_migrated(t *testing.T) {
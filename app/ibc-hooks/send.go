@@ -2,10 +2,12 @@ package wasm_hooks
 
 import (
 	"encoding/json"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 
@@ -44,6 +46,42 @@ func (h WasmHooks) sendIcs721Packet(
 	})
 }
 
+func (h WasmHooks) sendIcs27Packet(
+	ctx sdk.Context,
+	im ibchooks.ICS4Middleware,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	ics27Data icatypes.InterchainAccountPacketData,
+) (uint64, error) {
+	return h.handleSendPacket(ctx, im, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, ics27PacketData{
+		InterchainAccountPacketData: &ics27Data,
+	})
+}
+
+func (h WasmHooks) sendWasmPacket(
+	ctx sdk.Context,
+	im ibchooks.ICS4Middleware,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	wasmData *wasmPacketData,
+) (uint64, error) {
+	return h.handleSendPacket(ctx, im, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, wasmData)
+}
+
+// hookPacketData is the data of an outbound packet which carries the hook data
+// in its memo.
+type hookPacketData interface {
+	GetMemo() string
+	SetMemo(memo string)
+	GetBytes() []byte
+}
+
 func (h WasmHooks) handleSendPacket(
 	ctx sdk.Context,
 	im ibchooks.ICS4Middleware,
@@ -52,7 +90,7 @@ func (h WasmHooks) handleSendPacket(
 	sourceChannel string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
-	icsData ibchookstypes.ICSData,
+	icsData hookPacketData,
 ) (uint64, error) {
 	hookData, routed, err := parseHookData(icsData.GetMemo())
 	if err != nil {
@@ -63,14 +101,27 @@ func (h WasmHooks) handleSendPacket(
 	}
 
 	asyncCallback := hookData.AsyncCallback
-	if _, err := h.ac.StringToBytes(asyncCallback); err != nil {
+	callbackAddr, err := h.ac.StringToBytes(asyncCallback)
+	if err != nil {
+		return 0, err
+	}
+
+	// the callback is invoked by sudo, so it must be a contract
+	if !h.wasmKeeper.HasContractInfo(ctx, callbackAddr) {
+		return 0, fmt.Errorf("async callback `%s` is not a contract", asyncCallback)
+	}
+
+	// the callback must be bound to the packet sender, or be an allowed contract
+	if allowed, err := h.checkCallbackACL(im.HooksKeeper, ctx, sourcePort, icsData.GetBytes(), callbackAddr); err != nil {
 		return 0, err
+	} else if !allowed {
+		return 0, fmt.Errorf("async callback `%s` is neither the packet sender nor an allowed contract", asyncCallback)
 	}
 
 	var memoMap map[string]any
 	// ignore error, it is already checked in parseHookData
 	_ = json.Unmarshal([]byte(icsData.GetMemo()), &memoMap)
-	if hookData.Message == nil {
+	if hookData.Message == nil && len(hookData.Messages) == 0 {
 		delete(memoMap, wasmHookMemoKey)
 	} else {
 		hookData.AsyncCallback = ""
@@ -10,6 +10,7 @@ import (
 
 	"cosmossdk.io/collections"
 
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 
@@ -18,8 +19,9 @@ import (
 
 func Test_SendPacket_asyncCallback_only(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
-	_, _, addr := keyPubAddr()
+	_, _, creator := keyPubAddr()
 	_, _, addr2 := keyPubAddr()
+	addr := instantiateContract(t, ctx, input, creator, "./contracts/artifacts/counter-aarch64.wasm")
 
 	input.MockIBCMiddleware.setSequence(42)
 
@@ -76,8 +78,9 @@ func Test_SendPacket_asyncCallback_invalid(t *testing.T) {
 
 func Test_SendPacket_asyncCallback_with_message(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
-	_, _, addr := keyPubAddr()
+	_, _, creator := keyPubAddr()
 	_, _, addr2 := keyPubAddr()
+	addr := instantiateContract(t, ctx, input, creator, "./contracts/artifacts/counter-aarch64.wasm")
 
 	input.MockIBCMiddleware.setSequence(7)
 
@@ -129,7 +132,8 @@ func Test_SendPacket_asyncCallback_with_message(t *testing.T) {
 
 func Test_SendPacket_asyncCallback_ics721(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
-	_, _, addr := keyPubAddr()
+	_, _, creator := keyPubAddr()
+	addr := instantiateContract(t, ctx, input, creator, "./contracts/artifacts/counter-aarch64.wasm")
 
 	input.MockIBCMiddleware.setSequence(11)
 
@@ -160,6 +164,110 @@ func Test_SendPacket_asyncCallback_ics721(t *testing.T) {
 	require.Equal(t, expectedCallbackBz, callbackBz)
 }
 
+func Test_SendPacket_asyncCallback_ics27(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, creator := keyPubAddr()
+	addr := instantiateContract(t, ctx, input, creator, "./contracts/artifacts/counter-aarch64.wasm")
+
+	sourcePort := "icacontroller-" + addr.String()
+
+	input.MockIBCMiddleware.setSequence(11)
+
+	data := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+		Memo: fmt.Sprintf(`{"wasm":{"async_callback":"%s"},"key":"value"}`, addr.String()),
+	}
+
+	seq, err := input.IBCHooksMiddleware.ICS4Middleware.SendPacket(ctx, nil, sourcePort, "channel-3", clienttypes.ZeroHeight(), 0, data.GetBytes())
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), seq)
+
+	var sent icatypes.InterchainAccountPacketData
+	require.NoError(t, sent.UnmarshalJSON(input.MockIBCMiddleware.lastData))
+	require.Equal(t, `{"key":"value"}`, sent.Memo)
+	require.Equal(t, data.Data, sent.Data)
+
+	callbackBz, err := input.IBCHooksKeeper.GetAsyncCallback(ctx, sourcePort, "channel-3", seq)
+	require.NoError(t, err)
+	require.Equal(t, []byte(addr.String()), callbackBz)
+}
+
+func Test_SendPacket_asyncCallback_wasm(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, creator := keyPubAddr()
+	contractAddr := instantiateContract(t, ctx, input, creator, "./contracts/artifacts/counter-aarch64.wasm")
+	sourcePort := "wasm." + contractAddr.String()
+
+	input.MockIBCMiddleware.setSequence(12)
+
+	// the data without memo is passed through as it is
+	dataBz := []byte(`{"payload": {"key": "value"}}`)
+	seq, err := input.IBCHooksMiddleware.ICS4Middleware.SendPacket(ctx, nil, sourcePort, "channel-4", clienttypes.ZeroHeight(), 0, dataBz)
+	require.NoError(t, err)
+	require.Equal(t, dataBz, input.MockIBCMiddleware.lastData)
+
+	_, err = input.IBCHooksKeeper.GetAsyncCallback(ctx, sourcePort, "channel-4", seq)
+	require.True(t, errors.Is(err, collections.ErrNotFound))
+
+	// the async callback is registered and removed from the memo
+	dataBz = fmt.Appendf(nil, `{"payload":{"key":"value"},"memo":"{\"wasm\":{\"async_callback\":\"%s\"}}"}`, contractAddr.String())
+	seq, err = input.IBCHooksMiddleware.ICS4Middleware.SendPacket(ctx, nil, sourcePort, "channel-4", clienttypes.ZeroHeight(), 0, dataBz)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"payload":{"key":"value"},"memo":"{}"}`, string(input.MockIBCMiddleware.lastData))
+
+	callbackBz, err := input.IBCHooksKeeper.GetAsyncCallback(ctx, sourcePort, "channel-4", seq)
+	require.NoError(t, err)
+	require.Equal(t, []byte(contractAddr.String()), callbackBz)
+}
+
+func Test_SendPacket_asyncCallback_sender_binding(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+	contractAddr := instantiateContract(t, ctx, input, addr, "./contracts/artifacts/counter-aarch64.wasm")
+
+	input.MockIBCMiddleware.setSequence(13)
+
+	// the callback bound to the sender must be a contract
+	eoaData := transfertypes.FungibleTokenPacketData{
+		Denom:    "foo",
+		Amount:   "10000",
+		Sender:   addr.String(),
+		Receiver: contractAddr.String(),
+		Memo:     fmt.Sprintf(`{"wasm":{"async_callback":"%s"}}`, addr.String()),
+	}
+	eoaDataBz, err := json.Marshal(&eoaData)
+	require.NoError(t, err)
+
+	_, err = input.IBCHooksMiddleware.ICS4Middleware.SendPacket(ctx, nil, "transfer", "channel-5", clienttypes.ZeroHeight(), 0, eoaDataBz)
+	require.ErrorContains(t, err, "is not a contract")
+	require.Nil(t, input.MockIBCMiddleware.lastData)
+
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    "foo",
+		Amount:   "10000",
+		Sender:   addr.String(),
+		Receiver: contractAddr.String(),
+		Memo:     fmt.Sprintf(`{"wasm":{"async_callback":"%s"}}`, contractAddr.String()),
+	}
+	dataBz, err := json.Marshal(&data)
+	require.NoError(t, err)
+
+	// the callback is neither the sender nor an allowed contract
+	_, err = input.IBCHooksMiddleware.ICS4Middleware.SendPacket(ctx, nil, "transfer", "channel-5", clienttypes.ZeroHeight(), 0, dataBz)
+	require.Error(t, err)
+	require.Nil(t, input.MockIBCMiddleware.lastData)
+
+	// the allowed contract can be the callback of the other senders
+	require.NoError(t, input.IBCHooksKeeper.SetAllowed(ctx, contractAddr, true))
+	seq, err := input.IBCHooksMiddleware.ICS4Middleware.SendPacket(ctx, nil, "transfer", "channel-5", clienttypes.ZeroHeight(), 0, dataBz)
+	require.NoError(t, err)
+
+	callbackBz, err := input.IBCHooksKeeper.GetAsyncCallback(ctx, "transfer", "channel-5", seq)
+	require.NoError(t, err)
+	require.Equal(t, []byte(contractAddr.String()), callbackBz)
+}
+
 func Test_SendPacket_not_routed_passthrough(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
 	_, _, addr := keyPubAddr()
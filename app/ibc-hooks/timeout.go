@@ -1,10 +1,8 @@
 package wasm_hooks
 
 import (
-	"errors"
 	"fmt"
 
-	"cosmossdk.io/collections"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
@@ -20,9 +18,15 @@ func (h WasmHooks) onTimeoutIcs20Packet(
 	im ibchooks.IBCMiddleware,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
-	_ transfertypes.FungibleTokenPacketData,
+	data transfertypes.FungibleTokenPacketData,
 ) error {
-	return h.handleOnTimeout(ctx, im, packet, relayer)
+	if err := h.handleOnTimeout(ctx, im, packet, relayer); err != nil {
+		return err
+	}
+
+	h.refundForwardFunds(ctx, packet, data, true)
+
+	return nil
 }
 
 func (h WasmHooks) onTimeoutIcs721Packet(
@@ -45,65 +49,20 @@ func (h WasmHooks) handleOnTimeout(
 		return err
 	}
 
-	// if no async callback, return early
-	bz, err := im.HooksKeeper.GetAsyncCallback(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
-	if err != nil && errors.Is(err, collections.ErrNotFound) {
-		return nil
-	} else if err != nil {
-		h.wasmKeeper.Logger(ctx).Error("failed to get async callback", "error", err)
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeHookFailed,
-			sdk.NewAttribute(types.AttributeKeyReason, "failed to get async callback"),
-			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
-		))
-
+	// if no async callback to invoke, return early
+	contractAddr, ok := h.popAsyncCallback(ctx, im, packet)
+	if !ok {
 		return nil
 	}
 
-	// ignore error on removal; it should not happen
-	_ = im.HooksKeeper.RemoveAsyncCallback(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
-
-	asyncCallback := string(bz)
-
 	// create a new cache context to ignore errors during
 	// the execution of the callback
 	cacheCtx, write := ctx.CacheContext()
 
-	if allowed, err := h.checkACL(im, cacheCtx, asyncCallback); err != nil {
-		h.wasmKeeper.Logger(cacheCtx).Error("failed to check ACL", "error", err)
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeHookFailed,
-			sdk.NewAttribute(types.AttributeKeyReason, "failed to check ACL"),
-			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
-		))
-
-		return nil
-	} else if !allowed {
-		h.wasmKeeper.Logger(cacheCtx).Error("failed to check ACL", "reason", "not allowed")
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeHookFailed,
-			sdk.NewAttribute(types.AttributeKeyReason, "failed to check ACL"),
-			sdk.NewAttribute(types.AttributeKeyError, "not allowed"),
-		))
-
-		return nil
-	}
-
-	contractAddr, err := h.ac.StringToBytes(asyncCallback)
-	if err != nil {
-		h.wasmKeeper.Logger(cacheCtx).Error("invalid contract address", "error", err)
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeHookFailed,
-			sdk.NewAttribute(types.AttributeKeyReason, "invalid contract address"),
-			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
-		))
-		return nil
-	}
-
 	sudoMsg := []byte(fmt.Sprintf(
 		`{"ibc_lifecycle_complete": {"ibc_timeout": {"channel": "%s", "sequence": %d}}}`,
 		packet.SourceChannel, packet.Sequence))
-	_, err = h.wasmKeeper.Sudo(cacheCtx, contractAddr, sudoMsg)
+	_, err := h.wasmKeeper.Sudo(cacheCtx, contractAddr, sudoMsg)
 	if err != nil {
 		h.wasmKeeper.Logger(cacheCtx).Error("failed to execute callback", "error", err)
 		ctx.EventManager().EmitEvent(sdk.NewEvent(
@@ -112,6 +71,9 @@ func (h WasmHooks) handleOnTimeout(
 			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
 		))
 
+		// keep the callback to be retried
+		h.enqueueFailedCallback(ctx, contractAddr, sudoMsg, err)
+
 		return nil
 	}
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"testing"
 
@@ -268,3 +269,159 @@ func Test_OnTimeoutPacket_memo_ICS721(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "99", string(queryRes))
 }
+
+func Test_OnTimeoutPacket_memo_wasm(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+	sourceChannel := "channel-0"
+	sequence := uint64(99)
+
+	code, err := os.ReadFile("./contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	wasmMsgServer := wasmkeeper.NewMsgServerImpl(&input.WasmKeeper)
+	storeRes, err := wasmMsgServer.StoreCode(ctx, &wasmtypes.MsgStoreCode{
+		Sender:       addr.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	instantiateRes, err := wasmMsgServer.InstantiateContract(ctx, &wasmtypes.MsgInstantiateContract{
+		Sender: addr.String(),
+		Admin:  addr.String(),
+		CodeID: storeRes.CodeID,
+		Label:  "Counter",
+		Msg:    []byte("{}"),
+		Funds:  nil,
+	})
+	require.NoError(t, err)
+
+	contractAddrBech32 := instantiateRes.Address
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBech32)
+	require.NoError(t, err)
+
+	// the callback is bound to the contract of the port, so it is invoked without acl
+	sourcePort := "wasm." + contractAddrBech32
+	require.NoError(t, input.IBCHooksKeeper.SetAsyncCallback(ctx, sourcePort, sourceChannel, sequence, []byte(contractAddrBech32)))
+
+	err = input.IBCHooksMiddleware.OnTimeoutPacket(ctx, channeltypes.Packet{
+		Data:          []byte(`{"payload":{"key":"value"}}`),
+		SourcePort:    sourcePort,
+		SourceChannel: sourceChannel,
+		Sequence:      sequence,
+	}, addr)
+	require.NoError(t, err)
+
+	// check the contract state
+	queryRes, err := input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "99", string(queryRes))
+
+	// the callback is removed
+	_, err = input.IBCHooksKeeper.GetAsyncCallback(ctx, sourcePort, sourceChannel, sequence)
+	require.True(t, errors.Is(err, collections.ErrNotFound))
+}
+
+func Test_OnTimeoutPacket_failed_callback_queued(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+	sourcePort := "transfer"
+	sourceChannel := "channel-0"
+	sequence := uint64(math.MaxUint64)
+
+	// the counter overflows on the timeout callback
+	contractAddr := instantiateContract(t, ctx, input, addr, "./contracts/artifacts/counter-aarch64.wasm")
+	_, err := wasmkeeper.NewDefaultPermissionKeeper(&input.WasmKeeper).Execute(ctx, contractAddr, addr, []byte(`{"increase":{}}`), nil)
+	require.NoError(t, err)
+
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    "foo",
+		Amount:   "10000",
+		Sender:   contractAddr.String(),
+		Receiver: addr.String(),
+	}
+
+	dataBz, err := json.Marshal(&data)
+	require.NoError(t, err)
+
+	// the callback bound to the sender fails
+	require.NoError(t, input.IBCHooksKeeper.SetAsyncCallback(ctx, sourcePort, sourceChannel, sequence, []byte(contractAddr.String())))
+	err = input.IBCHooksMiddleware.OnTimeoutPacket(ctx, channeltypes.Packet{
+		Data:          dataBz,
+		SourcePort:    sourcePort,
+		SourceChannel: sourceChannel,
+		Sequence:      sequence,
+	}, addr)
+	require.NoError(t, err)
+
+	// the failed callback is queued to be retried
+	require.Equal(t, []sdk.AccAddress{contractAddr}, input.CallbackKeeper.Contracts)
+	require.JSONEq(t, `{"ibc_lifecycle_complete": {"ibc_timeout": {"channel": "channel-0", "sequence": 18446744073709551615}}}`, string(input.CallbackKeeper.Msgs[0]))
+
+	_, err = input.IBCHooksKeeper.GetAsyncCallback(ctx, sourcePort, sourceChannel, sequence)
+	require.True(t, errors.Is(err, collections.ErrNotFound))
+}
+
+func Test_OnTimeoutPacket_non_contract_callback(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+	sourcePort := "transfer"
+	sourceChannel := "channel-0"
+	sequence := uint64(99)
+
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    "foo",
+		Amount:   "10000",
+		Sender:   addr.String(),
+		Receiver: addr.String(),
+	}
+
+	dataBz, err := json.Marshal(&data)
+	require.NoError(t, err)
+
+	// the callback bound to the sender is dropped, as the sender is not a contract
+	require.NoError(t, input.IBCHooksKeeper.SetAsyncCallback(ctx, sourcePort, sourceChannel, sequence, []byte(addr.String())))
+	err = input.IBCHooksMiddleware.OnTimeoutPacket(ctx, channeltypes.Packet{
+		Data:          dataBz,
+		SourcePort:    sourcePort,
+		SourceChannel: sourceChannel,
+		Sequence:      sequence,
+	}, addr)
+	require.NoError(t, err)
+
+	// nothing is queued to be retried
+	require.Empty(t, input.CallbackKeeper.Contracts)
+
+	_, err = input.IBCHooksKeeper.GetAsyncCallback(ctx, sourcePort, sourceChannel, sequence)
+	require.True(t, errors.Is(err, collections.ErrNotFound))
+}
+
+func Test_OnTimeoutPacket_forward_refund(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, intermediateSender := keyPubAddr()
+	_, _, refundAddr := keyPubAddr()
+
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    "bar",
+		Amount:   "10000",
+		Sender:   intermediateSender.String(),
+		Receiver: "receiver",
+	}
+	dataBz, err := json.Marshal(&data)
+	require.NoError(t, err)
+
+	input.Faucet.Fund(ctx, intermediateSender, sdk.NewInt64Coin("bar", 10000))
+	input.RefundKeeper.RefundAddresses["transfer/channel-1/1"] = refundAddr.String()
+
+	// the refunded funds are sent to the refund address on timeout
+	err = input.IBCHooksMiddleware.OnTimeoutPacket(ctx, channeltypes.Packet{
+		Data:          dataBz,
+		Sequence:      1,
+		SourcePort:    "transfer",
+		SourceChannel: "channel-1",
+	}, intermediateSender)
+	require.NoError(t, err)
+	require.Empty(t, input.RefundKeeper.RefundAddresses)
+	require.Equal(t, sdk.NewInt64Coin("bar", 10000), input.BankKeeper.GetBalance(ctx, refundAddr, "bar"))
+	require.True(t, input.BankKeeper.GetBalance(ctx, intermediateSender, "bar").IsZero())
+}
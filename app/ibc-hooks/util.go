@@ -1,6 +1,7 @@
 package wasm_hooks
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -11,11 +12,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 
 	nfttransfertypes "github.com/initia-labs/initia/x/ibc/nft-transfer/types"
 
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
@@ -51,6 +55,118 @@ func isIcs721Packet(packetData []byte) (isIcs721 bool, ics721data nfttransfertyp
 	}
 }
 
+func isIcs27Packet(packetData []byte) (isIcs27 bool, ics27data icatypes.InterchainAccountPacketData) {
+	var data icatypes.InterchainAccountPacketData
+	if err := data.UnmarshalJSON(packetData); err != nil {
+		return false, data
+	} else if err := data.ValidateBasic(); err != nil {
+		return false, data
+	}
+
+	return true, data
+}
+
+// isWasmPacket checks whether the packet is sent from a contract port and its
+// data is a json object with a string `memo` field, which is the convention of
+// the other ibc applications to carry the hook data.
+func isWasmPacket(sourcePort string, packetData []byte) (isWasm bool, wasmData *wasmPacketData) {
+	if _, err := wasmkeeper.ContractFromPortID(sourcePort); err != nil {
+		return false, nil
+	}
+
+	start, end, found := memoValueRange(packetData)
+	if !found {
+		return false, nil
+	}
+
+	var memo string
+	if err := json.Unmarshal(packetData[start:end], &memo); err != nil {
+		return false, nil
+	}
+
+	return true, &wasmPacketData{raw: packetData, memoStart: start, memoEnd: end, memo: memo}
+}
+
+// memoValueRange returns the byte range of the value of the top level `memo`
+// field of the json object. The last field wins on duplicate keys, as in the
+// json decoding of the packet data.
+func memoValueRange(data []byte) (start, end int, found bool) {
+	if !json.Valid(data) {
+		return 0, 0, false
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := decoder.Token(); err != nil || tok != json.Delim('{') {
+		return 0, 0, false
+	}
+
+	for decoder.More() {
+		tok, err := decoder.Token()
+		if err != nil {
+			return 0, 0, false
+		}
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return 0, 0, false
+		}
+
+		if key, _ := tok.(string); key == "memo" {
+			end = int(decoder.InputOffset())
+			start = end - len(value)
+			found = true
+		}
+	}
+
+	return start, end, found
+}
+
+// ics27PacketData wraps the interchain account packet data to update its memo.
+type ics27PacketData struct {
+	*icatypes.InterchainAccountPacketData
+}
+
+func (d ics27PacketData) SetMemo(memo string) {
+	d.Memo = memo
+}
+
+// wasmPacketData is the json object data of a packet sent from a contract port.
+// Only the bytes of the memo value are rewritten when the memo is updated, so
+// the rest of the data stays as the contract committed to it.
+type wasmPacketData struct {
+	raw       []byte
+	memoStart int
+	memoEnd   int
+	memo      string
+}
+
+func (d *wasmPacketData) GetMemo() string {
+	return d.memo
+}
+
+func (d *wasmPacketData) SetMemo(memo string) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	// cannot fail; it is a string
+	_ = encoder.Encode(memo)
+	value := bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+
+	raw := make([]byte, 0, len(d.raw)-(d.memoEnd-d.memoStart)+len(value))
+	raw = append(raw, d.raw[:d.memoStart]...)
+	raw = append(raw, value...)
+	raw = append(raw, d.raw[d.memoEnd:]...)
+
+	d.raw = raw
+	d.memoEnd = d.memoStart + len(value)
+	d.memo = memo
+}
+
+func (d *wasmPacketData) GetBytes() []byte {
+	return d.raw
+}
+
 func parseHookData(memo string) (*HookData, bool, error) {
 	if len(memo) == 0 {
 		return nil, false, nil
@@ -94,6 +210,17 @@ func newEmitErrorAcknowledgement(err error) channeltypes.Acknowledgement {
 	}
 }
 
+// newContractAcknowledgement creates a success acknowledgement of which the
+// result is the json encoded contract ack.
+func newContractAcknowledgement(contractAck ContractAck) ibcexported.Acknowledgement {
+	bz, err := json.Marshal(contractAck)
+	if err != nil {
+		return newEmitErrorAcknowledgement(err)
+	}
+
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
 // isAckError checks an IBC acknowledgement to see if it's an error.
 // This is a replacement for ack.Success() which is currently not working on some circumstances
 func isAckError(appCodec codec.Codec, acknowledgement []byte) bool {
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -45,6 +46,79 @@ func Test_isIcs721Packet(t *testing.T) {
 	require.False(t, ok)
 }
 
+func Test_isIcs27Packet(t *testing.T) {
+	icaMsg := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+		Memo: "memo",
+	}
+
+	ok, _icaMsg := isIcs27Packet(icaMsg.GetBytes())
+	require.True(t, ok)
+	require.Equal(t, icaMsg, _icaMsg)
+
+	transferMsg := transfertypes.NewFungibleTokenPacketData("denom", "1000000", "0x1", "0x2", "memo")
+	ok, _ = isIcs27Packet(transferMsg.GetBytes())
+	require.False(t, ok)
+
+	nftTransferMsg := nfttransfertypes.NewNonFungibleTokenPacketData("class_id", "uri", "data", []string{"1", "2", "3"}, []string{"uri1", "uri2", "uri3"}, []string{"data1", "data2", "data3"}, "sender", "receiver", "memo")
+	ok, _ = isIcs721Packet(icaMsg.GetBytes())
+	require.False(t, ok)
+	ok, _ = isIcs27Packet(nftTransferMsg.GetBytes())
+	require.False(t, ok)
+}
+
+func Test_isWasmPacket(t *testing.T) {
+	contractPort := "wasm." + sdk.AccAddress("contract").String()
+	bz := []byte(`{"memo":"memo","payload":{"key":"value"}}`)
+
+	ok, wasmData := isWasmPacket(contractPort, bz)
+	require.True(t, ok)
+	require.Equal(t, "memo", wasmData.GetMemo())
+	require.Equal(t, bz, wasmData.GetBytes())
+
+	wasmData.SetMemo("")
+	require.JSONEq(t, `{"memo":"","payload":{"key":"value"}}`, string(wasmData.GetBytes()))
+
+	// only the memo value is rewritten, the other bytes are kept as they are
+	bz = []byte(`{"zeta": "<a&b>", "memo" : "memo",  "alpha":{"b":1,"a":"x>y"}}`)
+	ok, wasmData = isWasmPacket(contractPort, bz)
+	require.True(t, ok)
+	require.Equal(t, "memo", wasmData.GetMemo())
+
+	wasmData.SetMemo(`{"wasm":{"async_callback":"<&>"}}`)
+	require.Equal(t, `{"zeta": "<a&b>", "memo" : "{\"wasm\":{\"async_callback\":\"<&>\"}}",  "alpha":{"b":1,"a":"x>y"}}`, string(wasmData.GetBytes()))
+	require.Equal(t, `{"wasm":{"async_callback":"<&>"}}`, wasmData.GetMemo())
+
+	wasmData.SetMemo("")
+	require.Equal(t, `{"zeta": "<a&b>", "memo" : "",  "alpha":{"b":1,"a":"x>y"}}`, string(wasmData.GetBytes()))
+
+	// the last memo wins on duplicate keys
+	ok, wasmData = isWasmPacket(contractPort, []byte(`{"memo":"first","memo":"second"}`))
+	require.True(t, ok)
+	require.Equal(t, "second", wasmData.GetMemo())
+	wasmData.SetMemo("")
+	require.Equal(t, `{"memo":"first","memo":""}`, string(wasmData.GetBytes()))
+
+	// not a contract port
+	ok, _ = isWasmPacket("transfer", bz)
+	require.False(t, ok)
+
+	// without memo
+	ok, _ = isWasmPacket(contractPort, []byte(`{"payload":{"key":"value"}}`))
+	require.False(t, ok)
+
+	// not a json object
+	ok, _ = isWasmPacket(contractPort, []byte("data"))
+	require.False(t, ok)
+	ok, _ = isWasmPacket(contractPort, []byte(`["memo", "memo"]`))
+	require.False(t, ok)
+
+	// not a string memo
+	ok, _ = isWasmPacket(contractPort, []byte(`{"memo":1}`))
+	require.False(t, ok)
+}
+
 func Test_parseHookData_without_callback(t *testing.T) {
 	memo := `{
 			"wasm" : {
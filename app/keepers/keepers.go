@@ -95,8 +95,11 @@ import (
 	"github.com/initia-labs/miniwasm/app/ante"
 	ibcwasmhooks "github.com/initia-labs/miniwasm/app/ibc-hooks"
 	bankkeeper "github.com/initia-labs/miniwasm/x/bank/keeper"
+	tokenfactorybindings "github.com/initia-labs/miniwasm/x/tokenfactory/bindings"
 	tokenfactorykeeper "github.com/initia-labs/miniwasm/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/initia-labs/miniwasm/x/tokenfactory/types"
+	wasmextensionkeeper "github.com/initia-labs/miniwasm/x/wasmextension/keeper"
+	wasmextensiontypes "github.com/initia-labs/miniwasm/x/wasmextension/types"
 
 	// noble forwarding keeper
 	forwarding "github.com/noble-assets/forwarding/v2"
@@ -132,6 +135,7 @@ type AppKeepers struct {
 	OracleKeeper          *oraclekeeper.Keeper // x/oracle keeper used for the connect oracle
 	MarketMapKeeper       *marketmapkeeper.Keeper
 	TokenFactoryKeeper    *tokenfactorykeeper.Keeper
+	WasmExtensionKeeper   *wasmextensionkeeper.Keeper
 	IBCHooksKeeper        *ibchookskeeper.Keeper
 	ForwardingKeeper      *forwardingkeeper.Keeper
 	RatelimitKeeper       *ratelimitkeeper.Keeper
@@ -202,6 +206,9 @@ func NewAppKeeper(
 
 	// add keepers
 	appKeepers.WasmKeeper = &wasmkeeper.Keeper{}
+	// the wasm extension keeper is set after the ibc stacks are created, which
+	// queue the failed ibc hook callbacks to it
+	appKeepers.WasmExtensionKeeper = &wasmextensionkeeper.Keeper{}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
 		appCodec,
@@ -461,7 +468,7 @@ func NewAppKeeper(
 			// ics4wrapper: transfer -> packet forward -> rate limit -> ibchooks -> fee
 			appKeepers.IBCFeeKeeper,
 			appKeepers.IBCHooksKeeper,
-			ibcwasmhooks.NewWasmHooks(appCodec, ac, appKeepers.WasmKeeper, appKeepers.OPChildKeeper),
+			ibcwasmhooks.NewWasmHooks(appCodec, ac, appKeepers.WasmKeeper, appKeepers.BankKeeper, appKeepers.TransferKeeper, appKeepers.WasmExtensionKeeper, appKeepers.OPChildKeeper, appKeepers.WasmExtensionKeeper),
 		)
 		transferStack = ibchooks.NewIBCMiddleware(
 			// receive: wasm -> migration -> rate limit -> packet forward -> forwarding -> transfer
@@ -495,6 +502,15 @@ func NewAppKeeper(
 	var icaHostStack porttypes.IBCModule
 	var icaControllerStack porttypes.IBCModule
 	{
+		// create wasm middleware for ica controller to register the async
+		// callbacks of the interchain account packets
+		ibcHooksICS4Wrapper := ibchooks.NewICS4Middleware(
+			// ics4wrapper: ica controller -> ibchooks -> fee
+			appKeepers.IBCFeeKeeper,
+			appKeepers.IBCHooksKeeper,
+			ibcwasmhooks.NewWasmHooks(appCodec, ac, appKeepers.WasmKeeper, appKeepers.BankKeeper, appKeepers.TransferKeeper, appKeepers.WasmExtensionKeeper, appKeepers.OPChildKeeper, appKeepers.WasmExtensionKeeper),
+		)
+
 		icaHostKeeper := icahostkeeper.NewKeeper(
 			appCodec, appKeepers.keys[icahosttypes.StoreKey],
 			nil, // we don't need migration
@@ -512,7 +528,7 @@ func NewAppKeeper(
 		icaControllerKeeper := icacontrollerkeeper.NewKeeper(
 			appCodec, appKeepers.keys[icacontrollertypes.StoreKey],
 			nil, // we don't need migration
-			appKeepers.IBCFeeKeeper,
+			ibcHooksICS4Wrapper,
 			appKeepers.IBCKeeper.ChannelKeeper,
 			appKeepers.IBCKeeper.PortKeeper,
 			appKeepers.ScopedICAControllerKeeper,
@@ -533,7 +549,17 @@ func NewAppKeeper(
 		icaHostIBCModule := icahost.NewIBCModule(*appKeepers.ICAHostKeeper)
 		icaHostStack = ibcfee.NewIBCMiddleware(icaHostIBCModule, *appKeepers.IBCFeeKeeper)
 		icaControllerIBCModule := icacontroller.NewIBCMiddleware(icaAuthIBCModule, *appKeepers.ICAControllerKeeper)
-		icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerIBCModule, *appKeepers.IBCFeeKeeper)
+		icaControllerStack = ibchooks.NewIBCMiddleware(
+			// receive: hook -> ica controller -> ica auth
+			icaControllerIBCModule,
+			ibcHooksICS4Wrapper,
+			appKeepers.IBCHooksKeeper,
+		)
+		icaControllerStack = ibcfee.NewIBCMiddleware(
+			// receive: fee -> hook -> ica controller -> ica auth
+			icaControllerStack,
+			*appKeepers.IBCFeeKeeper,
+		)
 	}
 
 	//////////////////////////////
@@ -556,7 +582,7 @@ func NewAppKeeper(
 			// ics4wrapper: wasm -> ibchooks -> fee
 			appKeepers.IBCFeeKeeper,
 			appKeepers.IBCHooksKeeper,
-			ibcwasmhooks.NewWasmHooks(appCodec, ac, appKeepers.WasmKeeper, appKeepers.OPChildKeeper),
+			ibcwasmhooks.NewWasmHooks(appCodec, ac, appKeepers.WasmKeeper, appKeepers.BankKeeper, appKeepers.TransferKeeper, appKeepers.WasmExtensionKeeper, appKeepers.OPChildKeeper, appKeepers.WasmExtensionKeeper),
 		)
 		hookMiddleware := ibchooks.NewIBCMiddleware(
 			// receive: hook -> wasm
@@ -590,6 +616,35 @@ func NewAppKeeper(
 		WithTransferKeeper(appKeepers.TransferKeeper).
 		WithChannelKeeper(appKeepers.IBCKeeper.ChannelKeeper)
 
+	////////////////////////////////
+	// TokenFactory Configuration //
+	////////////////////////////////
+
+	// the contract keeper is set after the wasm keeper is created
+	tokenfactoryKeeper := tokenfactorykeeper.NewKeeper(
+		ac,
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[tokenfactorytypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		communityPoolKeeper,
+		appKeepers.OPChildKeeper,
+		authorityAddr,
+	)
+	appKeepers.TokenFactoryKeeper = &tokenfactoryKeeper
+
+	/////////////////////////////////
+	// WasmExtension Configuration //
+	/////////////////////////////////
+
+	wasmExtensionKeeper := wasmextensionkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[wasmextensiontypes.StoreKey]),
+		appKeepers.WasmKeeper,
+		authorityAddr,
+	)
+	*appKeepers.WasmExtensionKeeper = wasmExtensionKeeper
+
 	//////////////////////////////
 	// WasmKeeper Configuration //
 	//////////////////////////////
@@ -601,11 +656,24 @@ func NewAppKeeper(
 	queryAllowlist["/connect.oracle.v2.Query/GetPrice"] = func() proto.Message { return &oracletypes.GetPriceResponse{} }
 	queryAllowlist["/connect.oracle.v2.Query/GetPrices"] = func() proto.Message { return &oracletypes.GetPricesResponse{} }
 
-	// use accept list stargate querier
+	// use accept list stargate querier, extended by the governance controlled
+	// allowlist, and allow contracts to read tokenfactory state via
+	// `{"token_factory": {...}}` custom queries
 	wasmOpts = append(wasmOpts, wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
-		Stargate: wasmkeeper.AcceptListStargateQuerier(queryAllowlist, bApp.GRPCQueryRouter(), appCodec),
+		Stargate: appKeepers.WasmExtensionKeeper.StargateQuerier(queryAllowlist, bApp.GRPCQueryRouter(), appCodec),
+		Custom:   tokenfactorybindings.CustomQuerier(appKeepers.TokenFactoryKeeper, appKeepers.BankKeeper),
 	}))
 
+	// allow contracts to manage their own denoms via `{"token_factory": {...}}` custom messages
+	wasmOpts = append(wasmOpts, wasmkeeper.WithMessageHandlerDecorator(
+		tokenfactorybindings.CustomMessageDecorator(appKeepers.TokenFactoryKeeper),
+	))
+
+	// only allow stargate messages in the governance controlled allowlist
+	wasmOpts = append(wasmOpts, wasmkeeper.WithMessageHandlerDecorator(
+		appKeepers.WasmExtensionKeeper.StargateMsgDecorator(),
+	))
+
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	*appKeepers.WasmKeeper = wasmkeeper.NewKeeper(
@@ -635,16 +703,6 @@ func NewAppKeeper(
 
 	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(appKeepers.WasmKeeper)
 
-	tokenfactoryKeeper := tokenfactorykeeper.NewKeeper(
-		ac,
-		appCodec,
-		runtime.NewKVStoreService(appKeepers.keys[tokenfactorytypes.StoreKey]),
-		appKeepers.AccountKeeper,
-		appKeepers.BankKeeper,
-		communityPoolKeeper,
-		authorityAddr,
-	)
-	appKeepers.TokenFactoryKeeper = &tokenfactoryKeeper
 	appKeepers.TokenFactoryKeeper.SetContractKeeper(contractKeeper)
 
 	appKeepers.BankKeeper.SetHooks(appKeepers.TokenFactoryKeeper.Hooks())
@@ -40,6 +40,7 @@ import (
 
 	// local imports
 	tokenfactorytypes "github.com/initia-labs/miniwasm/x/tokenfactory/types"
+	wasmextensiontypes "github.com/initia-labs/miniwasm/x/wasmextension/types"
 
 	// noble forwarding keeper
 	forwardingtypes "github.com/noble-assets/forwarding/v2/types"
@@ -54,7 +55,7 @@ func KVStoreKeys() []string {
 		ibcfeetypes.StoreKey, wasmtypes.StoreKey, opchildtypes.StoreKey,
 		packetforwardtypes.StoreKey, oracletypes.StoreKey,
 		tokenfactorytypes.StoreKey, ibchookstypes.StoreKey, forwardingtypes.StoreKey,
-		marketmaptypes.StoreKey, ratelimittypes.StoreKey,
+		marketmaptypes.StoreKey, ratelimittypes.StoreKey, wasmextensiontypes.StoreKey,
 	}
 }
 func (appKeepers *AppKeepers) GenerateKeys() {
@@ -62,6 +62,7 @@ import (
 	"github.com/initia-labs/miniwasm/x/tokenfactory"
 	tokenfactorytypes "github.com/initia-labs/miniwasm/x/tokenfactory/types"
 	"github.com/initia-labs/miniwasm/x/wasmextension"
+	wasmextensiontypes "github.com/initia-labs/miniwasm/x/wasmextension/types"
 
 	// noble forwarding keeper
 	forwarding "github.com/noble-assets/forwarding/v2"
@@ -116,7 +117,7 @@ func appModules(
 		oracle.NewAppModule(app.appCodec, *app.OracleKeeper),
 		marketmap.NewAppModule(app.appCodec, app.MarketMapKeeper),
 
-		wasmextension.NewAppModule(app.appCodec, app.WasmKeeper, app.OPChildKeeper.GetAuthority()),
+		wasmextension.NewAppModule(app.appCodec, app.WasmExtensionKeeper),
 	}
 }
 
@@ -193,6 +194,6 @@ func orderInitBlockers() []string {
 		icaauthtypes.ModuleName, ibcfeetypes.ModuleName,
 		wasmtypes.ModuleName, oracletypes.ModuleName, marketmaptypes.ModuleName,
 		packetforwardtypes.ModuleName, tokenfactorytypes.ModuleName,
-		ibchookstypes.ModuleName, forwardingtypes.ModuleName,
+		ibchookstypes.ModuleName, forwardingtypes.ModuleName, wasmextensiontypes.ModuleName,
 	}
 }
@@ -0,0 +1,42 @@
+package v1_4_0
+
+import (
+	"context"
+
+	tmos "github.com/cometbft/cometbft/libs/os"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/initia-labs/miniwasm/app/upgrades"
+	wasmextensiontypes "github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+const upgradeName = "v1.4.0"
+
+// RegisterUpgradeHandlers returns upgrade handlers
+func RegisterUpgradeHandlers(app upgrades.MinitiaApp) {
+	// apply store upgrade only if this upgrade is scheduled at a height
+	if upgradeInfo, err := app.GetUpgradeKeeper().ReadUpgradeInfoFromDisk(); err == nil {
+		if upgradeInfo.Name == upgradeName && !app.GetUpgradeKeeper().IsSkipHeight(upgradeInfo.Height) {
+			storeUpgrades := storetypes.StoreUpgrades{
+				Added: []string{wasmextensiontypes.StoreKey},
+			}
+
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		}
+	} else {
+		tmos.Exit(err.Error())
+	}
+
+	app.GetUpgradeKeeper().SetUpgradeHandler(
+		upgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+			// the wasmextension store is added by this upgrade, so its state is
+			// initialized by the module migration from version 1 to 2
+			return app.GetModuleManager().RunMigrations(ctx, app.GetConfigurator(), vm)
+		},
+	)
+}
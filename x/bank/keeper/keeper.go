@@ -45,7 +45,7 @@ func NewKeeper(
 // SendCoinsFromModuleToModule transfers coins from a ModuleAccount to another.
 // It will panic if either module account does not exist.
 // SendCoinsFromModuleToModule is the only send method that does not call both BlockBeforeSend and TrackBeforeSend hook.
-// It only calls the TrackBeforeSend hook.
+// It only calls the TrackBeforeSend hook, and the BlockBeforeModuleSend hook for the hooks which opt in.
 func (k Keeper) SendCoinsFromModuleToModule(
 	ctx context.Context, senderModule, recipientModule string, amt sdk.Coins,
 ) error {
@@ -59,6 +59,12 @@ func (k Keeper) SendCoinsFromModuleToModule(
 		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
 	}
 
+	// BlockBeforeModuleSend hook should always be called before the TrackBeforeSend hook.
+	err := k.BlockBeforeModuleSend(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	if err != nil {
+		return err
+	}
+
 	// return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 	return k.SendCoinsWithoutBlockHook(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
@@ -96,6 +102,12 @@ type BankHooks interface {
 	BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error // Must be before any send is executed
 }
 
+// ModuleSendBankHooks is an optional extension of BankHooks to block module
+// to module sends, which do not call BlockBeforeSend.
+type ModuleSendBankHooks interface {
+	BlockBeforeModuleSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error // Must be before any send is executed
+}
+
 // TrackBeforeSend executes the TrackBeforeSend hook if registered.
 func (k Keeper) TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) {
 	if k.hooks != nil {
@@ -111,6 +123,15 @@ func (k Keeper) BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, am
 	return nil
 }
 
+// BlockBeforeModuleSend executes the BlockBeforeModuleSend hook if the
+// registered hooks implement it.
+func (k Keeper) BlockBeforeModuleSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if hooks, ok := k.hooks.(ModuleSendBankHooks); ok {
+		return hooks.BlockBeforeModuleSend(ctx, from, to, amount)
+	}
+	return nil
+}
+
 func (k *Keeper) SetHooks(bh BankHooks) *Keeper {
 	if k.hooks != nil {
 		panic("cannot set bank hooks twice")
@@ -28,6 +28,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "creator"},
 					},
 				},
+				{
+					RpcMethod: "AllDenoms",
+					Use:       "denoms",
+					Short:     "Returns a list of all factory tokens",
+				},
+				{
+					RpcMethod: "DenomsByAdmin",
+					Use:       "denoms-by-admin [admin]",
+					Short:     "Returns a list of the tokens administered by a specific address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "admin"},
+					},
+				},
+				{
+					RpcMethod: "DenomsByHookContract",
+					Use:       "denoms-by-hook-contract [cosmwasm-address]",
+					Short:     "Returns a list of the tokens using a specific contract as a before send hook",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cosmwasm_address"},
+					},
+				},
 				{
 					RpcMethod: "BeforeSendHookAddress",
 					Use:       "before_send_hook [denom]",
@@ -36,6 +57,88 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "denom"},
 					},
 				},
+				{
+					RpcMethod: "BeforeSendHooks",
+					Use:       "before_send_hooks [denom]",
+					Short:     "Get the ordered BeforeSend hooks for a specific denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "DenomRoles",
+					Use:       "denom-roles [denom]",
+					Short:     "Get the roles granted for a specific denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "MintAllowance",
+					Use:       "mint-allowance [denom] [minter]",
+					Short:     "Get the mint allowance of a minter of a specific denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "minter"},
+					},
+				},
+				{
+					RpcMethod: "SupplyCap",
+					Use:       "supply-cap [denom]",
+					Short:     "Get the supply cap of a specific denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "PendingAdminTransfer",
+					Use:       "pending-admin-transfer [denom]",
+					Short:     "Get the pending admin transfer of a specific denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "DenomPaused",
+					Use:       "denom-paused [denom]",
+					Short:     "Returns whether the transfers of a specific denom are paused",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "AccountFrozen",
+					Use:       "account-frozen [denom] [address]",
+					Short:     "Returns whether an account is frozen for a specific denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "FrozenAccounts",
+					Use:       "frozen-accounts [denom]",
+					Short:     "Returns a list of the accounts frozen for a specific denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "DenomStrictMode",
+					Use:       "denom-strict-mode [denom]",
+					Short:     "Returns whether the module to module sends of a specific denom go through the blocking checks",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "BeforeSendHookFailures",
+					Use:       "before-send-hook-failures [denom]",
+					Short:     "Returns the failure counter of the before send hooks of a specific denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
@@ -0,0 +1,260 @@
+package bindings
+
+import (
+	"encoding/json"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/keeper"
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+// CustomMessageDecorator returns a decorator which routes tokenfactory custom
+// messages to the tokenfactory msg server and forwards every other message
+// to the wrapped messenger.
+func CustomMessageDecorator(tokenFactoryKeeper *keeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:   old,
+			msgServer: keeper.NewMsgServerImpl(tokenFactoryKeeper),
+		}
+	}
+}
+
+type CustomMessenger struct {
+	wrapped   wasmkeeper.Messenger
+	msgServer types.MsgServer
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.Custom == nil {
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	var customMsg TokenFactoryCustomMsg
+	if err := json.Unmarshal(msg.Custom, &customMsg); err != nil {
+		return nil, nil, nil, errorsmod.Wrap(wasmtypes.ErrInvalidMsg, err.Error())
+	}
+
+	// not a tokenfactory message; let the wrapped messenger handle it.
+	if customMsg.TokenFactory == nil {
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	res, err := m.dispatchTokenFactoryMsg(ctx, contractAddr.String(), customMsg.TokenFactory)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// events are emitted to the context event manager by the msg server,
+	// so only the response data needs to be returned here.
+	result, err := sdk.WrapServiceResult(ctx, res, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return nil, [][]byte{result.Data}, [][]*codectypes.Any{result.MsgResponses}, nil
+}
+
+func (m *CustomMessenger) dispatchTokenFactoryMsg(ctx sdk.Context, sender string, tokenFactoryMsg *TokenFactoryMsg) (proto.Message, error) {
+	if n := tokenFactoryMsg.numVariants(); n > 1 {
+		return nil, errorsmod.Wrapf(wasmtypes.ErrInvalidMsg, "exactly one token_factory message variant must be set, got %d", n)
+	}
+
+	switch {
+	case tokenFactoryMsg.CreateDenom != nil:
+		return m.msgServer.CreateDenom(ctx, &types.MsgCreateDenom{
+			Sender:              sender,
+			Subdenom:            tokenFactoryMsg.CreateDenom.Subdenom,
+			EnableBurnFrom:      tokenFactoryMsg.CreateDenom.EnableBurnFrom,
+			EnableForceTransfer: tokenFactoryMsg.CreateDenom.EnableForceTransfer,
+		})
+	case tokenFactoryMsg.Mint != nil:
+		mint := tokenFactoryMsg.Mint
+		if mint.Amount.IsNil() {
+			return nil, errorsmod.Wrap(wasmtypes.ErrInvalidMsg, "mint amount is required")
+		}
+
+		return m.msgServer.Mint(ctx, &types.MsgMint{
+			Sender:        sender,
+			Amount:        sdk.Coin{Denom: mint.Denom, Amount: mint.Amount},
+			MintToAddress: mint.MintToAddress,
+		})
+	case tokenFactoryMsg.Burn != nil:
+		burn := tokenFactoryMsg.Burn
+		if burn.Amount.IsNil() {
+			return nil, errorsmod.Wrap(wasmtypes.ErrInvalidMsg, "burn amount is required")
+		}
+
+		return m.msgServer.Burn(ctx, &types.MsgBurn{
+			Sender: sender,
+			Amount: sdk.Coin{Denom: burn.Denom, Amount: burn.Amount},
+		})
+	case tokenFactoryMsg.BurnFrom != nil:
+		burnFrom := tokenFactoryMsg.BurnFrom
+		if burnFrom.Amount.IsNil() {
+			return nil, errorsmod.Wrap(wasmtypes.ErrInvalidMsg, "burn amount is required")
+		}
+
+		return m.msgServer.BurnFrom(ctx, &types.MsgBurnFrom{
+			Sender:          sender,
+			Amount:          sdk.Coin{Denom: burnFrom.Denom, Amount: burnFrom.Amount},
+			BurnFromAddress: burnFrom.BurnFromAddress,
+		})
+	case tokenFactoryMsg.ForceTransfer != nil:
+		forceTransfer := tokenFactoryMsg.ForceTransfer
+		if forceTransfer.Amount.IsNil() {
+			return nil, errorsmod.Wrap(wasmtypes.ErrInvalidMsg, "transfer amount is required")
+		}
+
+		return m.msgServer.ForceTransfer(ctx, &types.MsgForceTransfer{
+			Sender:              sender,
+			Amount:              sdk.Coin{Denom: forceTransfer.Denom, Amount: forceTransfer.Amount},
+			TransferFromAddress: forceTransfer.FromAddress,
+			TransferToAddress:   forceTransfer.ToAddress,
+		})
+	case tokenFactoryMsg.DisableCapabilities != nil:
+		return m.msgServer.DisableDenomCapabilities(ctx, &types.MsgDisableDenomCapabilities{
+			Sender:               sender,
+			Denom:                tokenFactoryMsg.DisableCapabilities.Denom,
+			DisableBurnFrom:      tokenFactoryMsg.DisableCapabilities.DisableBurnFrom,
+			DisableForceTransfer: tokenFactoryMsg.DisableCapabilities.DisableForceTransfer,
+		})
+	case tokenFactoryMsg.GrantRole != nil:
+		role, err := types.ParseDenomRole(tokenFactoryMsg.GrantRole.Role)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.msgServer.GrantRole(ctx, &types.MsgGrantRole{
+			Sender:  sender,
+			Denom:   tokenFactoryMsg.GrantRole.Denom,
+			Role:    role,
+			Address: tokenFactoryMsg.GrantRole.Address,
+		})
+	case tokenFactoryMsg.RevokeRole != nil:
+		role, err := types.ParseDenomRole(tokenFactoryMsg.RevokeRole.Role)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.msgServer.RevokeRole(ctx, &types.MsgRevokeRole{
+			Sender:  sender,
+			Denom:   tokenFactoryMsg.RevokeRole.Denom,
+			Role:    role,
+			Address: tokenFactoryMsg.RevokeRole.Address,
+		})
+	case tokenFactoryMsg.SetMintAllowance != nil:
+		setMintAllowance := tokenFactoryMsg.SetMintAllowance
+		msg := &types.MsgSetMintAllowance{
+			Sender:    sender,
+			Denom:     setMintAllowance.Denom,
+			Minter:    setMintAllowance.Minter,
+			Allowance: math.ZeroInt(),
+			Unlimited: setMintAllowance.Allowance == nil,
+		}
+		if setMintAllowance.Allowance != nil {
+			msg.Allowance = *setMintAllowance.Allowance
+		}
+
+		return m.msgServer.SetMintAllowance(ctx, msg)
+	case tokenFactoryMsg.SetSupplyCap != nil:
+		setSupplyCap := tokenFactoryMsg.SetSupplyCap
+		if setSupplyCap.SupplyCap.IsNil() {
+			return nil, errorsmod.Wrap(wasmtypes.ErrInvalidMsg, "supply cap is required")
+		}
+
+		return m.msgServer.SetSupplyCap(ctx, &types.MsgSetSupplyCap{
+			Sender:    sender,
+			Denom:     setSupplyCap.Denom,
+			SupplyCap: setSupplyCap.SupplyCap,
+		})
+	case tokenFactoryMsg.SetDenomPaused != nil:
+		return m.msgServer.SetDenomPaused(ctx, &types.MsgSetDenomPaused{
+			Sender: sender,
+			Denom:  tokenFactoryMsg.SetDenomPaused.Denom,
+			Paused: tokenFactoryMsg.SetDenomPaused.Paused,
+		})
+	case tokenFactoryMsg.SetAccountFrozen != nil:
+		return m.msgServer.SetAccountFrozen(ctx, &types.MsgSetAccountFrozen{
+			Sender:  sender,
+			Denom:   tokenFactoryMsg.SetAccountFrozen.Denom,
+			Address: tokenFactoryMsg.SetAccountFrozen.Address,
+			Frozen:  tokenFactoryMsg.SetAccountFrozen.Frozen,
+		})
+	case tokenFactoryMsg.SetDenomStrictMode != nil:
+		return m.msgServer.SetDenomStrictMode(ctx, &types.MsgSetDenomStrictMode{
+			Sender: sender,
+			Denom:  tokenFactoryMsg.SetDenomStrictMode.Denom,
+			Strict: tokenFactoryMsg.SetDenomStrictMode.Strict,
+		})
+	case tokenFactoryMsg.ChangeAdmin != nil:
+		return m.msgServer.ChangeAdmin(ctx, &types.MsgChangeAdmin{
+			Sender:   sender,
+			Denom:    tokenFactoryMsg.ChangeAdmin.Denom,
+			NewAdmin: tokenFactoryMsg.ChangeAdmin.NewAdminAddress,
+		})
+	case tokenFactoryMsg.ProposeAdmin != nil:
+		proposeAdmin := tokenFactoryMsg.ProposeAdmin
+		msg := &types.MsgProposeAdmin{
+			Sender:   sender,
+			Denom:    proposeAdmin.Denom,
+			NewAdmin: proposeAdmin.NewAdminAddress,
+		}
+		if proposeAdmin.ExpiresAt != 0 {
+			msg.ExpiresAt = time.Unix(0, int64(proposeAdmin.ExpiresAt)).UTC()
+		}
+
+		return m.msgServer.ProposeAdmin(ctx, msg)
+	case tokenFactoryMsg.AcceptAdmin != nil:
+		return m.msgServer.AcceptAdmin(ctx, &types.MsgAcceptAdmin{
+			Sender: sender,
+			Denom:  tokenFactoryMsg.AcceptAdmin.Denom,
+		})
+	case tokenFactoryMsg.CancelAdminTransfer != nil:
+		return m.msgServer.CancelAdminTransfer(ctx, &types.MsgCancelAdminTransfer{
+			Sender: sender,
+			Denom:  tokenFactoryMsg.CancelAdminTransfer.Denom,
+		})
+	case tokenFactoryMsg.RenounceAdmin != nil:
+		return m.msgServer.RenounceAdmin(ctx, &types.MsgRenounceAdmin{
+			Sender: sender,
+			Denom:  tokenFactoryMsg.RenounceAdmin.Denom,
+		})
+	case tokenFactoryMsg.SetMetadata != nil:
+		return m.msgServer.SetDenomMetadata(ctx, &types.MsgSetDenomMetadata{
+			Sender:   sender,
+			Metadata: tokenFactoryMsg.SetMetadata.Metadata.ToBankMetadata(),
+		})
+	case tokenFactoryMsg.SetBeforeSendHook != nil:
+		return m.msgServer.SetBeforeSendHook(ctx, &types.MsgSetBeforeSendHook{
+			Sender:          sender,
+			Denom:           tokenFactoryMsg.SetBeforeSendHook.Denom,
+			CosmwasmAddress: tokenFactoryMsg.SetBeforeSendHook.CosmwasmAddress,
+			GasLimit:        tokenFactoryMsg.SetBeforeSendHook.GasLimit,
+		})
+	case tokenFactoryMsg.SetBeforeSendHooks != nil:
+		return m.msgServer.SetBeforeSendHooks(ctx, &types.MsgSetBeforeSendHooks{
+			Sender:            sender,
+			Denom:             tokenFactoryMsg.SetBeforeSendHooks.Denom,
+			CosmwasmAddresses: tokenFactoryMsg.SetBeforeSendHooks.CosmwasmAddresses,
+			GasLimit:          tokenFactoryMsg.SetBeforeSendHooks.GasLimit,
+		})
+	default:
+		return nil, errorsmod.Wrap(wasmtypes.ErrUnknownMsg, "unknown token_factory message variant")
+	}
+}
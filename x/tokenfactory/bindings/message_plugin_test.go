@@ -0,0 +1,166 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	minitiaapp "github.com/initia-labs/miniwasm/app"
+	"github.com/initia-labs/miniwasm/x/tokenfactory/bindings"
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+type mockMessenger struct {
+	called bool
+}
+
+func (m *mockMessenger) DispatchMsg(_ sdk.Context, _ sdk.AccAddress, _ string, _ wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	m.called = true
+	return nil, nil, nil, nil
+}
+
+func customMsg(t *testing.T, msg bindings.TokenFactoryMsg) wasmvmtypes.CosmosMsg {
+	bz, err := json.Marshal(bindings.TokenFactoryCustomMsg{TokenFactory: &msg})
+	require.NoError(t, err)
+
+	return wasmvmtypes.CosmosMsg{Custom: bz}
+}
+
+func setupMessenger(t *testing.T) (*minitiaapp.MinitiaApp, sdk.Context, *mockMessenger, wasmkeeper.Messenger) {
+	app := minitiaapp.SetupWithGenesisAccounts(t.TempDir(), nil, nil)
+	ctx := app.NewContext(true)
+
+	wrapped := &mockMessenger{}
+	messenger := bindings.CustomMessageDecorator(app.TokenFactoryKeeper)(wrapped)
+
+	return app, ctx, wrapped, messenger
+}
+
+func TestCustomMessenger_TokenFactoryMsgs(t *testing.T) {
+	app, ctx, wrapped, messenger := setupMessenger(t)
+
+	contractAddr := sdk.AccAddress("contract_addr_______")
+	recipient := sdk.AccAddress("recipient_addr______")
+	newAdmin := sdk.AccAddress("new_admin_addr______")
+	denom := fmt.Sprintf("factory/%s/bitcoin", contractAddr.String())
+
+	// create denom
+	_, data, msgResponses, err := messenger.DispatchMsg(ctx, contractAddr, "", customMsg(t, bindings.TokenFactoryMsg{
+		CreateDenom: &bindings.CreateDenom{Subdenom: "bitcoin"},
+	}))
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	require.Len(t, msgResponses, 1)
+
+	var createRes types.MsgCreateDenomResponse
+	require.NoError(t, app.AppCodec().Unmarshal(data[0], &createRes))
+	require.Equal(t, denom, createRes.NewTokenDenom)
+
+	// mint to the contract itself and to the recipient
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", customMsg(t, bindings.TokenFactoryMsg{
+		Mint: &bindings.MintTokens{Denom: denom, Amount: math.NewInt(100), MintToAddress: contractAddr.String()},
+	}))
+	require.NoError(t, err)
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", customMsg(t, bindings.TokenFactoryMsg{
+		Mint: &bindings.MintTokens{Denom: denom, Amount: math.NewInt(50), MintToAddress: recipient.String()},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), app.BankKeeper.GetBalance(ctx, contractAddr, denom).Amount)
+	require.Equal(t, math.NewInt(50), app.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+
+	// burn from the contract balance
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", customMsg(t, bindings.TokenFactoryMsg{
+		Burn: &bindings.BurnTokens{Denom: denom, Amount: math.NewInt(40)},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(60), app.BankKeeper.GetBalance(ctx, contractAddr, denom).Amount)
+
+	// set metadata
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", customMsg(t, bindings.TokenFactoryMsg{
+		SetMetadata: &bindings.SetMetadata{Metadata: bindings.Metadata{
+			Description: "wrapped bitcoin",
+			DenomUnits: []bindings.DenomUnit{
+				{Denom: denom, Exponent: 0},
+				{Denom: "BTC", Exponent: 8},
+			},
+			Base:    denom,
+			Display: "BTC",
+			Name:    "Bitcoin",
+			Symbol:  "BTC",
+		}},
+	}))
+	require.NoError(t, err)
+	metadata, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, "BTC", metadata.Symbol)
+
+	// change admin
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", customMsg(t, bindings.TokenFactoryMsg{
+		ChangeAdmin: &bindings.ChangeAdmin{Denom: denom, NewAdminAddress: newAdmin.String()},
+	}))
+	require.NoError(t, err)
+	authorityMetadata, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, newAdmin.String(), authorityMetadata.Admin)
+
+	// the contract is no longer the admin
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", customMsg(t, bindings.TokenFactoryMsg{
+		Mint: &bindings.MintTokens{Denom: denom, Amount: math.NewInt(1), MintToAddress: contractAddr.String()},
+	}))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	require.False(t, wrapped.called)
+}
+
+func TestCustomMessenger_Routing(t *testing.T) {
+	app, ctx, wrapped, messenger := setupMessenger(t)
+	contractAddr := sdk.AccAddress("contract_addr_______")
+
+	// non custom messages are forwarded
+	_, _, _, err := messenger.DispatchMsg(ctx, contractAddr, "", wasmvmtypes.CosmosMsg{
+		Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: contractAddr.String()}},
+	})
+	require.NoError(t, err)
+	require.True(t, wrapped.called)
+
+	// custom messages of other modules are forwarded
+	wrapped.called = false
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", wasmvmtypes.CosmosMsg{Custom: []byte(`{"other": {}}`)})
+	require.NoError(t, err)
+	require.True(t, wrapped.called)
+
+	// malformed custom message
+	wrapped.called = false
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", wasmvmtypes.CosmosMsg{Custom: []byte(`{"token_factory": 1}`)})
+	require.ErrorIs(t, err, wasmtypes.ErrInvalidMsg)
+	require.False(t, wrapped.called)
+
+	// empty token_factory message
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", wasmvmtypes.CosmosMsg{Custom: []byte(`{"token_factory": {}}`)})
+	require.ErrorIs(t, err, wasmtypes.ErrUnknownMsg)
+	require.False(t, wrapped.called)
+
+	// multiple token_factory message variants
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", customMsg(t, bindings.TokenFactoryMsg{
+		CreateDenom: &bindings.CreateDenom{Subdenom: "first"},
+		Burn:        &bindings.BurnTokens{},
+	}))
+	require.ErrorIs(t, err, wasmtypes.ErrInvalidMsg)
+	require.False(t, wrapped.called)
+
+	// no denom is created by the rejected message
+	denom := fmt.Sprintf("factory/%s/first", contractAddr)
+	_, err = app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	require.Error(t, err)
+}
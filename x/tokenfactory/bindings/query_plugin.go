@@ -0,0 +1,203 @@
+package bindings
+
+import (
+	"encoding/json"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/keeper"
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+// CustomQuerier returns a custom querier which answers `{"token_factory": {...}}`
+// queries from the tokenfactory state.
+func CustomQuerier(tokenFactoryKeeper *keeper.Keeper, bankKeeper types.BankKeeper) wasmkeeper.CustomQuerier {
+	querier := keeper.Querier{Keeper: tokenFactoryKeeper}
+
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var customQuery TokenFactoryCustomQuery
+		if err := json.Unmarshal(request, &customQuery); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+
+		if customQuery.TokenFactory == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+
+		res, err := queryTokenFactory(ctx, querier, bankKeeper, customQuery.TokenFactory)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+
+		return bz, nil
+	}
+}
+
+func queryTokenFactory(ctx sdk.Context, querier keeper.Querier, bankKeeper types.BankKeeper, query *TokenFactoryQuery) (any, error) {
+	if n := query.numVariants(); n > 1 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "exactly one token_factory query variant must be set, got %d", n)
+	}
+
+	switch {
+	case query.FullDenom != nil:
+		denom, err := types.GetTokenDenom(query.FullDenom.CreatorAddr, query.FullDenom.Subdenom)
+		if err != nil {
+			return nil, err
+		}
+
+		return FullDenomResponse{Denom: denom}, nil
+	case query.Admin != nil:
+		res, err := querier.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: query.Admin.Denom})
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", query.Admin.Denom)
+		} else if err != nil {
+			return nil, err
+		}
+
+		return AdminResponse{Admin: res.AuthorityMetadata.Admin}, nil
+	case query.Metadata != nil:
+		metadata, found := bankKeeper.GetDenomMetaData(ctx, query.Metadata.Denom)
+		if !found {
+			return MetadataResponse{}, nil
+		}
+
+		res := NewMetadata(metadata)
+		return MetadataResponse{Metadata: &res}, nil
+	case query.DenomsByCreator != nil:
+		res, err := querier.DenomsFromCreator(ctx, &types.QueryDenomsFromCreatorRequest{Creator: query.DenomsByCreator.Creator})
+		if err != nil {
+			return nil, err
+		}
+
+		return DenomsByCreatorResponse{Denoms: res.Denoms}, nil
+	case query.BeforeSendHook != nil:
+		res, err := querier.BeforeSendHookAddress(ctx, &types.QueryBeforeSendHookAddressRequest{Denom: query.BeforeSendHook.Denom})
+		if err != nil {
+			return nil, err
+		}
+
+		return BeforeSendHookResponse{CosmwasmAddress: res.CosmwasmAddress, GasLimit: res.GasLimit}, nil
+	case query.BeforeSendHooks != nil:
+		res, err := querier.BeforeSendHooks(ctx, &types.QueryBeforeSendHooksRequest{Denom: query.BeforeSendHooks.Denom})
+		if err != nil {
+			return nil, err
+		}
+
+		return BeforeSendHooksResponse{CosmwasmAddresses: res.CosmwasmAddresses, GasLimit: res.GasLimit}, nil
+	case query.MintAllowance != nil:
+		res, err := querier.MintAllowance(ctx, &types.QueryMintAllowanceRequest{Denom: query.MintAllowance.Denom, Minter: query.MintAllowance.Minter})
+		if err != nil {
+			return nil, err
+		}
+
+		response := MintAllowanceResponse{IsMinter: res.IsMinter}
+		if !res.Unlimited {
+			response.Allowance = &res.Allowance
+		}
+
+		return response, nil
+	case query.SupplyCap != nil:
+		res, err := querier.SupplyCap(ctx, &types.QuerySupplyCapRequest{Denom: query.SupplyCap.Denom})
+		if err != nil {
+			return nil, err
+		}
+
+		response := SupplyCapResponse{}
+		if res.Capped {
+			response.SupplyCap = &res.SupplyCap
+		}
+
+		return response, nil
+	case query.PendingAdminTransfer != nil:
+		res, err := querier.PendingAdminTransfer(ctx, &types.QueryPendingAdminTransferRequest{Denom: query.PendingAdminTransfer.Denom})
+		if err != nil {
+			return nil, err
+		}
+
+		response := PendingAdminTransferResponse{}
+		if transfer := res.PendingAdminTransfer; transfer != nil {
+			response.PendingAdmin = transfer.PendingAdmin
+			if !transfer.ExpiresAt.IsZero() {
+				response.ExpiresAt = wasmvmtypes.Uint64(transfer.ExpiresAt.UnixNano())
+			}
+		}
+
+		return response, nil
+	case query.DenomPaused != nil:
+		res, err := querier.DenomPaused(ctx, &types.QueryDenomPausedRequest{Denom: query.DenomPaused.Denom})
+		if err != nil {
+			return nil, err
+		}
+
+		return DenomPausedResponse{Paused: res.Paused}, nil
+	case query.AccountFrozen != nil:
+		res, err := querier.AccountFrozen(ctx, &types.QueryAccountFrozenRequest{
+			Denom:   query.AccountFrozen.Denom,
+			Address: query.AccountFrozen.Address,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return AccountFrozenResponse{Frozen: res.Frozen}, nil
+	case query.DenomStrictMode != nil:
+		res, err := querier.DenomStrictMode(ctx, &types.QueryDenomStrictModeRequest{Denom: query.DenomStrictMode.Denom})
+		if err != nil {
+			return nil, err
+		}
+
+		return DenomStrictModeResponse{Strict: res.Strict}, nil
+	case query.BeforeSendHookFailures != nil:
+		res, err := querier.BeforeSendHookFailures(ctx, &types.QueryBeforeSendHookFailuresRequest{Denom: query.BeforeSendHookFailures.Denom})
+		if err != nil {
+			return nil, err
+		}
+
+		return BeforeSendHookFailuresResponse{
+			Count:        res.Failures.Count,
+			LastHeight:   res.Failures.LastHeight,
+			LastContract: res.Failures.LastContract,
+		}, nil
+	case query.Params != nil:
+		res, err := querier.Params(ctx, &types.QueryParamsRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		return ParamsResponse{Params: Params{
+			DenomCreationFee:            wasmkeeper.ConvertSdkCoinsToWasmCoins(res.Params.DenomCreationFee),
+			DenomCreationGasConsume:     res.Params.DenomCreationGasConsume,
+			MaxBeforeSendHookGasLimit:   res.Params.MaxBeforeSendHookGasLimit,
+			DenomCreationFeeDestination: feeDestinationName(res.Params.DenomCreationFeeDestination),
+			DenomCreationFeeRecipient:   res.Params.DenomCreationFeeRecipient,
+			MaxDenomsPerCreator:         res.Params.MaxDenomsPerCreator,
+		}}, nil
+	default:
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown token_factory query variant"}
+	}
+}
+
+// feeDestinationName returns the contract facing name of the fee destination.
+func feeDestinationName(destination types.FeeDestination) string {
+	switch destination {
+	case types.FeeDestinationBurn:
+		return "burn"
+	case types.FeeDestinationAddress:
+		return "address"
+	default:
+		return "fee_collector"
+	}
+}
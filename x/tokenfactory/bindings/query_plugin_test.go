@@ -0,0 +1,119 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	minitiaapp "github.com/initia-labs/miniwasm/app"
+	"github.com/initia-labs/miniwasm/x/tokenfactory/bindings"
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+func customQuery(t *testing.T, query bindings.TokenFactoryQuery) json.RawMessage {
+	bz, err := json.Marshal(bindings.TokenFactoryCustomQuery{TokenFactory: &query})
+	require.NoError(t, err)
+
+	return bz
+}
+
+func TestCustomQuerier(t *testing.T) {
+	app := minitiaapp.SetupWithGenesisAccounts(t.TempDir(), nil, nil)
+	ctx := app.NewContext(true)
+	querier := bindings.CustomQuerier(app.TokenFactoryKeeper, app.BankKeeper)
+
+	creator := sdk.AccAddress("creator_addr________")
+	denom, err := app.TokenFactoryKeeper.CreateDenom(ctx, creator.String(), "bitcoin")
+	require.NoError(t, err)
+
+	// full denom
+	bz, err := querier(ctx, customQuery(t, bindings.TokenFactoryQuery{
+		FullDenom: &bindings.FullDenom{CreatorAddr: creator.String(), Subdenom: "bitcoin"},
+	}))
+	require.NoError(t, err)
+	var fullDenomRes bindings.FullDenomResponse
+	require.NoError(t, json.Unmarshal(bz, &fullDenomRes))
+	require.Equal(t, fmt.Sprintf("factory/%s/bitcoin", creator), fullDenomRes.Denom)
+	require.Equal(t, denom, fullDenomRes.Denom)
+
+	// admin
+	bz, err = querier(ctx, customQuery(t, bindings.TokenFactoryQuery{
+		Admin: &bindings.DenomAdmin{Denom: denom},
+	}))
+	require.NoError(t, err)
+	var adminRes bindings.AdminResponse
+	require.NoError(t, json.Unmarshal(bz, &adminRes))
+	require.Equal(t, creator.String(), adminRes.Admin)
+
+	_, err = querier(ctx, customQuery(t, bindings.TokenFactoryQuery{
+		Admin: &bindings.DenomAdmin{Denom: "factory/unknown/denom"},
+	}))
+	require.ErrorIs(t, err, types.ErrDenomDoesNotExist)
+
+	// metadata
+	bz, err = querier(ctx, customQuery(t, bindings.TokenFactoryQuery{
+		Metadata: &bindings.DenomMetadata{Denom: denom},
+	}))
+	require.NoError(t, err)
+	var metadataRes bindings.MetadataResponse
+	require.NoError(t, json.Unmarshal(bz, &metadataRes))
+	require.NotNil(t, metadataRes.Metadata)
+	require.Equal(t, denom, metadataRes.Metadata.Base)
+
+	bz, err = querier(ctx, customQuery(t, bindings.TokenFactoryQuery{
+		Metadata: &bindings.DenomMetadata{Denom: "unknown"},
+	}))
+	require.NoError(t, err)
+	metadataRes = bindings.MetadataResponse{}
+	require.NoError(t, json.Unmarshal(bz, &metadataRes))
+	require.Nil(t, metadataRes.Metadata)
+
+	// denoms by creator
+	bz, err = querier(ctx, customQuery(t, bindings.TokenFactoryQuery{
+		DenomsByCreator: &bindings.DenomsByCreator{Creator: creator.String()},
+	}))
+	require.NoError(t, err)
+	var denomsRes bindings.DenomsByCreatorResponse
+	require.NoError(t, json.Unmarshal(bz, &denomsRes))
+	require.Equal(t, []string{denom}, denomsRes.Denoms)
+
+	// before send hook
+	bz, err = querier(ctx, customQuery(t, bindings.TokenFactoryQuery{
+		BeforeSendHook: &bindings.BeforeSendHook{Denom: denom},
+	}))
+	require.NoError(t, err)
+	var hookRes bindings.BeforeSendHookResponse
+	require.NoError(t, json.Unmarshal(bz, &hookRes))
+	require.Empty(t, hookRes.CosmwasmAddress)
+
+	// params
+	bz, err = querier(ctx, customQuery(t, bindings.TokenFactoryQuery{
+		Params: &bindings.GetParams{},
+	}))
+	require.NoError(t, err)
+	var paramsRes bindings.ParamsResponse
+	require.NoError(t, json.Unmarshal(bz, &paramsRes))
+	require.Equal(t, app.TokenFactoryKeeper.GetParams(ctx).DenomCreationGasConsume, paramsRes.Params.DenomCreationGasConsume)
+
+	// unsupported and malformed queries
+	_, err = querier(ctx, []byte(`{"other": {}}`))
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+	_, err = querier(ctx, []byte(`{"token_factory": {}}`))
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+	_, err = querier(ctx, []byte(`{"token_factory": 1}`))
+	require.ErrorIs(t, err, sdkerrors.ErrJSONUnmarshal)
+
+	// multiple query variants
+	_, err = querier(ctx, customQuery(t, bindings.TokenFactoryQuery{
+		Admin:    &bindings.DenomAdmin{Denom: denom},
+		Metadata: &bindings.DenomMetadata{Denom: denom},
+	}))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
@@ -0,0 +1,442 @@
+package bindings
+
+import (
+	"reflect"
+
+	"cosmossdk.io/math"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// TokenFactoryCustomMsg is the top level enum of the custom messages a
+// contract can dispatch through CosmosMsg::Custom.
+type TokenFactoryCustomMsg struct {
+	TokenFactory *TokenFactoryMsg `json:"token_factory,omitempty"`
+}
+
+// TokenFactoryMsg contains the tokenfactory operations exposed to contracts.
+// Exactly one of the fields must be set.
+type TokenFactoryMsg struct {
+	// CreateDenom creates a new factory denom, of denomination:
+	// factory/{creating contract address}/{Subdenom}
+	CreateDenom *CreateDenom `json:"create_denom,omitempty"`
+	// Mint mints tokens of a factory denom to the given address.
+	Mint *MintTokens `json:"mint,omitempty"`
+	// Burn burns tokens of a factory denom from the contract balance.
+	Burn *BurnTokens `json:"burn,omitempty"`
+	// BurnFrom burns tokens of a factory denom from the given address.
+	BurnFrom *BurnFromTokens `json:"burn_from,omitempty"`
+	// ForceTransfer transfers tokens of a factory denom between the given addresses.
+	ForceTransfer *ForceTransfer `json:"force_transfer,omitempty"`
+	// DisableCapabilities turns off capabilities of a factory denom.
+	DisableCapabilities *DisableCapabilities `json:"disable_capabilities,omitempty"`
+	// ChangeAdmin changes the admin of a factory denom.
+	ChangeAdmin *ChangeAdmin `json:"change_admin,omitempty"`
+	// ProposeAdmin proposes a new admin of a factory denom.
+	ProposeAdmin *ProposeAdmin `json:"propose_admin,omitempty"`
+	// AcceptAdmin accepts the pending admin transfer of a factory denom.
+	AcceptAdmin *AdminTransferMsg `json:"accept_admin,omitempty"`
+	// CancelAdminTransfer cancels the pending admin transfer of a factory denom.
+	CancelAdminTransfer *AdminTransferMsg `json:"cancel_admin_transfer,omitempty"`
+	// RenounceAdmin permanently gives up the admin of a factory denom.
+	RenounceAdmin *AdminTransferMsg `json:"renounce_admin,omitempty"`
+	// SetMetadata sets the bank metadata of a factory denom.
+	SetMetadata *SetMetadata `json:"set_metadata,omitempty"`
+	// SetBeforeSendHook sets the before send hook contract of a factory denom.
+	SetBeforeSendHook *SetBeforeSendHook `json:"set_before_send_hook,omitempty"`
+	// SetBeforeSendHooks sets the ordered before send hook contracts of a factory denom.
+	SetBeforeSendHooks *SetBeforeSendHooks `json:"set_before_send_hooks,omitempty"`
+	// GrantRole grants a role of a factory denom to an address.
+	GrantRole *DenomRoleMsg `json:"grant_role,omitempty"`
+	// RevokeRole revokes a role of a factory denom from an address.
+	RevokeRole *DenomRoleMsg `json:"revoke_role,omitempty"`
+	// SetMintAllowance sets the mint allowance of a minter of a factory denom.
+	SetMintAllowance *SetMintAllowance `json:"set_mint_allowance,omitempty"`
+	// SetSupplyCap sets or lowers the max supply of a factory denom.
+	SetSupplyCap *SetSupplyCap `json:"set_supply_cap,omitempty"`
+	// SetDenomPaused pauses or resumes the transfers of a factory denom.
+	SetDenomPaused *SetDenomPaused `json:"set_denom_paused,omitempty"`
+	// SetAccountFrozen freezes or unfreezes an address for a factory denom.
+	SetAccountFrozen *SetAccountFrozen `json:"set_account_frozen,omitempty"`
+	// SetDenomStrictMode opts a factory denom in or out of the blocking checks
+	// on module to module sends.
+	SetDenomStrictMode *SetDenomStrictMode `json:"set_denom_strict_mode,omitempty"`
+}
+
+// numVariants returns the number of message variants set.
+func (m *TokenFactoryMsg) numVariants() int {
+	return countSetFields(m)
+}
+
+type CreateDenom struct {
+	Subdenom string `json:"subdenom"`
+	// EnableBurnFrom opts the denom into burn_from.
+	EnableBurnFrom bool `json:"enable_burn_from,omitempty"`
+	// EnableForceTransfer opts the denom into force_transfer.
+	EnableForceTransfer bool `json:"enable_force_transfer,omitempty"`
+}
+
+type MintTokens struct {
+	Denom         string   `json:"denom"`
+	Amount        math.Int `json:"amount"`
+	MintToAddress string   `json:"mint_to_address"`
+}
+
+type BurnTokens struct {
+	Denom  string   `json:"denom"`
+	Amount math.Int `json:"amount"`
+}
+
+type BurnFromTokens struct {
+	Denom           string   `json:"denom"`
+	Amount          math.Int `json:"amount"`
+	BurnFromAddress string   `json:"burn_from_address"`
+}
+
+type ForceTransfer struct {
+	Denom       string   `json:"denom"`
+	Amount      math.Int `json:"amount"`
+	FromAddress string   `json:"from_address"`
+	ToAddress   string   `json:"to_address"`
+}
+
+type DisableCapabilities struct {
+	Denom                string `json:"denom"`
+	DisableBurnFrom      bool   `json:"disable_burn_from,omitempty"`
+	DisableForceTransfer bool   `json:"disable_force_transfer,omitempty"`
+}
+
+// DenomRoleMsg grants or revokes a role, one of minter, burner,
+// metadata_manager or hook_manager.
+type DenomRoleMsg struct {
+	Denom   string `json:"denom"`
+	Role    string `json:"role"`
+	Address string `json:"address"`
+}
+
+type SetMintAllowance struct {
+	Denom  string `json:"denom"`
+	Minter string `json:"minter"`
+	// Allowance is the amount the minter can mint, nil removes the limit.
+	Allowance *math.Int `json:"allowance,omitempty"`
+}
+
+type SetSupplyCap struct {
+	Denom     string   `json:"denom"`
+	SupplyCap math.Int `json:"supply_cap"`
+}
+
+type SetDenomPaused struct {
+	Denom  string `json:"denom"`
+	Paused bool   `json:"paused"`
+}
+
+type SetAccountFrozen struct {
+	Denom   string `json:"denom"`
+	Address string `json:"address"`
+	Frozen  bool   `json:"frozen"`
+}
+
+type SetDenomStrictMode struct {
+	Denom  string `json:"denom"`
+	Strict bool   `json:"strict"`
+}
+
+type ChangeAdmin struct {
+	Denom           string `json:"denom"`
+	NewAdminAddress string `json:"new_admin_address"`
+}
+
+type ProposeAdmin struct {
+	Denom           string `json:"denom"`
+	NewAdminAddress string `json:"new_admin_address"`
+	// ExpiresAt is the optional expiry in unix nanoseconds, zero means no expiry.
+	ExpiresAt wasmvmtypes.Uint64 `json:"expires_at,omitempty"`
+}
+
+// AdminTransferMsg accepts or cancels the pending admin transfer, or
+// renounces the admin of a factory denom.
+type AdminTransferMsg struct {
+	Denom string `json:"denom"`
+}
+
+type SetMetadata struct {
+	Metadata Metadata `json:"metadata"`
+}
+
+type SetBeforeSendHook struct {
+	Denom           string `json:"denom"`
+	CosmwasmAddress string `json:"cosmwasm_address"`
+	// GasLimit is the optional gas limit of a hook call, zero uses the default.
+	GasLimit uint64 `json:"gas_limit,omitempty"`
+}
+
+type SetBeforeSendHooks struct {
+	Denom             string   `json:"denom"`
+	CosmwasmAddresses []string `json:"cosmwasm_addresses"`
+	// GasLimit is the optional gas limit of a hook call, zero uses the default.
+	GasLimit uint64 `json:"gas_limit,omitempty"`
+}
+
+// Metadata is the contract facing representation of bank denom metadata.
+type Metadata struct {
+	Description string      `json:"description"`
+	DenomUnits  []DenomUnit `json:"denom_units"`
+	Base        string      `json:"base"`
+	Display     string      `json:"display"`
+	Name        string      `json:"name"`
+	Symbol      string      `json:"symbol"`
+	URI         string      `json:"uri"`
+	URIHash     string      `json:"uri_hash"`
+}
+
+type DenomUnit struct {
+	Denom    string   `json:"denom"`
+	Exponent uint32   `json:"exponent"`
+	Aliases  []string `json:"aliases"`
+}
+
+// ToBankMetadata converts the contract facing metadata to the bank metadata.
+func (m Metadata) ToBankMetadata() banktypes.Metadata {
+	denomUnits := make([]*banktypes.DenomUnit, len(m.DenomUnits))
+	for i, unit := range m.DenomUnits {
+		denomUnits[i] = &banktypes.DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  unit.Aliases,
+		}
+	}
+
+	return banktypes.Metadata{
+		Description: m.Description,
+		DenomUnits:  denomUnits,
+		Base:        m.Base,
+		Display:     m.Display,
+		Name:        m.Name,
+		Symbol:      m.Symbol,
+		URI:         m.URI,
+		URIHash:     m.URIHash,
+	}
+}
+
+// TokenFactoryCustomQuery is the top level enum of the custom queries a
+// contract can send through QueryRequest::Custom.
+type TokenFactoryCustomQuery struct {
+	TokenFactory *TokenFactoryQuery `json:"token_factory,omitempty"`
+}
+
+// TokenFactoryQuery contains the tokenfactory queries exposed to contracts.
+// Exactly one of the fields must be set.
+type TokenFactoryQuery struct {
+	// FullDenom returns the full denom of the given creator and subdenom.
+	FullDenom *FullDenom `json:"full_denom,omitempty"`
+	// Admin returns the admin of a factory denom.
+	Admin *DenomAdmin `json:"admin,omitempty"`
+	// Metadata returns the bank metadata of a denom.
+	Metadata *DenomMetadata `json:"metadata,omitempty"`
+	// DenomsByCreator returns the denoms created by the given creator.
+	DenomsByCreator *DenomsByCreator `json:"denoms_by_creator,omitempty"`
+	// BeforeSendHook returns the before send hook contract of a factory denom.
+	BeforeSendHook *BeforeSendHook `json:"before_send_hook,omitempty"`
+	// BeforeSendHooks returns the ordered before send hook contracts of a factory denom.
+	BeforeSendHooks *BeforeSendHooks `json:"before_send_hooks,omitempty"`
+	// MintAllowance returns the mint allowance of a minter of a factory denom.
+	MintAllowance *MintAllowance `json:"mint_allowance,omitempty"`
+	// SupplyCap returns the max supply of a factory denom.
+	SupplyCap *SupplyCap `json:"supply_cap,omitempty"`
+	// PendingAdminTransfer returns the pending admin transfer of a factory denom.
+	PendingAdminTransfer *PendingAdminTransfer `json:"pending_admin_transfer,omitempty"`
+	// DenomPaused returns whether the transfers of a factory denom are paused.
+	DenomPaused *DenomPaused `json:"denom_paused,omitempty"`
+	// AccountFrozen returns whether an address is frozen for a factory denom.
+	AccountFrozen *AccountFrozen `json:"account_frozen,omitempty"`
+	// DenomStrictMode returns whether the module to module sends of a factory
+	// denom go through the blocking checks.
+	DenomStrictMode *DenomStrictMode `json:"denom_strict_mode,omitempty"`
+	// BeforeSendHookFailures returns the failure counter of the before send
+	// hooks of a factory denom.
+	BeforeSendHookFailures *BeforeSendHookFailures `json:"before_send_hook_failures,omitempty"`
+	// Params returns the module params.
+	Params *GetParams `json:"params,omitempty"`
+}
+
+// numVariants returns the number of query variants set.
+func (q *TokenFactoryQuery) numVariants() int {
+	return countSetFields(q)
+}
+
+type FullDenom struct {
+	CreatorAddr string `json:"creator_addr"`
+	Subdenom    string `json:"subdenom"`
+}
+
+type DenomAdmin struct {
+	Denom string `json:"denom"`
+}
+
+type DenomMetadata struct {
+	Denom string `json:"denom"`
+}
+
+type DenomsByCreator struct {
+	Creator string `json:"creator"`
+}
+
+type BeforeSendHook struct {
+	Denom string `json:"denom"`
+}
+
+type BeforeSendHooks struct {
+	Denom string `json:"denom"`
+}
+
+type MintAllowance struct {
+	Denom  string `json:"denom"`
+	Minter string `json:"minter"`
+}
+
+type SupplyCap struct {
+	Denom string `json:"denom"`
+}
+
+type PendingAdminTransfer struct {
+	Denom string `json:"denom"`
+}
+
+type DenomPaused struct {
+	Denom string `json:"denom"`
+}
+
+type AccountFrozen struct {
+	Denom   string `json:"denom"`
+	Address string `json:"address"`
+}
+
+type DenomStrictMode struct {
+	Denom string `json:"denom"`
+}
+
+type BeforeSendHookFailures struct {
+	Denom string `json:"denom"`
+}
+
+type GetParams struct{}
+
+type FullDenomResponse struct {
+	Denom string `json:"denom"`
+}
+
+type AdminResponse struct {
+	Admin string `json:"admin"`
+}
+
+type MetadataResponse struct {
+	// Metadata is nil when the denom has no bank metadata.
+	Metadata *Metadata `json:"metadata,omitempty"`
+}
+
+type DenomsByCreatorResponse struct {
+	Denoms []string `json:"denoms"`
+}
+
+type BeforeSendHookResponse struct {
+	CosmwasmAddress string `json:"cosmwasm_address"`
+	GasLimit        uint64 `json:"gas_limit"`
+}
+
+type BeforeSendHooksResponse struct {
+	CosmwasmAddresses []string `json:"cosmwasm_addresses"`
+	GasLimit          uint64   `json:"gas_limit"`
+}
+
+type MintAllowanceResponse struct {
+	IsMinter bool `json:"is_minter"`
+	// Allowance is nil when the minter can mint without limit.
+	Allowance *math.Int `json:"allowance,omitempty"`
+}
+
+type SupplyCapResponse struct {
+	// SupplyCap is nil when the denom is not capped.
+	SupplyCap *math.Int `json:"supply_cap,omitempty"`
+}
+
+type PendingAdminTransferResponse struct {
+	// PendingAdmin is empty when the denom has no pending transfer.
+	PendingAdmin string `json:"pending_admin,omitempty"`
+	// ExpiresAt is the expiry in unix nanoseconds, zero means no expiry.
+	ExpiresAt wasmvmtypes.Uint64 `json:"expires_at,omitempty"`
+}
+
+type DenomPausedResponse struct {
+	Paused bool `json:"paused"`
+}
+
+type AccountFrozenResponse struct {
+	Frozen bool `json:"frozen"`
+}
+
+type DenomStrictModeResponse struct {
+	Strict bool `json:"strict"`
+}
+
+type BeforeSendHookFailuresResponse struct {
+	Count      uint64 `json:"count"`
+	LastHeight int64  `json:"last_height"`
+	// LastContract is empty when no hook call failed.
+	LastContract string `json:"last_contract,omitempty"`
+}
+
+type ParamsResponse struct {
+	Params Params `json:"params"`
+}
+
+type Params struct {
+	DenomCreationFee          []wasmvmtypes.Coin `json:"denom_creation_fee"`
+	DenomCreationGasConsume   uint64             `json:"denom_creation_gas_consume"`
+	MaxBeforeSendHookGasLimit uint64             `json:"max_before_send_hook_gas_limit"`
+
+	// DenomCreationFeeDestination is one of fee_collector, burn or address.
+	DenomCreationFeeDestination string `json:"denom_creation_fee_destination"`
+	DenomCreationFeeRecipient   string `json:"denom_creation_fee_recipient,omitempty"`
+	MaxDenomsPerCreator         uint64 `json:"max_denoms_per_creator"`
+}
+
+// NewMetadata converts the bank metadata to the contract facing metadata.
+func NewMetadata(metadata banktypes.Metadata) Metadata {
+	denomUnits := make([]DenomUnit, len(metadata.DenomUnits))
+	for i, unit := range metadata.DenomUnits {
+		denomUnits[i] = DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  unit.Aliases,
+		}
+	}
+
+	return Metadata{
+		Description: metadata.Description,
+		DenomUnits:  denomUnits,
+		Base:        metadata.Base,
+		Display:     metadata.Display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+		URI:         metadata.URI,
+		URIHash:     metadata.URIHash,
+	}
+}
+
+// countSetFields returns the number of non-nil pointer fields of the struct
+// the given pointer points to.
+func countSetFields(v any) int {
+	rv := reflect.ValueOf(v).Elem()
+
+	n := 0
+	for i := 0; i < rv.NumField(); i++ {
+		if field := rv.Field(i); field.Kind() == reflect.Pointer && !field.IsNil() {
+			n++
+		}
+	}
+
+	return n
+}
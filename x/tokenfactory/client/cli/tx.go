@@ -1,7 +1,13 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"cosmossdk.io/core/address"
+	sdkmath "cosmossdk.io/math"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -12,6 +18,21 @@ import (
 	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
 )
 
+const (
+	// FlagHookGasLimit is the flag to set the gas limit of a before send hook
+	FlagHookGasLimit = "hook-gas-limit"
+	// FlagEnableBurnFrom is the flag to opt a new denom into burn from
+	FlagEnableBurnFrom = "enable-burn-from"
+	// FlagEnableForceTransfer is the flag to opt a new denom into force transfer
+	FlagEnableForceTransfer = "enable-force-transfer"
+	// FlagBurnFrom is the flag to disable the burn from capability
+	FlagBurnFrom = "burn-from"
+	// FlagForceTransfer is the flag to disable the force transfer capability
+	FlagForceTransfer = "force-transfer"
+	// FlagExpiresAt is the flag to set the expiry of an admin transfer
+	FlagExpiresAt = "expires-at"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(ac address.Codec) *cobra.Command {
 	txCmd := &cobra.Command{
@@ -25,9 +46,25 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 		NewCreateDenomCmd(ac),
 		NewMintCmd(ac),
 		NewBurnCmd(ac),
-		// NewForceTransferCmd(),
+		NewBurnFromCmd(ac),
+		NewForceTransferCmd(ac),
+		NewDisableDenomCapabilitiesCmd(ac),
+		NewGrantRoleCmd(ac),
+		NewRevokeRoleCmd(ac),
+		NewSetMintAllowanceCmd(ac),
+		NewSetSupplyCapCmd(ac),
+		NewPauseCmd(ac),
+		NewUnpauseCmd(ac),
+		NewFreezeCmd(ac),
+		NewUnfreezeCmd(ac),
+		NewSetStrictModeCmd(ac),
 		NewChangeAdminCmd(ac),
+		NewProposeAdminCmd(ac),
+		NewAcceptAdminCmd(ac),
+		NewCancelAdminTransferCmd(ac),
+		NewRenounceAdminCmd(ac),
 		NewSetBeforeSendHookCmd(ac),
+		NewSetBeforeSendHooksCmd(ac),
 	)
 	return txCmd
 }
@@ -48,10 +85,22 @@ func NewCreateDenomCmd(ac address.Codec) *cobra.Command {
 				return err
 			}
 
+			enableBurnFrom, err := cmd.Flags().GetBool(FlagEnableBurnFrom)
+			if err != nil {
+				return err
+			}
+
+			enableForceTransfer, err := cmd.Flags().GetBool(FlagEnableForceTransfer)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateDenom(
 				fromAddr,
 				args[0],
 			)
+			msg.EnableBurnFrom = enableBurnFrom
+			msg.EnableForceTransfer = enableForceTransfer
 
 			if err = msg.Validate(ac); err != nil {
 				return err
@@ -60,6 +109,8 @@ func NewCreateDenomCmd(ac address.Codec) *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().Bool(FlagEnableBurnFrom, false, "Allow the admin to burn tokens from any account; can only be turned off later")
+	cmd.Flags().Bool(FlagEnableForceTransfer, false, "Allow the admin to transfer tokens between any accounts; can only be turned off later")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -144,6 +195,131 @@ func NewBurnCmd(ac address.Codec) *cobra.Command {
 	return cmd
 }
 
+func NewBurnFromCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-from [amount] [burn-from-address] [flags]",
+		Short: "Burn tokens from any address. Must have admin authority and the burn from capability to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnFrom(
+				fromAddr,
+				amount,
+				args[1],
+			)
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewForceTransferCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-transfer [amount] [transfer-from-address] [transfer-to-address] [flags]",
+		Short: "Transfer tokens between any addresses. Must have admin authority and the force transfer capability to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgForceTransfer(
+				fromAddr,
+				amount,
+				args[1],
+				args[2],
+			)
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewDisableDenomCapabilitiesCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable-capabilities [denom] [flags]",
+		Short: "Turn off the burn from and/or force transfer capabilities of a factory-created denom. Must have admin authority to do so.",
+		Long: `Turn off the burn from and/or force transfer capabilities of a factory-created denom.
+Disabled capabilities cannot be enabled again.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			disableBurnFrom, err := cmd.Flags().GetBool(FlagBurnFrom)
+			if err != nil {
+				return err
+			}
+
+			disableForceTransfer, err := cmd.Flags().GetBool(FlagForceTransfer)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDisableDenomCapabilities(
+				fromAddr,
+				args[0],
+				disableBurnFrom,
+				disableForceTransfer,
+			)
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(FlagBurnFrom, false, "Disable the burn from capability")
+	cmd.Flags().Bool(FlagForceTransfer, false, "Disable the force transfer capability")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewChangeAdminCmd(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "change-admin [denom] [new-admin] [flags]",
@@ -177,6 +353,154 @@ func NewChangeAdminCmd(ac address.Codec) *cobra.Command {
 	return cmd
 }
 
+// NewProposeAdminCmd broadcast MsgProposeAdmin
+func NewProposeAdminCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-admin [denom] [new-admin] [flags]",
+		Short: "Proposes a new admin for a factory-created denom, which takes effect once the new admin accepts it. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			expiresAtStr, err := cmd.Flags().GetString(FlagExpiresAt)
+			if err != nil {
+				return err
+			}
+
+			var expiresAt time.Time
+			if expiresAtStr != "" {
+				expiresAt, err = time.Parse(time.RFC3339, expiresAtStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgProposeAdmin(
+				fromAddr,
+				args[0],
+				args[1],
+				expiresAt,
+			)
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagExpiresAt, "", "Time in RFC3339 format after which the transfer cannot be accepted; empty means no expiry")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAcceptAdminCmd broadcast MsgAcceptAdmin
+func NewAcceptAdminCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-admin [denom] [flags]",
+		Short: "Accepts the pending admin transfer of a factory-created denom. Must be the pending admin to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptAdmin(
+				fromAddr,
+				args[0],
+			)
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelAdminTransferCmd broadcast MsgCancelAdminTransfer
+func NewCancelAdminTransferCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-admin-transfer [denom] [flags]",
+		Short: "Cancels the pending admin transfer of a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAdminTransfer(
+				fromAddr,
+				args[0],
+			)
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRenounceAdminCmd broadcast MsgRenounceAdmin
+func NewRenounceAdminCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renounce-admin [denom] [flags]",
+		Short: "Permanently gives up the admin of a factory-created denom and removes its roles and capabilities. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenounceAdmin(
+				fromAddr,
+				args[0],
+			)
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewChangeAdminCmd broadcast MsgChangeAdmin
 func NewSetBeforeSendHookCmd(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -194,11 +518,368 @@ func NewSetBeforeSendHookCmd(ac address.Codec) *cobra.Command {
 				return err
 			}
 
+			gasLimit, err := cmd.Flags().GetUint64(FlagHookGasLimit)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSetBeforeSendHook(
 				fromAddr,
 				args[0],
 				args[1],
 			)
+			msg.GasLimit = gasLimit
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Uint64(FlagHookGasLimit, 0, "Gas limit of a before send hook call; zero uses the default gas limit")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetBeforeSendHooksCmd broadcast MsgSetBeforeSendHooks
+func NewSetBeforeSendHooksCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-beforesend-hooks [denom] [cosmwasm-address1,cosmwasm-address2,...] [flags]",
+		Short: "Set the ordered cosmwasm contracts to be the beforesend hooks for a factory-created denom. Must have admin authority to do so.",
+		Long: `Set the ordered cosmwasm contracts to be the beforesend hooks for a factory-created denom.
+The contracts are called in the given order. Pass an empty string to remove all hooks.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := cmd.Flags().GetUint64(FlagHookGasLimit)
+			if err != nil {
+				return err
+			}
+
+			var cosmwasmAddresses []string
+			if args[1] != "" {
+				cosmwasmAddresses = strings.Split(args[1], ",")
+			}
+
+			msg := types.NewMsgSetBeforeSendHooks(
+				fromAddr,
+				args[0],
+				cosmwasmAddresses,
+			)
+			msg.GasLimit = gasLimit
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Uint64(FlagHookGasLimit, 0, "Gas limit of a before send hook call; zero uses the default gas limit")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewGrantRoleCmd broadcast MsgGrantRole
+func NewGrantRoleCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [denom] [role] [address] [flags]",
+		Short: "Grant a role of a factory-created denom to an address. Must have admin authority to do so.",
+		Long: `Grant a role of a factory-created denom to an address.
+Roles are minter, burner, metadata-manager and hook-manager.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			role, err := types.ParseDenomRole(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantRole(
+				fromAddr,
+				args[0],
+				role,
+				args[2],
+			)
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRevokeRoleCmd broadcast MsgRevokeRole
+func NewRevokeRoleCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [denom] [role] [address] [flags]",
+		Short: "Revoke a role of a factory-created denom from an address. Must have admin authority to do so.",
+		Long: `Revoke a role of a factory-created denom from an address.
+Roles are minter, burner, metadata-manager and hook-manager.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			role, err := types.ParseDenomRole(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeRole(
+				fromAddr,
+				args[0],
+				role,
+				args[2],
+			)
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMintAllowanceCmd broadcast MsgSetMintAllowance
+func NewSetMintAllowanceCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-allowance [denom] [minter] [allowance] [flags]",
+		Short: "Set the mint allowance of a minter of a factory-created denom. Must have admin authority to do so.",
+		Long: `Set the mint allowance of a minter of a factory-created denom.
+Use "unlimited" as the allowance to remove the limit.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMintAllowance(
+				fromAddr,
+				args[0],
+				args[1],
+				sdkmath.ZeroInt(),
+			)
+
+			if args[2] == "unlimited" {
+				msg.Unlimited = true
+			} else {
+				allowance, ok := sdkmath.NewIntFromString(args[2])
+				if !ok {
+					return fmt.Errorf("invalid allowance: %s", args[2])
+				}
+				msg.Allowance = allowance
+			}
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetSupplyCapCmd broadcast MsgSetSupplyCap
+func NewSetSupplyCapCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-supply-cap [denom] [supply-cap] [flags]",
+		Short: "Set the max supply of a factory-created denom. Must have admin authority to do so.",
+		Long: `Set the max supply of a factory-created denom.
+Once set, the supply cap can only be lowered, and never below the current supply.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			supplyCap, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid supply cap: %s", args[1])
+			}
+
+			msg := types.NewMsgSetSupplyCap(
+				fromAddr,
+				args[0],
+				supplyCap,
+			)
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewPauseCmd broadcast MsgSetDenomPaused to pause a denom
+func NewPauseCmd(ac address.Codec) *cobra.Command {
+	return newSetDenomPausedCmd(ac, true, "pause [denom] [flags]",
+		"Pause the transfers of a factory-created denom. Must have admin authority to do so.")
+}
+
+// NewUnpauseCmd broadcast MsgSetDenomPaused to unpause a denom
+func NewUnpauseCmd(ac address.Codec) *cobra.Command {
+	return newSetDenomPausedCmd(ac, false, "unpause [denom] [flags]",
+		"Resume the transfers of a paused factory-created denom. Must have admin authority to do so.")
+}
+
+func newSetDenomPausedCmd(ac address.Codec, paused bool, use, short string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomPaused(
+				fromAddr,
+				args[0],
+				paused,
+			)
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewFreezeCmd broadcast MsgSetAccountFrozen to freeze an account
+func NewFreezeCmd(ac address.Codec) *cobra.Command {
+	return newSetAccountFrozenCmd(ac, true, "freeze [denom] [address] [flags]",
+		"Forbid an address to send or receive a factory-created denom. Must have admin authority to do so.")
+}
+
+// NewUnfreezeCmd broadcast MsgSetAccountFrozen to unfreeze an account
+func NewUnfreezeCmd(ac address.Codec) *cobra.Command {
+	return newSetAccountFrozenCmd(ac, false, "unfreeze [denom] [address] [flags]",
+		"Allow a frozen address to send and receive a factory-created denom again. Must have admin authority to do so.")
+}
+
+func newSetAccountFrozenCmd(ac address.Codec, frozen bool, use, short string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAccountFrozen(
+				fromAddr,
+				args[0],
+				args[1],
+				frozen,
+			)
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetStrictModeCmd broadcast MsgSetDenomStrictMode
+func NewSetStrictModeCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-strict-mode [denom] [true|false] [flags]",
+		Short: "Opt a factory-created denom in or out of the blocking checks on module to module sends. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			strict, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid strict mode: %s", args[1])
+			}
+
+			msg := types.NewMsgSetDenomStrictMode(
+				fromAddr,
+				args[0],
+				strict,
+			)
 
 			if err = msg.Validate(ac); err != nil {
 				return err
@@ -2,6 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
 )
@@ -11,13 +16,29 @@ func (k Keeper) GetAuthorityMetadata(ctx context.Context, denom string) (types.D
 	return k.DenomAuthority.Get(ctx, denom)
 }
 
-// setAuthorityMetadata stores authority metadata for a specific denom
+// setAuthorityMetadata stores authority metadata for a specific denom and
+// keeps the admin index in sync
 func (k Keeper) setAuthorityMetadata(ctx context.Context, denom string, metadata types.DenomAuthorityMetadata) error {
 	err := metadata.Validate(k.ac)
 	if err != nil {
 		return err
 	}
 
+	prevMetadata, err := k.DenomAuthority.Get(ctx, denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	} else if err == nil && prevMetadata.Admin != "" {
+		if err := k.AdminDenoms.Remove(ctx, collections.Join(prevMetadata.Admin, denom)); err != nil {
+			return err
+		}
+	}
+
+	if metadata.Admin != "" {
+		if err := k.AdminDenoms.Set(ctx, collections.Join(metadata.Admin, denom)); err != nil {
+			return err
+		}
+	}
+
 	return k.DenomAuthority.Set(ctx, denom, metadata)
 }
 
@@ -31,3 +52,153 @@ func (k Keeper) setAdmin(ctx context.Context, denom string, admin string) error
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
+
+// disableCapabilities turns off the given capabilities of the denom. The
+// capabilities can only be enabled at denom creation.
+func (k Keeper) disableCapabilities(ctx context.Context, denom string, disableBurnFrom, disableForceTransfer bool) (types.DenomAuthorityMetadata, error) {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return metadata, err
+	}
+
+	if disableBurnFrom {
+		metadata.BurnFromEnabled = false
+	}
+	if disableForceTransfer {
+		metadata.ForceTransferEnabled = false
+	}
+
+	return metadata, k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// GetPendingAdminTransfer returns the pending admin transfer of the denom, or
+// nil if there is none.
+func (k Keeper) GetPendingAdminTransfer(ctx context.Context, denom string) (*types.PendingAdminTransfer, error) {
+	transfer, err := k.PendingAdminTransfers.Get(ctx, denom)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+// proposeAdmin stores a pending admin transfer of the denom, replacing the
+// previous one if any.
+func (k Keeper) proposeAdmin(ctx context.Context, denom string, transfer types.PendingAdminTransfer) error {
+	if err := transfer.Validate(k.ac); err != nil {
+		return err
+	}
+
+	if !transfer.ExpiresAt.IsZero() && transfer.IsExpired(sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return types.ErrInvalidAdminTransfer.Wrap("expiry must be in the future")
+	}
+
+	return k.PendingAdminTransfers.Set(ctx, denom, transfer)
+}
+
+// acceptAdmin makes the sender the admin of the denom if it is the pending
+// admin of an unexpired transfer.
+func (k Keeper) acceptAdmin(ctx context.Context, denom string, sender string) error {
+	transfer, err := k.GetPendingAdminTransfer(ctx, denom)
+	if err != nil {
+		return err
+	} else if transfer == nil {
+		return types.ErrNoPendingAdminTransfer
+	}
+
+	if transfer.PendingAdmin != sender {
+		return types.ErrUnauthorized
+	}
+
+	if transfer.IsExpired(sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return types.ErrAdminTransferExpired
+	}
+
+	if err := k.PendingAdminTransfers.Remove(ctx, denom); err != nil {
+		return err
+	}
+
+	return k.setAdmin(ctx, denom, sender)
+}
+
+// cancelAdminTransfer removes the pending admin transfer of the denom.
+func (k Keeper) cancelAdminTransfer(ctx context.Context, denom string) error {
+	if has, err := k.PendingAdminTransfers.Has(ctx, denom); err != nil {
+		return err
+	} else if !has {
+		return types.ErrNoPendingAdminTransfer
+	}
+
+	return k.PendingAdminTransfers.Remove(ctx, denom)
+}
+
+// renounceAdmin removes the admin of the denom along with the pending admin
+// transfer, the roles, the mint allowances, the capabilities and the before
+// send hooks, so nobody can change the denom anymore and no hook contract
+// which nobody could replace is left on it. It is rejected while the denom has transfer
+// restrictions, which nobody could lift after the admin is gone.
+func (k Keeper) renounceAdmin(ctx context.Context, denom string) error {
+	if err := k.checkNoTransferRestrictions(ctx, denom); err != nil {
+		return err
+	}
+
+	if err := k.PendingAdminTransfers.Remove(ctx, denom); err != nil {
+		return err
+	}
+
+	if err := k.RoleGrants.Clear(ctx, collections.NewPrefixedTripleRange[string, int32, string](denom)); err != nil {
+		return err
+	}
+
+	if err := k.MintAllowances.Clear(ctx, collections.NewPrefixedPairRange[string, string](denom)); err != nil {
+		return err
+	}
+
+	if _, err := k.disableCapabilities(ctx, denom, true, true); err != nil {
+		return err
+	}
+
+	if err := k.storeBeforeSendHooks(ctx, denom, nil); err != nil {
+		return err
+	}
+
+	if err := k.DenomHookGas.Remove(ctx, denom); err != nil {
+		return err
+	}
+
+	if err := k.HookFailures.Remove(ctx, denom); err != nil {
+		return err
+	}
+
+	return k.setAdmin(ctx, denom, "")
+}
+
+// checkNoTransferRestrictions returns an error if the denom is paused, in
+// strict mode or has frozen accounts.
+func (k Keeper) checkNoTransferRestrictions(ctx context.Context, denom string) error {
+	if paused, err := k.PausedDenoms.Has(ctx, denom); err != nil {
+		return err
+	} else if paused {
+		return types.ErrDenomRestricted.Wrapf("denom %s is paused", denom)
+	}
+
+	if strict, err := k.StrictDenoms.Has(ctx, denom); err != nil {
+		return err
+	} else if strict {
+		return types.ErrDenomRestricted.Wrapf("denom %s is in strict mode", denom)
+	}
+
+	iter, err := k.FrozenAccounts.Iterate(ctx, collections.NewPrefixedPairRange[string, string](denom))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	if iter.Valid() {
+		return types.ErrDenomRestricted.Wrapf("denom %s has frozen accounts", denom)
+	}
+
+	return nil
+}
@@ -2,10 +2,14 @@ package keeper_test
 
 import (
 	"fmt"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -319,6 +323,202 @@ func TestChangeAdminDenom(t *testing.T) {
 	}
 }
 
+func TestTwoStepAdminTransfer(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	msgServer := tokenFactorykeeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+	querier := tokenFactorykeeper.Querier{Keeper: input.TokenFactoryKeeper}
+
+	admin, newAdmin := addrs[0].String(), addrs[1].String()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	res, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(admin, "bitcoin"))
+	require.NoError(t, err)
+	denom := res.GetNewTokenDenom()
+
+	// only the admin can propose
+	_, err = msgServer.ProposeAdmin(ctx, types.NewMsgProposeAdmin(newAdmin, denom, newAdmin, time.Time{}))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// the expiry must be in the future
+	_, err = msgServer.ProposeAdmin(ctx, types.NewMsgProposeAdmin(admin, denom, newAdmin, now))
+	require.ErrorIs(t, err, types.ErrInvalidAdminTransfer)
+
+	expiresAt := now.Add(time.Hour)
+	_, err = msgServer.ProposeAdmin(ctx, types.NewMsgProposeAdmin(admin, denom, newAdmin, expiresAt))
+	require.NoError(t, err)
+
+	pendingRes, err := querier.PendingAdminTransfer(ctx, &types.QueryPendingAdminTransferRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, &types.PendingAdminTransfer{PendingAdmin: newAdmin, ExpiresAt: expiresAt}, pendingRes.PendingAdminTransfer)
+
+	// the proposal doesn't change the admin yet
+	metadata, err := input.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, admin, metadata.Admin)
+
+	// only the pending admin can accept
+	_, err = msgServer.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(addrs[2].String(), denom))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// the transfer cannot be accepted once expired
+	_, err = msgServer.AcceptAdmin(ctx.WithBlockTime(expiresAt), types.NewMsgAcceptAdmin(newAdmin, denom))
+	require.ErrorIs(t, err, types.ErrAdminTransferExpired)
+
+	_, err = msgServer.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(newAdmin, denom))
+	require.NoError(t, err)
+
+	metadata, err = input.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, newAdmin, metadata.Admin)
+
+	pendingRes, err = querier.PendingAdminTransfer(ctx, &types.QueryPendingAdminTransferRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Nil(t, pendingRes.PendingAdminTransfer)
+
+	_, err = msgServer.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(newAdmin, denom))
+	require.ErrorIs(t, err, types.ErrNoPendingAdminTransfer)
+
+	// cancel a proposal without expiry
+	_, err = msgServer.ProposeAdmin(ctx, types.NewMsgProposeAdmin(newAdmin, denom, admin, time.Time{}))
+	require.NoError(t, err)
+
+	_, err = msgServer.CancelAdminTransfer(ctx, types.NewMsgCancelAdminTransfer(admin, denom))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.CancelAdminTransfer(ctx, types.NewMsgCancelAdminTransfer(newAdmin, denom))
+	require.NoError(t, err)
+
+	_, err = msgServer.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(admin, denom))
+	require.ErrorIs(t, err, types.ErrNoPendingAdminTransfer)
+
+	// a direct admin change drops the pending transfer
+	_, err = msgServer.ProposeAdmin(ctx, types.NewMsgProposeAdmin(newAdmin, denom, addrs[2].String(), time.Time{}))
+	require.NoError(t, err)
+
+	_, err = msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(newAdmin, denom, admin))
+	require.NoError(t, err)
+
+	_, err = msgServer.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(addrs[2].String(), denom))
+	require.ErrorIs(t, err, types.ErrNoPendingAdminTransfer)
+}
+
+func TestRenounceAdmin(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	msgServer := tokenFactorykeeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+
+	admin, minter := addrs[0].String(), addrs[1].String()
+
+	res, err := msgServer.CreateDenom(ctx, &types.MsgCreateDenom{
+		Sender:              admin,
+		Subdenom:            "bitcoin",
+		EnableBurnFrom:      true,
+		EnableForceTransfer: true,
+	})
+	require.NoError(t, err)
+	denom := res.GetNewTokenDenom()
+
+	_, err = msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, denom, types.DenomRoleMinter, minter))
+	require.NoError(t, err)
+
+	_, err = msgServer.ProposeAdmin(ctx, types.NewMsgProposeAdmin(admin, denom, addrs[2].String(), time.Time{}))
+	require.NoError(t, err)
+
+	wasmCode, err := os.ReadFile("./testdata/no100.wasm")
+	require.NoError(t, err)
+	codeID, _, err := input.ContractKeeper.Create(ctx, addrs[0], wasmCode, nil)
+	require.NoError(t, err)
+	hookAddr, _, err := input.ContractKeeper.Instantiate(ctx, codeID, addrs[0], addrs[0], []byte("{}"), "", sdk.NewCoins())
+	require.NoError(t, err)
+
+	require.NoError(t, input.TokenFactoryKeeper.SetParams(ctx, types.DefaultParams()))
+	hookMsg := types.NewMsgSetBeforeSendHook(admin, denom, hookAddr.String())
+	hookMsg.GasLimit = 1_000_000
+	_, err = msgServer.SetBeforeSendHook(ctx, hookMsg)
+	require.NoError(t, err)
+
+	// only the admin can renounce
+	_, err = msgServer.RenounceAdmin(ctx, types.NewMsgRenounceAdmin(minter, denom))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.RenounceAdmin(ctx, types.NewMsgRenounceAdmin(admin, denom))
+	require.NoError(t, err)
+
+	metadata, err := input.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, types.DenomAuthorityMetadata{}, metadata)
+
+	roles, err := input.TokenFactoryKeeper.GetDenomRoles(ctx, denom)
+	require.NoError(t, err)
+	require.Empty(t, roles)
+
+	// the hooks nobody could replace anymore are removed
+	require.Empty(t, input.TokenFactoryKeeper.GetBeforeSendHooks(ctx, denom))
+	has, err := input.TokenFactoryKeeper.HookContractDenoms.Has(ctx, collections.Join(hookAddr.String(), denom))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = input.TokenFactoryKeeper.DenomHookGas.Has(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// nobody can mint or take over the denom anymore
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(denom, 5)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.AcceptAdmin(ctx, types.NewMsgAcceptAdmin(addrs[2].String(), denom))
+	require.ErrorIs(t, err, types.ErrNoPendingAdminTransfer)
+
+	_, err = msgServer.RenounceAdmin(ctx, types.NewMsgRenounceAdmin(admin, denom))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestRenounceAdmin_TransferRestrictions(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	msgServer := tokenFactorykeeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+
+	admin, holder := addrs[0].String(), addrs[1].String()
+
+	res, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(admin, "bitcoin"))
+	require.NoError(t, err)
+	denom := res.GetNewTokenDenom()
+
+	// paused
+	_, err = msgServer.SetDenomPaused(ctx, types.NewMsgSetDenomPaused(admin, denom, true))
+	require.NoError(t, err)
+	_, err = msgServer.RenounceAdmin(ctx, types.NewMsgRenounceAdmin(admin, denom))
+	require.ErrorIs(t, err, types.ErrDenomRestricted)
+	_, err = msgServer.SetDenomPaused(ctx, types.NewMsgSetDenomPaused(admin, denom, false))
+	require.NoError(t, err)
+
+	// frozen account
+	_, err = msgServer.SetAccountFrozen(ctx, types.NewMsgSetAccountFrozen(admin, denom, holder, true))
+	require.NoError(t, err)
+	_, err = msgServer.RenounceAdmin(ctx, types.NewMsgRenounceAdmin(admin, denom))
+	require.ErrorIs(t, err, types.ErrDenomRestricted)
+	_, err = msgServer.SetAccountFrozen(ctx, types.NewMsgSetAccountFrozen(admin, denom, holder, false))
+	require.NoError(t, err)
+
+	// strict mode
+	_, err = msgServer.SetDenomStrictMode(ctx, types.NewMsgSetDenomStrictMode(admin, denom, true))
+	require.NoError(t, err)
+	_, err = msgServer.RenounceAdmin(ctx, types.NewMsgRenounceAdmin(admin, denom))
+	require.ErrorIs(t, err, types.ErrDenomRestricted)
+	_, err = msgServer.SetDenomStrictMode(ctx, types.NewMsgSetDenomStrictMode(admin, denom, false))
+	require.NoError(t, err)
+
+	// the admin is still in place after the rejected renounces
+	metadata, err := input.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, admin, metadata.Admin)
+
+	// without restrictions the admin can be renounced
+	_, err = msgServer.RenounceAdmin(ctx, types.NewMsgRenounceAdmin(admin, denom))
+	require.NoError(t, err)
+}
+
 func TestSetDenomMetaData(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
 
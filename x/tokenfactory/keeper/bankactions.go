@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
@@ -16,6 +18,18 @@ func (k Keeper) mintTo(ctx context.Context, amount sdk.Coin, mintTo string) erro
 		return err
 	}
 
+	// enforce the supply cap of the denom
+	supplyCap, capped, err := k.GetSupplyCap(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+	if capped {
+		supply := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount
+		if supply.Add(amount.Amount).GT(supplyCap) {
+			return types.ErrSupplyCapExceeded.Wrapf("supply %s, mint %s, supply cap %s", supply, amount.Amount, supplyCap)
+		}
+	}
+
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
 		return err
@@ -30,7 +44,15 @@ func (k Keeper) mintTo(ctx context.Context, amount sdk.Coin, mintTo string) erro
 		return fmt.Errorf("failed to mint to blocked address: %s", addr)
 	}
 
-	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName,
+	if frozen, err := k.IsAccountFrozen(ctx, amount.Denom, mintTo); err != nil {
+		return err
+	} else if frozen {
+		return errorsmod.Wrapf(types.ErrAccountFrozen, "%s is frozen for denom %s", mintTo, amount.Denom)
+	}
+
+	// mints are allowed for paused denoms
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithValue(skipTransferRestrictionsKey{}, true)
+	return k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName,
 		addr,
 		sdk.NewCoins(amount))
 }
@@ -51,7 +73,9 @@ func (k Keeper) burnFrom(ctx context.Context, amount sdk.Coin, burnFrom string)
 		return fmt.Errorf("failed to burn from blocked address: %s", addr)
 	}
 
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx,
+	// burns are allowed for paused denoms and frozen accounts
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithValue(skipTransferRestrictionsKey{}, true)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx,
 		addr,
 		types.ModuleName,
 		sdk.NewCoins(amount))
@@ -61,3 +85,29 @@ func (k Keeper) burnFrom(ctx context.Context, amount sdk.Coin, burnFrom string)
 
 	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 }
+
+func (k Keeper) forceTransfer(ctx context.Context, amount sdk.Coin, fromAddr string, toAddr string) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(k.ac, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	fromAcc, err := k.ac.StringToBytes(fromAddr)
+	if err != nil {
+		return err
+	}
+
+	toAcc, err := k.ac.StringToBytes(toAddr)
+	if err != nil {
+		return err
+	}
+
+	if k.bankKeeper.BlockedAddr(toAcc) {
+		return fmt.Errorf("failed to force transfer to blocked address: %s", toAcc)
+	}
+
+	// force transfers are allowed for paused denoms and frozen accounts
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithValue(skipTransferRestrictionsKey{}, true)
+	return k.bankKeeper.SendCoins(sdkCtx, fromAcc, toAcc, sdk.NewCoins(amount))
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"strings"
 
+	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,22 +18,33 @@ import (
 	errorsmod "cosmossdk.io/errors"
 )
 
-func (k Keeper) setBeforeSendHook(ctx context.Context, denom string, cosmwasmAddress string) error {
+func (k Keeper) setBeforeSendHook(ctx context.Context, denom string, cosmwasmAddress string, gasLimit uint64) error {
+	if cosmwasmAddress == "" {
+		return k.setBeforeSendHooks(ctx, denom, nil, gasLimit)
+	}
+
+	return k.setBeforeSendHooks(ctx, denom, []string{cosmwasmAddress}, gasLimit)
+}
+
+// setBeforeSendHooks replaces the before send hooks of the denom with the given
+// contracts, which are called in the given order.
+func (k Keeper) setBeforeSendHooks(ctx context.Context, denom string, cosmwasmAddresses []string, gasLimit uint64) error {
 	// verify that denom is an x/tokenfactory denom
 	_, _, err := types.DeconstructDenom(k.ac, denom)
 	if err != nil {
 		return err
 	}
 
-	// delete the store for denom prefix store when cosmwasm address is nil
-	if cosmwasmAddress == "" {
-		return k.DenomHookAddr.Remove(ctx, denom)
-	} else {
-		// if a contract is being set, call the contract using cache context
-		// to test if the contract is an existing, valid contract.
+	if err := types.ValidateBeforeSendHooks(k.ac, cosmwasmAddresses); err != nil {
+		return err
+	}
+
+	// if a contract is being set, call the contract using cache context
+	// to test if the contract is an existing, valid contract.
+	for _, cosmwasmAddress := range cosmwasmAddresses {
 		cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
 
-		cwAddr, err := sdk.AccAddressFromBech32(cosmwasmAddress)
+		cwAddr, err := k.ac.StringToBytes(cosmwasmAddress)
 		if err != nil {
 			return err
 		}
@@ -51,21 +63,107 @@ func (k Keeper) setBeforeSendHook(ctx context.Context, denom string, cosmwasmAdd
 		}
 	}
 
-	_, err = k.ac.StringToBytes(cosmwasmAddress)
-	if err != nil {
+	if err := k.storeBeforeSendHooks(ctx, denom, cosmwasmAddresses); err != nil {
 		return err
 	}
 
-	return k.DenomHookAddr.Set(ctx, denom, cosmwasmAddress)
+	// the failures of the previous hooks do not tell anything about the new ones
+	if err := k.HookFailures.Remove(ctx, denom); err != nil {
+		return err
+	}
+
+	// delete the gas limit when the hooks are removed
+	if len(cosmwasmAddresses) == 0 {
+		return k.DenomHookGas.Remove(ctx, denom)
+	}
+
+	return k.setBeforeSendHookGasLimit(ctx, denom, gasLimit)
 }
 
-func (k Keeper) GetBeforeSendHook(ctx context.Context, denom string) string {
-	wasmAddr, err := k.DenomHookAddr.Get(ctx, denom)
+// storeBeforeSendHooks replaces the stored hooks of the denom without any
+// check and keeps the hook contract index in sync.
+func (k Keeper) storeBeforeSendHooks(ctx context.Context, denom string, cosmwasmAddresses []string) error {
+	// delete the existing hooks of the denom
+	for _, cosmwasmAddress := range k.GetBeforeSendHooks(ctx, denom) {
+		if err := k.HookContractDenoms.Remove(ctx, collections.Join(cosmwasmAddress, denom)); err != nil {
+			return err
+		}
+	}
+
+	rng := collections.NewPrefixedPairRange[string, uint64](denom)
+	if err := k.DenomHooks.Clear(ctx, rng); err != nil {
+		return err
+	}
+
+	for i, cosmwasmAddress := range cosmwasmAddresses {
+		if err := k.DenomHooks.Set(ctx, collections.Join(denom, uint64(i)), cosmwasmAddress); err != nil {
+			return err
+		}
+
+		if err := k.HookContractDenoms.Set(ctx, collections.Join(cosmwasmAddress, denom)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setBeforeSendHookGasLimit stores the gas limit of the before send hook of
+// the denom. Zero removes the per-denom gas limit to use the default one.
+func (k Keeper) setBeforeSendHookGasLimit(ctx context.Context, denom string, gasLimit uint64) error {
+	if gasLimit == 0 {
+		return k.DenomHookGas.Remove(ctx, denom)
+	}
+
+	params := k.GetParams(ctx)
+	if gasLimit > params.MaxBeforeSendHookGasLimit {
+		return errorsmod.Wrapf(types.ErrInvalidHookGasLimit, "gas limit %d exceeds the max gas limit %d", gasLimit, params.MaxBeforeSendHookGasLimit)
+	}
+
+	return k.DenomHookGas.Set(ctx, denom, gasLimit)
+}
+
+// GetBeforeSendHookGasLimit returns the gas limit applied to a before send
+// hook call of the denom. The per-denom gas limit is bounded by the current
+// max_before_send_hook_gas_limit param, and the default gas limit is used when
+// the param disables per-denom gas limits.
+func (k Keeper) GetBeforeSendHookGasLimit(ctx context.Context, denom string) uint64 {
+	gasLimit, err := k.DenomHookGas.Get(ctx, denom)
 	if err != nil {
+		return types.BeforeSendHookGasLimit
+	}
+
+	maxGasLimit := k.GetParams(ctx).MaxBeforeSendHookGasLimit
+	if maxGasLimit == 0 {
+		return types.BeforeSendHookGasLimit
+	}
+
+	return min(gasLimit, maxGasLimit)
+}
+
+// GetBeforeSendHook returns the first before send hook of the denom.
+func (k Keeper) GetBeforeSendHook(ctx context.Context, denom string) string {
+	hooks := k.GetBeforeSendHooks(ctx, denom)
+	if len(hooks) == 0 {
 		return ""
 	}
 
-	return wasmAddr
+	return hooks[0]
+}
+
+// GetBeforeSendHooks returns the before send hooks of the denom in call order.
+func (k Keeper) GetBeforeSendHooks(ctx context.Context, denom string) []string {
+	hooks := []string{}
+	rng := collections.NewPrefixedPairRange[string, uint64](denom)
+	err := k.DenomHooks.Walk(ctx, rng, func(_ collections.Pair[string, uint64], wasmAddr string) (bool, error) {
+		hooks = append(hooks, wasmAddr)
+		return false, nil
+	})
+	if err != nil {
+		return nil
+	}
+
+	return hooks
 }
 
 // Hooks wrapper struct for bank keeper
@@ -85,16 +183,30 @@ func (h Hooks) TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amo
 	_ = h.k.callBeforeSendListener(ctx, from, to, amount, false)
 }
 
-// TrackBeforeSend calls the before send listener contract returns any errors
+// BlockBeforeSend checks the pause and freeze state of the denoms, then calls
+// the before send listener contract and returns any errors
 func (h Hooks) BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if err := h.k.checkTransferRestrictions(ctx, from, to, amount); err != nil {
+		return err
+	}
+
 	return h.k.callBeforeSendListener(ctx, from, to, amount, true)
 }
 
+// BlockBeforeModuleSend runs the blocking checks of BlockBeforeSend on the
+// strict mode denoms of a module to module send. The other denoms are only
+// observed by TrackBeforeSend, as before.
+func (h Hooks) BlockBeforeModuleSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	return h.k.blockBeforeModuleSend(ctx, from, to, amount)
+}
+
 // callBeforeSendListener iterates over each coin and sends corresponding sudo msg to the contract address stored in state.
 // If blockBeforeSend is true, sudoMsg wraps BlockBeforeSendMsg, otherwise sudoMsg wraps TrackBeforeSendMsg.
 // Note that we gas meter trackBeforeSend to prevent infinite contract calls.
 // CONTRACT: this should not be called in beginBlock or endBlock since out of gas will cause this method to panic.
 func (k Keeper) callBeforeSendListener(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins, blockBeforeSend bool) (err error) {
+	// the hook being called, to record the failure of the call
+	var denom, cosmwasmAddress string
 	defer func() {
 		if r := recover(); r != nil {
 			switch r.(type) {
@@ -106,6 +218,10 @@ func (k Keeper) callBeforeSendListener(ctx context.Context, from, to sdk.AccAddr
 				err = errors.New("panic in callBeforeSendListener occurred")
 			}
 		}
+
+		if err != nil && cosmwasmAddress != "" {
+			k.recordBeforeSendHookFailure(ctx, denom, cosmwasmAddress, blockBeforeSend, err)
+		}
 	}()
 
 	fromAddr, err := k.ac.BytesToString(from)
@@ -119,61 +235,114 @@ func (k Keeper) callBeforeSendListener(ctx context.Context, from, to sdk.AccAddr
 	}
 
 	for _, coin := range amount {
-		cosmwasmAddress := k.GetBeforeSendHook(ctx, coin.Denom)
-		if cosmwasmAddress != "" {
-			cwAddr, err := k.ac.StringToBytes(cosmwasmAddress)
-			if err != nil {
-				return err
-			}
+		cosmwasmAddresses := k.GetBeforeSendHooks(ctx, coin.Denom)
+		if len(cosmwasmAddresses) == 0 {
+			continue
+		}
 
-			var msgBz []byte
-
-			// get msgBz, either BlockBeforeSend or TrackBeforeSend
-			// Note that for trackBeforeSend, we need to gas meter computations to prevent infinite loop
-			// specifically because module to module sends are not gas metered.
-			// We don't need to do this for blockBeforeSend since blockBeforeSend is not called during module to module sends.
-			if blockBeforeSend {
-				msg := types.BlockBeforeSendSudoMsg{
-					BlockBeforeSend: types.BlockBeforeSendMsg{
-						From: fromAddr,
-						To:   toAddr,
-						Amount: wasmvmtypes.Coin{
-							Denom:  coin.GetDenom(),
-							Amount: coin.Amount.String(),
-						},
+		var msgBz []byte
+
+		// get msgBz, either BlockBeforeSend or TrackBeforeSend
+		// Note that we need to gas meter computations to prevent infinite loop
+		// specifically because module to module sends are not gas metered.
+		// Both modes are called during module to module sends, blockBeforeSend only for strict mode denoms.
+		if blockBeforeSend {
+			msg := types.BlockBeforeSendSudoMsg{
+				BlockBeforeSend: types.BlockBeforeSendMsg{
+					From: fromAddr,
+					To:   toAddr,
+					Amount: wasmvmtypes.Coin{
+						Denom:  coin.GetDenom(),
+						Amount: coin.Amount.String(),
 					},
-				}
-				msgBz, err = json.Marshal(msg)
-			} else {
-				msg := types.TrackBeforeSendSudoMsg{
-					TrackBeforeSend: types.TrackBeforeSendMsg{
-						From: fromAddr,
-						To:   toAddr,
-						Amount: wasmvmtypes.Coin{
-							Denom:  coin.GetDenom(),
-							Amount: coin.Amount.String(),
-						},
+				},
+			}
+			msgBz, err = json.Marshal(msg)
+		} else {
+			msg := types.TrackBeforeSendSudoMsg{
+				TrackBeforeSend: types.TrackBeforeSendMsg{
+					From: fromAddr,
+					To:   toAddr,
+					Amount: wasmvmtypes.Coin{
+						Denom:  coin.GetDenom(),
+						Amount: coin.Amount.String(),
 					},
-				}
-				msgBz, err = json.Marshal(msg)
+				},
 			}
+			msgBz, err = json.Marshal(msg)
+		}
+		if err != nil {
+			return err
+		}
+
+		// call each hook contract in order; the first failing hook stops the chain
+		for _, hook := range cosmwasmAddresses {
+			cwAddr, err := k.ac.StringToBytes(hook)
 			if err != nil {
 				return err
 			}
 
 			// safe guard against out of gas error
+			denom, cosmwasmAddress = coin.Denom, hook
 			err = k.safeSudo(ctx, cwAddr, msgBz, coin.Denom)
 			if err != nil {
 				return err
 			}
+			denom, cosmwasmAddress = "", ""
 		}
 	}
 	return nil
 }
 
+// recordBeforeSendHookFailure emits a before_send_hook_failed event and
+// increments the failure counter of the denom. The failure of a block call
+// reverts the send, so it is only kept when the caller ignores the error.
+func (k Keeper) recordBeforeSendHookFailure(ctx context.Context, denom, cosmwasmAddress string, blockBeforeSend bool, hookErr error) {
+	mode := types.AttributeValueModeTrack
+	if blockBeforeSend {
+		mode = types.AttributeValueModeBlock
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBeforeSendHookFailed,
+		sdk.NewAttribute(types.AttributeDenom, denom),
+		sdk.NewAttribute(types.AttributeContract, cosmwasmAddress),
+		sdk.NewAttribute(types.AttributeMode, mode),
+		sdk.NewAttribute(types.AttributeError, hookErr.Error()),
+	))
+
+	// the counter cannot be written once the send ran out of gas
+	if sdkCtx.GasMeter().IsOutOfGas() {
+		return
+	}
+
+	failures, err := k.GetBeforeSendHookFailures(ctx, denom)
+	if err == nil {
+		failures.Count = min(failures.Count+1, types.MaxBeforeSendHookFailures)
+		failures.LastHeight = sdkCtx.BlockHeight()
+		failures.LastContract = cosmwasmAddress
+		err = k.HookFailures.Set(ctx, denom, failures)
+	}
+	if err != nil {
+		k.Logger(ctx).Error("failed to record before send hook failure", "denom", denom, "error", err)
+	}
+}
+
+// GetBeforeSendHookFailures returns the failure counter of the before send
+// hooks of the denom.
+func (k Keeper) GetBeforeSendHookFailures(ctx context.Context, denom string) (types.BeforeSendHookFailures, error) {
+	failures, err := k.HookFailures.Get(ctx, denom)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.BeforeSendHookFailures{}, nil
+	}
+
+	return failures, err
+}
+
 func (k Keeper) safeSudo(ctx context.Context, cwAddr sdk.AccAddress, msgBz []byte, denom string) (err error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	gasLimit := min(sdkCtx.GasMeter().GasRemaining(), types.BeforeSendHookGasLimit)
+	gasLimit := min(sdkCtx.GasMeter().GasRemaining(), k.GetBeforeSendHookGasLimit(ctx, denom))
 	childCtx := sdkCtx.
 		WithGasMeter(storetypes.NewGasMeter(gasLimit)).
 		WithEventManager(sdk.NewEventManager())
@@ -251,3 +251,206 @@ func TestInfiniteTrackBeforeSend(t *testing.T) {
 		})
 	}
 }
+
+func TestBeforeSendHookGasLimit(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	msgServer := tokenFactorykeeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+	querier := tokenFactorykeeper.Querier{Keeper: input.TokenFactoryKeeper}
+
+	wasmCode, err := os.ReadFile("./testdata/infinite_track_beforesend.wasm")
+	require.NoError(t, err)
+	codeID, _, err := input.ContractKeeper.Create(ctx, addrs[0], wasmCode, nil)
+	require.NoError(t, err)
+	cosmwasmAddress, _, err := input.ContractKeeper.Instantiate(ctx, codeID, addrs[0], addrs[0], []byte("{}"), "", sdk.NewCoins())
+	require.NoError(t, err)
+
+	res, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(addrs[0].String(), "bitcoin"))
+	require.NoError(t, err)
+	factoryDenom := res.GetNewTokenDenom()
+	tokenToSend := sdk.NewCoins(sdk.NewInt64Coin(factoryDenom, 100))
+	input.Faucet.Fund(ctx, addrs[0], tokenToSend...)
+
+	require.NoError(t, input.TokenFactoryKeeper.SetParams(ctx, types.DefaultParams()))
+	maxGasLimit := input.TokenFactoryKeeper.GetParams(ctx).MaxBeforeSendHookGasLimit
+
+	// gas limit without a hook contract
+	msg := types.NewMsgSetBeforeSendHook(addrs[0].String(), factoryDenom, "")
+	msg.GasLimit = 1_000_000
+	_, err = msgServer.SetBeforeSendHook(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidHookGasLimit)
+
+	// gas limit above the max param
+	msg = types.NewMsgSetBeforeSendHook(addrs[0].String(), factoryDenom, cosmwasmAddress.String())
+	msg.GasLimit = maxGasLimit + 1
+	_, err = msgServer.SetBeforeSendHook(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidHookGasLimit)
+
+	// default gas limit
+	_, err = msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(addrs[0].String(), factoryDenom, cosmwasmAddress.String()))
+	require.NoError(t, err)
+
+	hookRes, err := querier.BeforeSendHookAddress(ctx, &types.QueryBeforeSendHookAddressRequest{Denom: factoryDenom})
+	require.NoError(t, err)
+	require.Equal(t, types.BeforeSendHookGasLimit, hookRes.GasLimit)
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	err = input.BankKeeper.SendCoins(ctx, addrs[0], addrs[1], tokenToSend)
+	require.ErrorIs(t, err, types.ErrBeforeSendHookOutOfGas)
+	defaultGasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+	require.GreaterOrEqual(t, defaultGasUsed, types.BeforeSendHookGasLimit)
+
+	// per-denom gas limit
+	msg.GasLimit = maxGasLimit
+	_, err = msgServer.SetBeforeSendHook(ctx, msg)
+	require.NoError(t, err)
+
+	hookRes, err = querier.BeforeSendHookAddress(ctx, &types.QueryBeforeSendHookAddressRequest{Denom: factoryDenom})
+	require.NoError(t, err)
+	require.Equal(t, maxGasLimit, hookRes.GasLimit)
+
+	gasBefore = ctx.GasMeter().GasConsumed()
+	err = input.BankKeeper.SendCoins(ctx, addrs[0], addrs[1], tokenToSend)
+	require.ErrorIs(t, err, types.ErrBeforeSendHookOutOfGas)
+	customGasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+	require.GreaterOrEqual(t, customGasUsed, maxGasLimit)
+	require.Greater(t, customGasUsed, defaultGasUsed)
+
+	// the per-denom gas limit is bounded by the current param
+	params := input.TokenFactoryKeeper.GetParams(ctx)
+	params.MaxBeforeSendHookGasLimit = types.BeforeSendHookGasLimit / 2
+	require.NoError(t, input.TokenFactoryKeeper.SetParams(ctx, params))
+	require.Equal(t, params.MaxBeforeSendHookGasLimit, input.TokenFactoryKeeper.GetBeforeSendHookGasLimit(ctx, factoryDenom))
+
+	// the default gas limit is used when per-denom gas limits are disabled
+	params.MaxBeforeSendHookGasLimit = 0
+	require.NoError(t, input.TokenFactoryKeeper.SetParams(ctx, params))
+	require.Equal(t, types.BeforeSendHookGasLimit, input.TokenFactoryKeeper.GetBeforeSendHookGasLimit(ctx, factoryDenom))
+
+	// removing the hook removes the gas limit
+	_, err = msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(addrs[0].String(), factoryDenom, ""))
+	require.NoError(t, err)
+	has, err := input.TokenFactoryKeeper.DenomHookGas.Has(ctx, factoryDenom)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestBeforeSendHooks(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	msgServer := tokenFactorykeeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+	querier := tokenFactorykeeper.Querier{Keeper: input.TokenFactoryKeeper}
+
+	wasmCode, err := os.ReadFile("./testdata/no100.wasm")
+	require.NoError(t, err)
+	codeID, _, err := input.ContractKeeper.Create(ctx, addrs[0], wasmCode, nil)
+	require.NoError(t, err)
+	hookA, _, err := input.ContractKeeper.Instantiate(ctx, codeID, addrs[0], addrs[0], []byte("{}"), "a", sdk.NewCoins())
+	require.NoError(t, err)
+	hookB, _, err := input.ContractKeeper.Instantiate(ctx, codeID, addrs[0], addrs[0], []byte("{}"), "b", sdk.NewCoins())
+	require.NoError(t, err)
+
+	res, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(addrs[0].String(), "bitcoin"))
+	require.NoError(t, err)
+	factoryDenom := res.GetNewTokenDenom()
+	input.Faucet.Fund(ctx, addrs[0], sdk.NewInt64Coin(factoryDenom, 1000))
+
+	// duplicate hook contracts
+	_, err = msgServer.SetBeforeSendHooks(ctx, types.NewMsgSetBeforeSendHooks(addrs[0].String(), factoryDenom, []string{hookA.String(), hookA.String()}))
+	require.ErrorIs(t, err, types.ErrInvalidBeforeSendHooks)
+
+	// only the admin can set the hooks
+	_, err = msgServer.SetBeforeSendHooks(ctx, types.NewMsgSetBeforeSendHooks(addrs[1].String(), factoryDenom, []string{hookA.String()}))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.SetBeforeSendHooks(ctx, types.NewMsgSetBeforeSendHooks(addrs[0].String(), factoryDenom, []string{hookB.String(), hookA.String()}))
+	require.NoError(t, err)
+
+	hooksRes, err := querier.BeforeSendHooks(ctx, &types.QueryBeforeSendHooksRequest{Denom: factoryDenom})
+	require.NoError(t, err)
+	require.Equal(t, []string{hookB.String(), hookA.String()}, hooksRes.CosmwasmAddresses)
+	require.Equal(t, types.BeforeSendHookGasLimit, hooksRes.GasLimit)
+
+	// the single hook query returns the first hook
+	hookRes, err := querier.BeforeSendHookAddress(ctx, &types.QueryBeforeSendHookAddressRequest{Denom: factoryDenom})
+	require.NoError(t, err)
+	require.Equal(t, hookB.String(), hookRes.CosmwasmAddress)
+
+	// every hook is called
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(factoryDenom, 1))))
+	err = input.BankKeeper.SendCoins(ctx, addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(factoryDenom, 100)))
+	require.Error(t, err)
+
+	// replacing the hooks overwrites the whole list
+	_, err = msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(addrs[0].String(), factoryDenom, hookA.String()))
+	require.NoError(t, err)
+	require.Equal(t, []string{hookA.String()}, input.TokenFactoryKeeper.GetBeforeSendHooks(ctx, factoryDenom))
+
+	// removing all hooks
+	_, err = msgServer.SetBeforeSendHooks(ctx, types.NewMsgSetBeforeSendHooks(addrs[0].String(), factoryDenom, nil))
+	require.NoError(t, err)
+	require.Empty(t, input.TokenFactoryKeeper.GetBeforeSendHooks(ctx, factoryDenom))
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(factoryDenom, 100))))
+}
+
+func TestBeforeSendHookFailures(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	msgServer := tokenFactorykeeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+	querier := tokenFactorykeeper.Querier{Keeper: input.TokenFactoryKeeper}
+
+	wasmCode, err := os.ReadFile("./testdata/infinite_track_beforesend.wasm")
+	require.NoError(t, err)
+	codeID, _, err := input.ContractKeeper.Create(ctx, addrs[0], wasmCode, nil)
+	require.NoError(t, err)
+	cosmwasmAddress, _, err := input.ContractKeeper.Instantiate(ctx, codeID, addrs[0], addrs[0], []byte("{}"), "", sdk.NewCoins())
+	require.NoError(t, err)
+
+	res, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(addrs[0].String(), "bitcoin"))
+	require.NoError(t, err)
+	factoryDenom := res.GetNewTokenDenom()
+
+	tokenToSend := sdk.NewCoins(sdk.NewInt64Coin(factoryDenom, 100))
+	input.Faucet.Fund(ctx, input.AccountKeeper.GetModuleAccount(ctx, authtypes.Minter).GetAddress(), tokenToSend...)
+
+	_, err = msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(addrs[0].String(), factoryDenom, cosmwasmAddress.String()))
+	require.NoError(t, err)
+
+	// the track call runs out of gas, which does not fail the send
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithBlockHeight(10)
+	err = input.BankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.Minter, govtypes.ModuleName, tokenToSend)
+	require.NoError(t, err)
+
+	var failed *sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBeforeSendHookFailed {
+			failed = &event
+		}
+	}
+	require.NotNil(t, failed)
+	for key, value := range map[string]string{
+		types.AttributeDenom:    factoryDenom,
+		types.AttributeContract: cosmwasmAddress.String(),
+		types.AttributeMode:     types.AttributeValueModeTrack,
+	} {
+		attr, ok := failed.GetAttribute(key)
+		require.True(t, ok)
+		require.Equal(t, value, attr.Value)
+	}
+
+	failuresRes, err := querier.BeforeSendHookFailures(ctx, &types.QueryBeforeSendHookFailuresRequest{Denom: factoryDenom})
+	require.NoError(t, err)
+	require.Equal(t, types.BeforeSendHookFailures{
+		Count:        1,
+		LastHeight:   10,
+		LastContract: cosmwasmAddress.String(),
+	}, failuresRes.Failures)
+
+	// replacing the hooks resets the counter
+	_, err = msgServer.SetBeforeSendHooks(ctx, types.NewMsgSetBeforeSendHooks(addrs[0].String(), factoryDenom, nil))
+	require.NoError(t, err)
+
+	failuresRes, err = querier.BeforeSendHookFailures(ctx, &types.QueryBeforeSendHookFailuresRequest{Denom: factoryDenom})
+	require.NoError(t, err)
+	require.Zero(t, failuresRes.Failures.Count)
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"encoding/binary"
 	"testing"
 	"time"
@@ -194,6 +195,15 @@ func (f *TestFaucet) NewFundedAccount(ctx sdk.Context, amounts ...sdk.Coin) sdk.
 	return addr
 }
 
+// MockOPChildKeeper serves a configurable opchild fee whitelist.
+type MockOPChildKeeper struct {
+	Whitelist []string
+}
+
+func (k *MockOPChildKeeper) FeeWhitelist(_ context.Context) ([]string, error) {
+	return k.Whitelist, nil
+}
+
 type TestKeepers struct {
 	AccountKeeper       *authkeeper.AccountKeeper
 	BankKeeper          *bankkeeper.Keeper
@@ -201,6 +211,7 @@ type TestKeepers struct {
 	WasmKeeper          *wasmkeeper.Keeper
 	TokenFactoryKeeper  *tokenfactorykeeper.Keeper
 	CommunityPoolKeeper *appkeepers.CommunityPoolKeeper
+	OPChildKeeper       *MockOPChildKeeper
 	EncodingConfig      initiaappparams.EncodingConfig
 	Faucet              *TestFaucet
 	MultiStore          storetypes.CommitMultiStore
@@ -303,6 +314,7 @@ func _createTestInput(
 		AccountKeeper:       &accountKeeper,
 		BankKeeper:          &bankKeeper,
 		CommunityPoolKeeper: &communityPoolKeeper,
+		OPChildKeeper:       &MockOPChildKeeper{},
 		EncodingConfig:      encodingConfig,
 		Faucet:              faucet,
 		MultiStore:          ms,
@@ -344,6 +356,7 @@ func _createTestInput(
 		accountKeeper,
 		keepers.BankKeeper,
 		keepers.CommunityPoolKeeper,
+		keepers.OPChildKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	tokenfactoryKeeper.SetContractKeeper(contractKeeper)
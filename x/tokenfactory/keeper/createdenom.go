@@ -3,8 +3,13 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
@@ -17,7 +22,19 @@ func (k Keeper) CreateDenom(ctx context.Context, creatorAddr string, subdenom st
 		return "", err
 	}
 
-	err = k.chargeForCreateDenom(ctx, creatorAddr)
+	exempted, err := k.isFeeWhitelisted(ctx, creatorAddr)
+	if err != nil {
+		return "", err
+	}
+
+	if !exempted {
+		err = k.checkDenomQuota(ctx, creatorAddr)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = k.chargeForCreateDenom(ctx, creatorAddr, exempted)
 	if err != nil {
 		return "", err
 	}
@@ -76,18 +93,53 @@ func (k Keeper) validateCreateDenom(ctx context.Context, creatorAddr string, sub
 	return denom, nil
 }
 
-func (k Keeper) chargeForCreateDenom(ctx context.Context, creatorAddr string) (err error) {
+// isFeeWhitelisted returns true if the creator is on the opchild fee
+// whitelist, which exempts it from the denom creation fee and quota.
+func (k Keeper) isFeeWhitelisted(ctx context.Context, creatorAddr string) (bool, error) {
+	whitelist, err := k.opChildKeeper.FeeWhitelist(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Contains(whitelist, creatorAddr), nil
+}
+
+// checkDenomQuota returns an error if the creator already created the max
+// number of denoms per creator.
+func (k Keeper) checkDenomQuota(ctx context.Context, creatorAddr string) error {
+	params := k.GetParams(ctx)
+	if params.MaxDenomsPerCreator == 0 {
+		return nil
+	}
+
+	count := uint64(0)
+	err := k.CreatorDenoms.Walk(ctx, collections.NewPrefixedPairRange[string, string](creatorAddr), func(_ collections.Pair[string, string]) (bool, error) {
+		count++
+		return count >= params.MaxDenomsPerCreator, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if count >= params.MaxDenomsPerCreator {
+		return errorsmod.Wrapf(types.ErrDenomQuotaExceeded, "%s already created %d denoms", creatorAddr, count)
+	}
+
+	return nil
+}
+
+func (k Keeper) chargeForCreateDenom(ctx context.Context, creatorAddr string, exempted bool) (err error) {
 	params := k.GetParams(ctx)
 
 	// if DenomCreationFee is non-zero, transfer the tokens from the creator
-	// account to community pool
-	if params.DenomCreationFee != nil {
+	// account to the fee destination
+	if !exempted && !params.DenomCreationFee.IsZero() {
 		accAddr, err := sdk.AccAddressFromBech32(creatorAddr)
 		if err != nil {
 			return err
 		}
 
-		if err := k.communityPoolKeeper.FundCommunityPool(ctx, params.DenomCreationFee, accAddr); err != nil {
+		if err := k.sendDenomCreationFee(ctx, params, accAddr); err != nil {
 			return err
 		}
 	}
@@ -100,3 +152,48 @@ func (k Keeper) chargeForCreateDenom(ctx context.Context, creatorAddr string) (e
 
 	return nil
 }
+
+// sendDenomCreationFee moves the denom creation fee from the creator to the
+// configured fee destination.
+func (k Keeper) sendDenomCreationFee(ctx context.Context, params types.Params, creator sdk.AccAddress) error {
+	switch params.DenomCreationFeeDestination {
+	case types.FeeDestinationBurn:
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, params.DenomCreationFee); err != nil {
+			return err
+		}
+
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, params.DenomCreationFee)
+	case types.FeeDestinationAddress:
+		if err := k.checkDenomCreationFeeRecipient(params); err != nil {
+			return err
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(params.DenomCreationFeeRecipient)
+		if err != nil {
+			return err
+		}
+
+		return k.bankKeeper.SendCoins(ctx, creator, recipient, params.DenomCreationFee)
+	default:
+		return k.communityPoolKeeper.FundCommunityPool(ctx, params.DenomCreationFee, creator)
+	}
+}
+
+// checkDenomCreationFeeRecipient returns an error if the fee recipient of the
+// address fee destination is a blocked address, which cannot receive funds.
+func (k Keeper) checkDenomCreationFeeRecipient(params types.Params) error {
+	if params.DenomCreationFeeDestination != types.FeeDestinationAddress {
+		return nil
+	}
+
+	recipient, err := k.ac.StringToBytes(params.DenomCreationFeeRecipient)
+	if err != nil {
+		return err
+	}
+
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive the denom creation fee", params.DenomCreationFeeRecipient)
+	}
+
+	return nil
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -136,6 +138,108 @@ func TestCreateDenom(t *testing.T) {
 	}
 }
 
+func TestDenomCreationFeeDestination(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uinit", 1000))
+	recipient := addrs[2]
+
+	for _, tc := range []struct {
+		desc        string
+		destination types.FeeDestination
+		recipient   string
+		check       func(ctx sdk.Context, input TestKeepers, supplyBefore sdk.Coin)
+	}{
+		{
+			desc:        "fee collector",
+			destination: types.FeeDestinationFeeCollector,
+			check: func(ctx sdk.Context, input TestKeepers, _ sdk.Coin) {
+				feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+				require.Equal(t, fee, input.BankKeeper.GetAllBalances(ctx, feeCollector))
+			},
+		},
+		{
+			desc:        "burn",
+			destination: types.FeeDestinationBurn,
+			check: func(ctx sdk.Context, input TestKeepers, supplyBefore sdk.Coin) {
+				require.Equal(t, supplyBefore.Sub(fee[0]), input.BankKeeper.GetSupply(ctx, "uinit"))
+			},
+		},
+		{
+			desc:        "address",
+			destination: types.FeeDestinationAddress,
+			recipient:   recipient.String(),
+			check: func(ctx sdk.Context, input TestKeepers, _ sdk.Coin) {
+				require.Equal(t, fee, input.BankKeeper.GetAllBalances(ctx, recipient))
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx, input := createDefaultTestInput(t)
+
+			params := types.DefaultParams()
+			params.DenomCreationFee = fee
+			params.DenomCreationFeeDestination = tc.destination
+			params.DenomCreationFeeRecipient = tc.recipient
+			require.NoError(t, input.TokenFactoryKeeper.SetParams(ctx, params))
+
+			input.Faucet.Fund(ctx, addrs[0], fee...)
+			supplyBefore := input.BankKeeper.GetSupply(ctx, "uinit")
+
+			msgServer := tokenFactorykeeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+			_, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(addrs[0].String(), "bitcoin"))
+			require.NoError(t, err)
+
+			require.True(t, input.BankKeeper.GetAllBalances(ctx, addrs[0]).IsZero())
+			tc.check(ctx, input, supplyBefore)
+		})
+	}
+}
+
+func TestDenomCreationFeeBlockedRecipient(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uinit", 1000))
+	params := types.DefaultParams()
+	params.DenomCreationFee = fee
+	params.DenomCreationFeeDestination = types.FeeDestinationAddress
+	params.DenomCreationFeeRecipient = authtypes.NewModuleAddress(types.ModuleName).String()
+	require.NoError(t, input.TokenFactoryKeeper.SetParams(ctx, params))
+
+	input.Faucet.Fund(ctx, addrs[0], fee...)
+
+	// the fee is not sent to a module account which cannot receive funds
+	msgServer := tokenFactorykeeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+	_, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(addrs[0].String(), "bitcoin"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Equal(t, fee, input.BankKeeper.GetAllBalances(ctx, addrs[0]))
+}
+
+func TestMaxDenomsPerCreator(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params := types.DefaultParams()
+	params.DenomCreationFee = sdk.NewCoins(sdk.NewInt64Coin("uinit", 1000))
+	params.MaxDenomsPerCreator = 2
+	require.NoError(t, input.TokenFactoryKeeper.SetParams(ctx, params))
+
+	msgServer := tokenFactorykeeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+
+	input.Faucet.Fund(ctx, addrs[0], sdk.NewInt64Coin("uinit", 3000))
+	for _, subdenom := range []string{"a", "b"} {
+		_, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(addrs[0].String(), subdenom))
+		require.NoError(t, err)
+	}
+
+	_, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(addrs[0].String(), "c"))
+	require.ErrorIs(t, err, types.ErrDenomQuotaExceeded)
+
+	// whitelisted accounts are exempted from the quota and the fee
+	input.OPChildKeeper.Whitelist = []string{addrs[1].String()}
+	for _, subdenom := range []string{"a", "b", "c"} {
+		_, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(addrs[1].String(), subdenom))
+		require.NoError(t, err)
+	}
+}
+
 func TestGasConsume(t *testing.T) {
 	// It's hard to estimate exactly how much gas will be consumed when creating a
 	// denom, because besides consuming the gas specified by the params, the keeper
@@ -183,7 +287,7 @@ func TestGasConsume(t *testing.T) {
 			// set params with the gas consume amount
 
 			tokenFactoryKeeper := input.TokenFactoryKeeper
-			tokenFactoryKeeper.SetParams(ctx, types.NewParams(nil, tc.gasConsume)) //nolint:errcheck
+			tokenFactoryKeeper.SetParams(ctx, types.NewParams(nil, tc.gasConsume, types.DefaultMaxBeforeSendHookGasLimit)) //nolint:errcheck
 
 			// amount of gas consumed prior to the denom creation
 			gasConsumedBefore := ctx.GasMeter().GasConsumed()
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+// skipTransferRestrictionsKey marks a context of which the sends bypass the
+// pause and freeze checks, used by mints, burns and force transfers.
+type skipTransferRestrictionsKey struct{}
+
+// IsDenomPaused returns true if the transfers of the denom are paused.
+func (k Keeper) IsDenomPaused(ctx context.Context, denom string) (bool, error) {
+	return k.PausedDenoms.Has(ctx, denom)
+}
+
+// IsAccountFrozen returns true if the account cannot send or receive the denom.
+func (k Keeper) IsAccountFrozen(ctx context.Context, denom string, addr string) (bool, error) {
+	return k.FrozenAccounts.Has(ctx, collections.Join(denom, addr))
+}
+
+func (k Keeper) setDenomPaused(ctx context.Context, denom string, paused bool) error {
+	if !paused {
+		return k.PausedDenoms.Remove(ctx, denom)
+	}
+
+	return k.PausedDenoms.Set(ctx, denom)
+}
+
+func (k Keeper) setAccountFrozen(ctx context.Context, denom string, addr string, frozen bool) error {
+	if !frozen {
+		return k.FrozenAccounts.Remove(ctx, collections.Join(denom, addr))
+	}
+
+	return k.FrozenAccounts.Set(ctx, collections.Join(denom, addr))
+}
+
+// GetFrozenAccounts returns the frozen accounts of the denom.
+func (k Keeper) GetFrozenAccounts(ctx context.Context, denom string) ([]string, error) {
+	addrs := []string{}
+	rng := collections.NewPrefixedPairRange[string, string](denom)
+	err := k.FrozenAccounts.Walk(ctx, rng, func(key collections.Pair[string, string]) (bool, error) {
+		addrs = append(addrs, key.K2())
+		return false, nil
+	})
+
+	return addrs, err
+}
+
+// checkTransferRestrictions returns an error if a factory denom of the amount
+// is paused, or if the sender or the recipient is frozen for it. The sends of
+// the token factory module account are restricted as well; only the mints,
+// burns and force transfers of the admin bypass the checks, and the mints
+// still check the frozen recipient themselves.
+func (k Keeper) checkTransferRestrictions(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if skip, ok := ctx.Value(skipTransferRestrictionsKey{}).(bool); ok && skip {
+		return nil
+	}
+
+	var fromAddr, toAddr string
+	for _, coin := range amount {
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			continue
+		}
+
+		if paused, err := k.IsDenomPaused(ctx, coin.Denom); err != nil {
+			return err
+		} else if paused {
+			return errorsmod.Wrapf(types.ErrDenomPaused, "denom %s", coin.Denom)
+		}
+
+		if fromAddr == "" {
+			var err error
+			if fromAddr, err = k.ac.BytesToString(from); err != nil {
+				return err
+			}
+			if toAddr, err = k.ac.BytesToString(to); err != nil {
+				return err
+			}
+		}
+
+		for _, addr := range []string{fromAddr, toAddr} {
+			if frozen, err := k.IsAccountFrozen(ctx, coin.Denom, addr); err != nil {
+				return err
+			} else if frozen {
+				return errorsmod.Wrapf(types.ErrAccountFrozen, "%s is frozen for denom %s", addr, coin.Denom)
+			}
+		}
+	}
+
+	return nil
+}
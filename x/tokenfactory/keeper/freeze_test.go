@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/keeper"
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+func TestDenomPause(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	msgServer := keeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+	querier := keeper.Querier{Keeper: input.TokenFactoryKeeper}
+
+	admin := addrs[0].String()
+
+	res, err := msgServer.CreateDenom(ctx, &types.MsgCreateDenom{Sender: admin, Subdenom: "bitcoin", EnableForceTransfer: true})
+	require.NoError(t, err)
+	denom := res.GetNewTokenDenom()
+
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 100)))
+	require.NoError(t, err)
+
+	// only the admin can pause
+	_, err = msgServer.SetDenomPaused(ctx, types.NewMsgSetDenomPaused(addrs[1].String(), denom, true))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.SetDenomPaused(ctx, types.NewMsgSetDenomPaused(admin, denom, true))
+	require.NoError(t, err)
+
+	pausedRes, err := querier.DenomPaused(ctx, &types.QueryDenomPausedRequest{Denom: denom})
+	require.NoError(t, err)
+	require.True(t, pausedRes.Paused)
+
+	err = input.BankKeeper.SendCoins(ctx, addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+	require.ErrorIs(t, err, types.ErrDenomPaused)
+
+	// the admin can still mint, burn and force transfer
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 10)))
+	require.NoError(t, err)
+	_, err = msgServer.Burn(ctx, types.NewMsgBurn(admin, sdk.NewInt64Coin(denom, 10)))
+	require.NoError(t, err)
+	_, err = msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(denom, 10), admin, addrs[1].String()))
+	require.NoError(t, err)
+
+	// other denoms are not affected
+	input.Faucet.Fund(ctx, addrs[0], sdk.NewInt64Coin("uinit", 10))
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin("uinit", 10))))
+
+	_, err = msgServer.SetDenomPaused(ctx, types.NewMsgSetDenomPaused(admin, denom, false))
+	require.NoError(t, err)
+
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+}
+
+func TestAccountFreeze(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	msgServer := keeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+	querier := keeper.Querier{Keeper: input.TokenFactoryKeeper}
+
+	admin, frozen := addrs[0].String(), addrs[1].String()
+
+	res, err := msgServer.CreateDenom(ctx, &types.MsgCreateDenom{Sender: admin, Subdenom: "bitcoin", EnableForceTransfer: true})
+	require.NoError(t, err)
+	denom := res.GetNewTokenDenom()
+
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 100)))
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+
+	// only the admin can freeze
+	_, err = msgServer.SetAccountFrozen(ctx, types.NewMsgSetAccountFrozen(frozen, denom, frozen, false))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.SetAccountFrozen(ctx, types.NewMsgSetAccountFrozen(admin, denom, frozen, true))
+	require.NoError(t, err)
+
+	frozenRes, err := querier.AccountFrozen(ctx, &types.QueryAccountFrozenRequest{Denom: denom, Address: frozen})
+	require.NoError(t, err)
+	require.True(t, frozenRes.Frozen)
+
+	frozenAccountsRes, err := querier.FrozenAccounts(ctx, &types.QueryFrozenAccountsRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, []string{frozen}, frozenAccountsRes.Addresses)
+
+	// the frozen account can neither send nor receive
+	err = input.BankKeeper.SendCoins(ctx, addrs[1], addrs[2], sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+	err = input.BankKeeper.SendCoins(ctx, addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+
+	// the denom cannot be minted to the frozen account
+	_, err = msgServer.Mint(ctx, &types.MsgMint{Sender: admin, Amount: sdk.NewInt64Coin(denom, 10), MintToAddress: frozen})
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+
+	// other accounts are not affected
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, addrs[0], addrs[2], sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+
+	// the admin can still seize the funds
+	_, err = msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(denom, 50), frozen, admin))
+	require.NoError(t, err)
+
+	_, err = msgServer.SetAccountFrozen(ctx, types.NewMsgSetAccountFrozen(admin, denom, frozen, false))
+	require.NoError(t, err)
+
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+}
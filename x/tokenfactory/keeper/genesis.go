@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"errors"
+
 	"cosmossdk.io/collections"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,6 +36,69 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+
+		// the hooks are restored without calling the contracts, which may
+		// not be initialized yet.
+		err = k.storeBeforeSendHooks(ctx, genDenom.GetDenom(), genDenom.GetBeforeSendHooks())
+		if err != nil {
+			panic(err)
+		}
+		if genDenom.GetBeforeSendHookGasLimit() != 0 {
+			err = k.DenomHookGas.Set(ctx, genDenom.GetDenom(), genDenom.GetBeforeSendHookGasLimit())
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		for _, grant := range genDenom.GetRoles() {
+			err = k.grantRole(ctx, genDenom.GetDenom(), grant.Role, grant.Address)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, allowance := range genDenom.GetMintAllowances() {
+			err = k.setMintAllowance(ctx, genDenom.GetDenom(), allowance.Minter, &allowance.Allowance)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if genDenom.SupplyCap != nil {
+			err = k.SupplyCaps.Set(ctx, genDenom.GetDenom(), *genDenom.SupplyCap)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if genDenom.Paused {
+			err = k.setDenomPaused(ctx, genDenom.GetDenom(), true)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, frozenAccount := range genDenom.GetFrozenAccounts() {
+			err = k.setAccountFrozen(ctx, genDenom.GetDenom(), frozenAccount, true)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if genDenom.StrictMode {
+			err = k.setDenomStrictMode(ctx, genDenom.GetDenom(), true)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if genDenom.BeforeSendHookFailures != nil {
+			err = k.HookFailures.Set(ctx, genDenom.GetDenom(), *genDenom.BeforeSendHookFailures)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if genDenom.PendingAdminTransfer != nil {
+			// the expiry is not checked against the genesis time.
+			err = k.PendingAdminTransfers.Set(ctx, genDenom.GetDenom(), *genDenom.PendingAdminTransfer)
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 }
 
@@ -48,10 +113,81 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
-		genDenoms = append(genDenoms, types.GenesisDenom{
+		genDenom := types.GenesisDenom{
 			Denom:             denom,
 			AuthorityMetadata: authorityMetadata,
-		})
+		}
+
+		hooks := k.GetBeforeSendHooks(ctx, denom)
+		if len(hooks) != 0 {
+			genDenom.BeforeSendHooks = hooks
+
+			gasLimit, err := k.DenomHookGas.Get(ctx, denom)
+			if err != nil && !errors.Is(err, collections.ErrNotFound) {
+				panic(err)
+			}
+			genDenom.BeforeSendHookGasLimit = gasLimit
+		}
+
+		roles, err := k.GetDenomRoles(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+		if len(roles) != 0 {
+			genDenom.Roles = roles
+		}
+
+		mintAllowances, err := k.GetMintAllowances(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+		if len(mintAllowances) != 0 {
+			genDenom.MintAllowances = mintAllowances
+		}
+
+		supplyCap, capped, err := k.GetSupplyCap(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+		if capped {
+			genDenom.SupplyCap = &supplyCap
+		}
+
+		pendingAdminTransfer, err := k.GetPendingAdminTransfer(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+		genDenom.PendingAdminTransfer = pendingAdminTransfer
+
+		paused, err := k.IsDenomPaused(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+		genDenom.Paused = paused
+
+		frozenAccounts, err := k.GetFrozenAccounts(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+		if len(frozenAccounts) != 0 {
+			genDenom.FrozenAccounts = frozenAccounts
+		}
+
+		strict, err := k.IsDenomStrict(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+		genDenom.StrictMode = strict
+
+		failures, err := k.GetBeforeSendHookFailures(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+		if failures.Count != 0 {
+			genDenom.BeforeSendHookFailures = &failures
+		}
+
+		genDenoms = append(genDenoms, genDenom)
 		return false, nil
 	})
 	if err != nil {
@@ -3,9 +3,12 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -25,6 +28,7 @@ func TestGenesis(t *testing.T) {
 	another, err := input.AddressCodec.BytesToString(addrs[1])
 	require.NoError(t, err, "address encoding")
 
+	supplyCap := math.NewInt(1_000_000)
 	genesisState := types.GenesisState{
 		FactoryDenoms: []types.GenesisDenom{
 			{
@@ -32,12 +36,34 @@ func TestGenesis(t *testing.T) {
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: creator,
 				},
+				BeforeSendHooks:        []string{another, creator},
+				BeforeSendHookGasLimit: 1_000_000,
+				Roles: []types.DenomRoleGrant{
+					{Role: types.DenomRoleMinter, Address: another},
+					{Role: types.DenomRoleHookManager, Address: another},
+				},
+				MintAllowances: []types.MintAllowance{
+					{Minter: another, Allowance: math.NewInt(100)},
+				},
+				SupplyCap:      &supplyCap,
+				FrozenAccounts: []string{another},
+				BeforeSendHookFailures: &types.BeforeSendHookFailures{
+					Count:        3,
+					LastHeight:   10,
+					LastContract: another,
+				},
 			},
 			{
 				Denom: fmt.Sprintf("factory/%s/diff-admin", creator),
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: another,
 				},
+				PendingAdminTransfer: &types.PendingAdminTransfer{
+					PendingAdmin: creator,
+					ExpiresAt:    time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				Paused:     true,
+				StrictMode: true,
 			},
 			{
 				Denom: fmt.Sprintf("factory/%s/litecoin", creator),
@@ -80,6 +106,9 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, exportedGenesis)
 	require.Equal(t, genesisState, *exportedGenesis)
 
+	// the gas limit above the max gas limit param can be imported again
+	require.NoError(t, exportedGenesis.Validate(input.AddressCodec))
+
 	// verify that the exported bank genesis is valid
 	bankKeeper.SetParams(ctx, banktypes.DefaultParams()) //nolint:errcheck
 	exportedBankGenesis := bankKeeper.ExportGenesis(ctx)
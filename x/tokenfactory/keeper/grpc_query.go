@@ -5,6 +5,13 @@ import (
 
 	"net/url"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
 )
 
@@ -46,5 +53,204 @@ func (q Querier) BeforeSendHookAddress(ctx context.Context, req *types.QueryBefo
 
 	cosmwasmAddress := q.GetBeforeSendHook(ctx, req.GetDenom())
 
-	return &types.QueryBeforeSendHookAddressResponse{CosmwasmAddress: cosmwasmAddress}, nil
+	var gasLimit uint64
+	if cosmwasmAddress != "" {
+		gasLimit = q.GetBeforeSendHookGasLimit(ctx, req.GetDenom())
+	}
+
+	return &types.QueryBeforeSendHookAddressResponse{CosmwasmAddress: cosmwasmAddress, GasLimit: gasLimit}, nil
+}
+
+func (q Querier) BeforeSendHooks(ctx context.Context, req *types.QueryBeforeSendHooksRequest) (*types.QueryBeforeSendHooksResponse, error) {
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	cosmwasmAddresses := q.GetBeforeSendHooks(ctx, req.GetDenom())
+
+	var gasLimit uint64
+	if len(cosmwasmAddresses) != 0 {
+		gasLimit = q.GetBeforeSendHookGasLimit(ctx, req.GetDenom())
+	}
+
+	return &types.QueryBeforeSendHooksResponse{CosmwasmAddresses: cosmwasmAddresses, GasLimit: gasLimit}, nil
+}
+
+func (q Querier) DenomRoles(ctx context.Context, req *types.QueryDenomRolesRequest) (*types.QueryDenomRolesResponse, error) {
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	roles, err := q.GetDenomRoles(ctx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomRolesResponse{Roles: roles}, nil
+}
+
+func (q Querier) MintAllowance(ctx context.Context, req *types.QueryMintAllowanceRequest) (*types.QueryMintAllowanceResponse, error) {
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	isMinter, err := q.HasRole(ctx, req.GetDenom(), types.DenomRoleMinter, req.GetMinter())
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, limited, err := q.GetMintAllowance(ctx, req.GetDenom(), req.GetMinter())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMintAllowanceResponse{
+		IsMinter:  isMinter,
+		Unlimited: isMinter && !limited,
+		Allowance: allowance,
+	}, nil
+}
+
+func (q Querier) SupplyCap(ctx context.Context, req *types.QuerySupplyCapRequest) (*types.QuerySupplyCapResponse, error) {
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	supplyCap, capped, err := q.GetSupplyCap(ctx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySupplyCapResponse{Capped: capped, SupplyCap: supplyCap}, nil
+}
+
+func (q Querier) DenomPaused(ctx context.Context, req *types.QueryDenomPausedRequest) (*types.QueryDenomPausedResponse, error) {
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	paused, err := q.IsDenomPaused(ctx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomPausedResponse{Paused: paused}, nil
+}
+
+func (q Querier) AccountFrozen(ctx context.Context, req *types.QueryAccountFrozenRequest) (*types.QueryAccountFrozenResponse, error) {
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	frozen, err := q.IsAccountFrozen(ctx, req.GetDenom(), req.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountFrozenResponse{Frozen: frozen}, nil
+}
+
+func (q Querier) FrozenAccounts(ctx context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	addrs, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.FrozenAccounts, req.Pagination, func(key collections.Pair[string, string], _ collections.NoValue) (string, error) {
+		return key.K2(), nil
+	}, query.WithCollectionPaginationPairPrefix[string, string](req.Denom))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFrozenAccountsResponse{Addresses: addrs, Pagination: pageRes}, nil
+}
+
+func (q Querier) DenomStrictMode(ctx context.Context, req *types.QueryDenomStrictModeRequest) (*types.QueryDenomStrictModeResponse, error) {
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	strict, err := q.IsDenomStrict(ctx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomStrictModeResponse{Strict: strict}, nil
+}
+
+func (q Querier) BeforeSendHookFailures(ctx context.Context, req *types.QueryBeforeSendHookFailuresRequest) (*types.QueryBeforeSendHookFailuresResponse, error) {
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	failures, err := q.GetBeforeSendHookFailures(ctx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBeforeSendHookFailuresResponse{Failures: failures}, nil
+}
+
+func (q Querier) PendingAdminTransfer(ctx context.Context, req *types.QueryPendingAdminTransferRequest) (*types.QueryPendingAdminTransferResponse, error) {
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	transfer, err := q.GetPendingAdminTransfer(ctx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingAdminTransferResponse{PendingAdminTransfer: transfer}, nil
+}
+
+func (q Querier) AllDenoms(ctx context.Context, req *types.QueryAllDenomsRequest) (*types.QueryAllDenomsResponse, error) {
+	denoms, pageRes, err := query.CollectionPaginate(ctx, q.DenomAuthority, req.Pagination, func(denom string, _ types.DenomAuthorityMetadata) (string, error) {
+		return denom, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllDenomsResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
+
+func (q Querier) DenomsByAdmin(ctx context.Context, req *types.QueryDenomsByAdminRequest) (*types.QueryDenomsByAdminResponse, error) {
+	if _, err := q.ac.StringToBytes(req.Admin); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	denoms, pageRes, err := query.CollectionPaginate(ctx, q.AdminDenoms, req.Pagination, func(key collections.Pair[string, string], _ collections.NoValue) (string, error) {
+		return key.K2(), nil
+	}, query.WithCollectionPaginationPairPrefix[string, string](req.Admin))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsByAdminResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
+
+func (q Querier) DenomsByHookContract(ctx context.Context, req *types.QueryDenomsByHookContractRequest) (*types.QueryDenomsByHookContractResponse, error) {
+	if _, err := q.ac.StringToBytes(req.CosmwasmAddress); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	denoms, pageRes, err := query.CollectionPaginate(ctx, q.HookContractDenoms, req.Pagination, func(key collections.Pair[string, string], _ collections.NoValue) (string, error) {
+		return key.K2(), nil
+	}, query.WithCollectionPaginationPairPrefix[string, string](req.CosmwasmAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsByHookContractResponse{Denoms: denoms, Pagination: pageRes}, nil
 }
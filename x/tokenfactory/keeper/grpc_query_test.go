@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/keeper"
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+func TestDenomListQueries(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	msgServer := keeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+	querier := keeper.Querier{Keeper: input.TokenFactoryKeeper}
+
+	wasmCode, err := os.ReadFile("./testdata/no100.wasm")
+	require.NoError(t, err)
+	codeID, _, err := input.ContractKeeper.Create(ctx, addrs[0], wasmCode, nil)
+	require.NoError(t, err)
+	hook, _, err := input.ContractKeeper.Instantiate(ctx, codeID, addrs[0], addrs[0], []byte("{}"), "", sdk.NewCoins())
+	require.NoError(t, err)
+
+	admin, another := addrs[0].String(), addrs[1].String()
+
+	var denoms []string
+	for _, subdenom := range []string{"a", "b", "c"} {
+		res, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(admin, subdenom))
+		require.NoError(t, err)
+		denoms = append(denoms, res.GetNewTokenDenom())
+	}
+
+	// all denoms with pagination
+	allRes, err := querier.AllDenoms(ctx, &types.QueryAllDenomsRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, denoms[:2], allRes.Denoms)
+	require.NotNil(t, allRes.Pagination.NextKey)
+
+	allRes, err = querier.AllDenoms(ctx, &types.QueryAllDenomsRequest{Pagination: &query.PageRequest{Key: allRes.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, denoms[2:], allRes.Denoms)
+
+	// the admin index follows admin changes
+	_, err = msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(admin, denoms[1], another))
+	require.NoError(t, err)
+	_, err = msgServer.RenounceAdmin(ctx, types.NewMsgRenounceAdmin(admin, denoms[2]))
+	require.NoError(t, err)
+
+	adminRes, err := querier.DenomsByAdmin(ctx, &types.QueryDenomsByAdminRequest{Admin: admin})
+	require.NoError(t, err)
+	require.Equal(t, []string{denoms[0]}, adminRes.Denoms)
+
+	adminRes, err = querier.DenomsByAdmin(ctx, &types.QueryDenomsByAdminRequest{Admin: another})
+	require.NoError(t, err)
+	require.Equal(t, []string{denoms[1]}, adminRes.Denoms)
+
+	_, err = querier.DenomsByAdmin(ctx, &types.QueryDenomsByAdminRequest{Admin: "invalid"})
+	require.Error(t, err)
+
+	// the hook contract index follows hook changes
+	_, err = msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(admin, denoms[0], hook.String()))
+	require.NoError(t, err)
+	_, err = msgServer.SetBeforeSendHooks(ctx, types.NewMsgSetBeforeSendHooks(another, denoms[1], []string{hook.String()}))
+	require.NoError(t, err)
+
+	hookRes, err := querier.DenomsByHookContract(ctx, &types.QueryDenomsByHookContractRequest{CosmwasmAddress: hook.String()})
+	require.NoError(t, err)
+	require.Equal(t, denoms[:2], hookRes.Denoms)
+
+	_, err = msgServer.SetBeforeSendHooks(ctx, types.NewMsgSetBeforeSendHooks(admin, denoms[0], nil))
+	require.NoError(t, err)
+
+	hookRes, err = querier.DenomsByHookContract(ctx, &types.QueryDenomsByHookContractRequest{CosmwasmAddress: hook.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{denoms[1]}, hookRes.Denoms)
+}
@@ -9,6 +9,7 @@ import (
 	corestoretypes "cosmossdk.io/core/store"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
@@ -27,13 +28,39 @@ type Keeper struct {
 	contractKeeper types.ContractKeeper
 
 	communityPoolKeeper types.CommunityPoolKeeper
+	opChildKeeper       types.OPChildKeeper
 
 	Schema collections.Schema
 	//  key = [creator,denom], value = metadata
 	CreatorDenoms  collections.KeySet[collections.Pair[string, string]]
 	DenomAuthority collections.Map[string, types.DenomAuthorityMetadata]
-	DenomHookAddr  collections.Map[string, string]
-	Params         collections.Item[types.Params]
+	// key = [admin, denom], index of DenomAuthority by admin
+	AdminDenoms collections.KeySet[collections.Pair[string, string]]
+	// DenomHookAddr is the single hook store of consensus version 1, only read
+	// by the store migration to DenomHooks.
+	DenomHookAddr collections.Map[string, string]
+	// key = [denom, call order], value = hook contract address
+	DenomHooks collections.Map[collections.Pair[string, uint64], string]
+	// key = [hook contract, denom], index of DenomHooks by contract
+	HookContractDenoms collections.KeySet[collections.Pair[string, string]]
+	DenomHookGas       collections.Map[string, uint64]
+	// key = [denom, role, address]
+	RoleGrants collections.KeySet[collections.Triple[string, int32, string]]
+	// key = [denom, minter], value = remaining mint allowance
+	MintAllowances collections.Map[collections.Pair[string, string], math.Int]
+	// key = denom, value = max supply
+	SupplyCaps collections.Map[string, math.Int]
+	// key = denom, value = admin transfer waiting for acceptance
+	PendingAdminTransfers collections.Map[string, types.PendingAdminTransfer]
+	// key = denom, set when the transfers of the denom are paused
+	PausedDenoms collections.KeySet[string]
+	// key = [denom, address], set when the account is frozen for the denom
+	FrozenAccounts collections.KeySet[collections.Pair[string, string]]
+	// key = denom, set when the module to module sends of the denom are checked
+	StrictDenoms collections.KeySet[string]
+	// key = denom, value = failure counter of the before send hooks
+	HookFailures collections.Map[string, types.BeforeSendHookFailures]
+	Params       collections.Item[types.Params]
 
 	authority string
 }
@@ -46,6 +73,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	communityPoolKeeper types.CommunityPoolKeeper,
+	opChildKeeper types.OPChildKeeper,
 	authority string,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
@@ -57,10 +85,24 @@ func NewKeeper(
 		accountKeeper:       accountKeeper,
 		bankKeeper:          bankKeeper,
 		communityPoolKeeper: communityPoolKeeper,
+		opChildKeeper:       opChildKeeper,
 
 		CreatorDenoms:  collections.NewKeySet(sb, types.CreatorDenomsPrefix, "creatordenom", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
 		DenomAuthority: collections.NewMap(sb, types.DenomAuthorityPrefix, "denomauthority", collections.StringKey, codec.CollValue[types.DenomAuthorityMetadata](cdc)),
 		DenomHookAddr:  collections.NewMap(sb, types.DenomHookAddrPrefix, "denomhookaddr", collections.StringKey, collections.StringValue),
+		DenomHooks:     collections.NewMap(sb, types.DenomHooksPrefix, "denomhooks", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.StringValue),
+		DenomHookGas:   collections.NewMap(sb, types.DenomHookGasPrefix, "denomhookgas", collections.StringKey, collections.Uint64Value),
+		RoleGrants:     collections.NewKeySet(sb, types.DenomRolesPrefix, "denomroles", collections.TripleKeyCodec(collections.StringKey, collections.Int32Key, collections.StringKey)),
+		MintAllowances: collections.NewMap(sb, types.MintAllowancesPrefix, "mintallowances", collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue),
+		SupplyCaps:     collections.NewMap(sb, types.SupplyCapsPrefix, "supplycaps", collections.StringKey, sdk.IntValue),
+
+		AdminDenoms:           collections.NewKeySet(sb, types.AdminDenomsPrefix, "admindenoms", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		HookContractDenoms:    collections.NewKeySet(sb, types.HookContractDenomsPrefix, "hookcontractdenoms", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		PendingAdminTransfers: collections.NewMap(sb, types.PendingAdminTransfersPrefix, "pendingadmintransfers", collections.StringKey, codec.CollValue[types.PendingAdminTransfer](cdc)),
+		PausedDenoms:          collections.NewKeySet(sb, types.PausedDenomsPrefix, "pauseddenoms", collections.StringKey),
+		FrozenAccounts:        collections.NewKeySet(sb, types.FrozenAccountsPrefix, "frozenaccounts", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		StrictDenoms:          collections.NewKeySet(sb, types.StrictDenomsPrefix, "strictdenoms", collections.StringKey),
+		HookFailures:          collections.NewMap(sb, types.HookFailuresPrefix, "hookfailures", collections.StringKey, codec.CollValue[types.BeforeSendHookFailures](cdc)),
 
 		Params: collections.NewItem(sb, types.ParamsKeyPrefix, "params", codec.CollValue[types.Params](cdc)),
 
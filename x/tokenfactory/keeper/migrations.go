@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2 by moving the single before send
+// hook of each denom into the ordered before send hook list, and by setting
+// the default max_before_send_hook_gas_limit param.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.MaxBeforeSendHookGasLimit == 0 {
+		params.MaxBeforeSendHookGasLimit = types.DefaultMaxBeforeSendHookGasLimit
+		if err := m.keeper.SetParams(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	hooks := make(map[string]string)
+	err := m.keeper.DenomHookAddr.Walk(ctx, nil, func(denom, cosmwasmAddress string) (bool, error) {
+		hooks[denom] = cosmwasmAddress
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for denom, cosmwasmAddress := range hooks {
+		if cosmwasmAddress != "" {
+			if err := m.keeper.DenomHooks.Set(ctx, collections.Join(denom, uint64(0)), cosmwasmAddress); err != nil {
+				return err
+			}
+		}
+
+		if err := m.keeper.DenomHookAddr.Remove(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3 by building the admin and hook
+// contract indexes of the existing denoms.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	err := m.keeper.DenomAuthority.Walk(ctx, nil, func(denom string, metadata types.DenomAuthorityMetadata) (bool, error) {
+		if metadata.Admin == "" {
+			return false, nil
+		}
+
+		return false, m.keeper.AdminDenoms.Set(ctx, collections.Join(metadata.Admin, denom))
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.keeper.DenomHooks.Walk(ctx, nil, func(key collections.Pair[string, uint64], cosmwasmAddress string) (bool, error) {
+		return false, m.keeper.HookContractDenoms.Set(ctx, collections.Join(cosmwasmAddress, key.K1()))
+	})
+}
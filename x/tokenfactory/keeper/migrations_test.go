@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/keeper"
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	k := input.TokenFactoryKeeper
+
+	// version 1 has no max before send hook gas limit param
+	params := k.GetParams(ctx)
+	params.MaxBeforeSendHookGasLimit = 0
+	require.NoError(t, k.SetParams(ctx, params))
+
+	denomA := "factory/" + addrs[0].String() + "/a"
+	denomB := "factory/" + addrs[0].String() + "/b"
+	require.NoError(t, k.DenomHookAddr.Set(ctx, denomA, addrs[1].String()))
+	require.NoError(t, k.DenomHookAddr.Set(ctx, denomB, addrs[2].String()))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	require.Equal(t, []string{addrs[1].String()}, k.GetBeforeSendHooks(ctx, denomA))
+	require.Equal(t, []string{addrs[2].String()}, k.GetBeforeSendHooks(ctx, denomB))
+	require.Equal(t, types.DefaultMaxBeforeSendHookGasLimit, k.GetParams(ctx).MaxBeforeSendHookGasLimit)
+
+	has, err := k.DenomHookAddr.Has(ctx, denomA)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = k.DenomHookAddr.Has(ctx, denomB)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	k := input.TokenFactoryKeeper
+
+	denomA := "factory/" + addrs[0].String() + "/a"
+	denomB := "factory/" + addrs[0].String() + "/b"
+	require.NoError(t, k.DenomAuthority.Set(ctx, denomA, types.DenomAuthorityMetadata{Admin: addrs[0].String()}))
+	require.NoError(t, k.DenomAuthority.Set(ctx, denomB, types.DenomAuthorityMetadata{}))
+	require.NoError(t, k.DenomHooks.Set(ctx, collections.Join(denomA, uint64(0)), addrs[1].String()))
+	require.NoError(t, k.DenomHooks.Set(ctx, collections.Join(denomB, uint64(0)), addrs[1].String()))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+
+	has, err := k.AdminDenoms.Has(ctx, collections.Join(addrs[0].String(), denomA))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = k.AdminDenoms.Has(ctx, collections.Join("", denomB))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	has, err = k.HookContractDenoms.Has(ctx, collections.Join(addrs[1].String(), denomA))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = k.HookContractDenoms.Has(ctx, collections.Join(addrs[1].String(), denomB))
+	require.NoError(t, err)
+	require.True(t, has)
+}
@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -33,12 +36,28 @@ func (server msgServer) CreateDenom(ctx context.Context, msg *types.MsgCreateDen
 		return nil, err
 	}
 
+	// the capabilities can only be opted into at denom creation
+	if msg.EnableBurnFrom || msg.EnableForceTransfer {
+		authorityMetadata, err := server.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		authorityMetadata.BurnFromEnabled = msg.EnableBurnFrom
+		authorityMetadata.ForceTransferEnabled = msg.EnableForceTransfer
+		if err := server.setAuthorityMetadata(ctx, denom, authorityMetadata); err != nil {
+			return nil, err
+		}
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgCreateDenom,
 			sdk.NewAttribute(types.AttributeCreator, msg.Sender),
 			sdk.NewAttribute(types.AttributeNewTokenDenom, denom),
+			sdk.NewAttribute(types.AttributeBurnFromEnabled, strconv.FormatBool(msg.EnableBurnFrom)),
+			sdk.NewAttribute(types.AttributeForceTransferEnabled, strconv.FormatBool(msg.EnableForceTransfer)),
 		),
 	})
 
@@ -63,8 +82,17 @@ func (server msgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.Ms
 		return nil, err
 	}
 
+	// the admin mints without limit, other minters within their allowance
 	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
+		if isMinter, err := server.HasRole(ctx, msg.Amount.GetDenom(), types.DenomRoleMinter, msg.Sender); err != nil {
+			return nil, err
+		} else if !isMinter {
+			return nil, types.ErrUnauthorized
+		}
+
+		if err := server.useMintAllowance(ctx, msg.Amount.GetDenom(), msg.Sender, msg.Amount.Amount); err != nil {
+			return nil, err
+		}
 	}
 
 	if msg.MintToAddress == "" {
@@ -93,15 +121,11 @@ func (server msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.Ms
 		return nil, err
 	}
 
-	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Amount.GetDenom())
+	_, err := server.authorize(ctx, msg.Amount.GetDenom(), types.DenomRoleBurner, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
-	}
-
 	acc, err := server.ac.StringToBytes(msg.Sender)
 	if err != nil {
 		return nil, err
@@ -130,6 +154,482 @@ func (server msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.Ms
 	return &types.MsgBurnResponse{}, nil
 }
 
+func (server msgServer) BurnFrom(ctx context.Context, msg *types.MsgBurnFrom) (*types.MsgBurnFromResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Amount.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	// burning from other accounts stays with the admin, burners can only burn
+	// their own balance
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if !authorityMetadata.BurnFromEnabled {
+		return nil, types.ErrCapabilityDisabled.Wrapf("burn from is not enabled for denom: %s", msg.Amount.GetDenom())
+	}
+
+	acc, err := server.ac.StringToBytes(msg.BurnFromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	accountI := server.accountKeeper.GetAccount(ctx, acc)
+	_, ok := accountI.(sdk.ModuleAccountI)
+	if ok {
+		return nil, types.ErrBurnFromModuleAccount
+	}
+
+	err = server.burnFrom(ctx, msg.Amount, msg.BurnFromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgBurnFrom,
+			sdk.NewAttribute(types.AttributeBurnFromAddress, msg.BurnFromAddress),
+			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
+		),
+	})
+
+	return &types.MsgBurnFromResponse{}, nil
+}
+
+func (server msgServer) ForceTransfer(ctx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Amount.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if !authorityMetadata.ForceTransferEnabled {
+		return nil, types.ErrCapabilityDisabled.Wrapf("force transfer is not enabled for denom: %s", msg.Amount.GetDenom())
+	}
+
+	acc, err := server.ac.StringToBytes(msg.TransferFromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	accountI := server.accountKeeper.GetAccount(ctx, acc)
+	_, ok := accountI.(sdk.ModuleAccountI)
+	if ok {
+		return nil, types.ErrUnauthorized.Wrap("force transfer from module account is not allowed")
+	}
+
+	err = server.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgForceTransfer,
+			sdk.NewAttribute(types.AttributeTransferFromAddress, msg.TransferFromAddress),
+			sdk.NewAttribute(types.AttributeTransferToAddress, msg.TransferToAddress),
+			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
+		),
+	})
+
+	return &types.MsgForceTransferResponse{}, nil
+}
+
+func (server msgServer) DisableDenomCapabilities(ctx context.Context, msg *types.MsgDisableDenomCapabilities) (*types.MsgDisableDenomCapabilitiesResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	authorityMetadata, err = server.disableCapabilities(ctx, msg.Denom, msg.DisableBurnFrom, msg.DisableForceTransfer)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgDisableDenomCapabilities,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeBurnFromEnabled, strconv.FormatBool(authorityMetadata.BurnFromEnabled)),
+			sdk.NewAttribute(types.AttributeForceTransferEnabled, strconv.FormatBool(authorityMetadata.ForceTransferEnabled)),
+		),
+	})
+
+	return &types.MsgDisableDenomCapabilitiesResponse{}, nil
+}
+
+func (server msgServer) GrantRole(ctx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.grantRole(ctx, msg.Denom, msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgGrantRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeRole, msg.Role.String()),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+		),
+	})
+
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+func (server msgServer) RevokeRole(ctx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.revokeRole(ctx, msg.Denom, msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRevokeRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeRole, msg.Role.String()),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+		),
+	})
+
+	return &types.MsgRevokeRoleResponse{}, nil
+}
+
+func (server msgServer) SetMintAllowance(ctx context.Context, msg *types.MsgSetMintAllowance) (*types.MsgSetMintAllowanceResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	allowance := "unlimited"
+	if msg.Unlimited {
+		err = server.setMintAllowance(ctx, msg.Denom, msg.Minter, nil)
+	} else {
+		allowance = msg.Allowance.String()
+		err = server.setMintAllowance(ctx, msg.Denom, msg.Minter, &msg.Allowance)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMintAllowance,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMinter, msg.Minter),
+			sdk.NewAttribute(types.AttributeAllowance, allowance),
+		),
+	})
+
+	return &types.MsgSetMintAllowanceResponse{}, nil
+}
+
+func (server msgServer) SetSupplyCap(ctx context.Context, msg *types.MsgSetSupplyCap) (*types.MsgSetSupplyCapResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.setSupplyCap(ctx, msg.Denom, msg.SupplyCap)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetSupplyCap,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeSupplyCap, msg.SupplyCap.String()),
+		),
+	})
+
+	return &types.MsgSetSupplyCapResponse{}, nil
+}
+
+func (server msgServer) SetDenomPaused(ctx context.Context, msg *types.MsgSetDenomPaused) (*types.MsgSetDenomPausedResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.setDenomPaused(ctx, msg.Denom, msg.Paused)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetDenomPaused,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributePaused, strconv.FormatBool(msg.Paused)),
+		),
+	})
+
+	return &types.MsgSetDenomPausedResponse{}, nil
+}
+
+func (server msgServer) SetAccountFrozen(ctx context.Context, msg *types.MsgSetAccountFrozen) (*types.MsgSetAccountFrozenResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.setAccountFrozen(ctx, msg.Denom, msg.Address, msg.Frozen)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetAccountFrozen,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeFrozen, strconv.FormatBool(msg.Frozen)),
+		),
+	})
+
+	return &types.MsgSetAccountFrozenResponse{}, nil
+}
+
+func (server msgServer) SetDenomStrictMode(ctx context.Context, msg *types.MsgSetDenomStrictMode) (*types.MsgSetDenomStrictModeResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.setDenomStrictMode(ctx, msg.Denom, msg.Strict)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetDenomStrictMode,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeStrictMode, strconv.FormatBool(msg.Strict)),
+		),
+	})
+
+	return &types.MsgSetDenomStrictModeResponse{}, nil
+}
+
+func (server msgServer) ProposeAdmin(ctx context.Context, msg *types.MsgProposeAdmin) (*types.MsgProposeAdminResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.proposeAdmin(ctx, msg.Denom, types.PendingAdminTransfer{
+		PendingAdmin: msg.NewAdmin,
+		ExpiresAt:    msg.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := ""
+	if !msg.ExpiresAt.IsZero() {
+		expiresAt = msg.ExpiresAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgProposeAdmin,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributePendingAdmin, msg.NewAdmin),
+			sdk.NewAttribute(types.AttributeExpiresAt, expiresAt),
+		),
+	})
+
+	return &types.MsgProposeAdminResponse{}, nil
+}
+
+func (server msgServer) AcceptAdmin(ctx context.Context, msg *types.MsgAcceptAdmin) (*types.MsgAcceptAdminResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	err := server.acceptAdmin(ctx, msg.Denom, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgAcceptAdmin,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeNewAdmin, msg.Sender),
+		),
+	})
+
+	return &types.MsgAcceptAdminResponse{}, nil
+}
+
+func (server msgServer) CancelAdminTransfer(ctx context.Context, msg *types.MsgCancelAdminTransfer) (*types.MsgCancelAdminTransferResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.cancelAdminTransfer(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgCancelAdminTransfer,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+		),
+	})
+
+	return &types.MsgCancelAdminTransferResponse{}, nil
+}
+
+func (server msgServer) RenounceAdmin(ctx context.Context, msg *types.MsgRenounceAdmin) (*types.MsgRenounceAdminResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.renounceAdmin(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRenounceAdmin,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+		),
+	})
+
+	return &types.MsgRenounceAdminResponse{}, nil
+}
+
 func (server msgServer) ChangeAdmin(ctx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
 	if err := msg.Validate(server.ac); err != nil {
 		return nil, err
@@ -144,6 +644,11 @@ func (server msgServer) ChangeAdmin(ctx context.Context, msg *types.MsgChangeAdm
 		return nil, types.ErrUnauthorized
 	}
 
+	// a direct admin change supersedes any pending transfer
+	if err := server.PendingAdminTransfers.Remove(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
 	err = server.setAdmin(ctx, msg.Denom, msg.NewAdmin)
 	if err != nil {
 		return nil, err
@@ -172,15 +677,11 @@ func (server msgServer) SetDenomMetadata(ctx context.Context, msg *types.MsgSetD
 		return nil, err
 	}
 
-	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Metadata.Base)
+	_, err = server.authorize(ctx, msg.Metadata.Base, types.DenomRoleMetadataManager, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
-	}
-
 	server.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -200,16 +701,12 @@ func (server msgServer) SetBeforeSendHook(ctx context.Context, msg *types.MsgSet
 		return nil, err
 	}
 
-	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	_, err := server.authorize(ctx, msg.Denom, types.DenomRoleHookManager, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
-	}
-
-	err = server.setBeforeSendHook(ctx, msg.Denom, msg.CosmwasmAddress)
+	err = server.setBeforeSendHook(ctx, msg.Denom, msg.CosmwasmAddress, msg.GasLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -226,6 +723,33 @@ func (server msgServer) SetBeforeSendHook(ctx context.Context, msg *types.MsgSet
 	return &types.MsgSetBeforeSendHookResponse{}, nil
 }
 
+func (server msgServer) SetBeforeSendHooks(ctx context.Context, msg *types.MsgSetBeforeSendHooks) (*types.MsgSetBeforeSendHooksResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	_, err := server.authorize(ctx, msg.Denom, types.DenomRoleHookManager, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.setBeforeSendHooks(ctx, msg.Denom, msg.CosmwasmAddresses, msg.GasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetBeforeSendHooks,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeBeforeSendHookAddress, strings.Join(msg.GetCosmwasmAddresses(), ",")),
+		),
+	})
+
+	return &types.MsgSetBeforeSendHooksResponse{}, nil
+}
+
 func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
@@ -235,6 +759,10 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 		return nil, err
 	}
 
+	if err := k.checkDenomCreationFeeRecipient(req.Params); err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
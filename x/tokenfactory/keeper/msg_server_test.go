@@ -11,6 +11,7 @@ import (
 	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -128,6 +129,79 @@ func TestBurnDenomMsg(t *testing.T) {
 }
 
 // TestCreateDenomMsg tests TypeMsgCreateDenom message is emitted on a successful denom creation
+func TestBurnFromAndForceTransferMsg(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	msgServer := keeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+
+	// denom without capabilities
+	res, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(addrs[0].String(), "plain"))
+	require.NoError(t, err)
+	plainDenom := res.GetNewTokenDenom()
+	_, err = msgServer.Mint(ctx, types.NewMsgMintTo(addrs[0].String(), sdk.NewInt64Coin(plainDenom, 100), addrs[1].String()))
+	require.NoError(t, err)
+
+	_, err = msgServer.BurnFrom(ctx, types.NewMsgBurnFrom(addrs[0].String(), sdk.NewInt64Coin(plainDenom, 10), addrs[1].String()))
+	require.ErrorIs(t, err, types.ErrCapabilityDisabled)
+	_, err = msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(addrs[0].String(), sdk.NewInt64Coin(plainDenom, 10), addrs[1].String(), addrs[2].String()))
+	require.ErrorIs(t, err, types.ErrCapabilityDisabled)
+
+	// denom opted into both capabilities
+	msg := types.NewMsgCreateDenom(addrs[0].String(), "regulated")
+	msg.EnableBurnFrom = true
+	msg.EnableForceTransfer = true
+	res, err = msgServer.CreateDenom(ctx, msg)
+	require.NoError(t, err)
+	denom := res.GetNewTokenDenom()
+
+	authorityMetadata, err := input.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	require.NoError(t, err)
+	require.True(t, authorityMetadata.BurnFromEnabled)
+	require.True(t, authorityMetadata.ForceTransferEnabled)
+
+	_, err = msgServer.Mint(ctx, types.NewMsgMintTo(addrs[0].String(), sdk.NewInt64Coin(denom, 100), addrs[1].String()))
+	require.NoError(t, err)
+
+	// only the admin can use the capabilities
+	_, err = msgServer.BurnFrom(ctx, types.NewMsgBurnFrom(addrs[1].String(), sdk.NewInt64Coin(denom, 10), addrs[1].String()))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(addrs[1].String(), sdk.NewInt64Coin(denom, 10), addrs[1].String(), addrs[2].String()))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.BurnFrom(ctx, types.NewMsgBurnFrom(addrs[0].String(), sdk.NewInt64Coin(denom, 10), addrs[1].String()))
+	require.NoError(t, err)
+	require.Equal(t, int64(90), input.BankKeeper.GetBalance(ctx, addrs[1], denom).Amount.Int64())
+	require.Equal(t, int64(90), input.BankKeeper.GetSupply(ctx, denom).Amount.Int64())
+
+	_, err = msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(addrs[0].String(), sdk.NewInt64Coin(denom, 30), addrs[1].String(), addrs[2].String()))
+	require.NoError(t, err)
+	require.Equal(t, int64(60), input.BankKeeper.GetBalance(ctx, addrs[1], denom).Amount.Int64())
+	require.Equal(t, int64(30), input.BankKeeper.GetBalance(ctx, addrs[2], denom).Amount.Int64())
+
+	// module accounts are protected
+	moduleAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	_, err = msgServer.BurnFrom(ctx, types.NewMsgBurnFrom(addrs[0].String(), sdk.NewInt64Coin(denom, 10), moduleAddr.String()))
+	require.ErrorIs(t, err, types.ErrBurnFromModuleAccount)
+
+	// disabled capabilities cannot be used anymore
+	_, err = msgServer.DisableDenomCapabilities(ctx, types.NewMsgDisableDenomCapabilities(addrs[1].String(), denom, true, false))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.DisableDenomCapabilities(ctx, types.NewMsgDisableDenomCapabilities(addrs[0].String(), denom, true, false))
+	require.NoError(t, err)
+
+	_, err = msgServer.BurnFrom(ctx, types.NewMsgBurnFrom(addrs[0].String(), sdk.NewInt64Coin(denom, 10), addrs[1].String()))
+	require.ErrorIs(t, err, types.ErrCapabilityDisabled)
+	_, err = msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(addrs[0].String(), sdk.NewInt64Coin(denom, 10), addrs[1].String(), addrs[2].String()))
+	require.NoError(t, err)
+
+	// the capabilities survive an admin change
+	_, err = msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(addrs[0].String(), denom, addrs[3].String()))
+	require.NoError(t, err)
+	authorityMetadata, err = input.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, types.DenomAuthorityMetadata{Admin: addrs[3].String(), ForceTransferEnabled: true}, authorityMetadata)
+}
+
 func TestCreateDenomMsg(t *testing.T) {
 	for _, tc := range []struct {
 		desc                  string
@@ -354,6 +428,19 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErr:    true,
 			expErrMsg: "invalid denom creation fee",
 		},
+		{
+			name: "blocked denom creation fee recipient",
+			input: &types.MsgUpdateParams{
+				Authority: input.TokenFactoryKeeper.GetAuthority(),
+				Params: types.Params{
+					DenomCreationFee:            sdk.NewCoins(sdk.NewInt64Coin("uinit", 1000)),
+					DenomCreationFeeDestination: types.FeeDestinationAddress,
+					DenomCreationFeeRecipient:   authtypes.NewModuleAddress(types.ModuleName).String(),
+				},
+			},
+			expErr:    true,
+			expErrMsg: "is not allowed to receive the denom creation fee",
+		},
 		{
 			name: "all good",
 			input: &types.MsgUpdateParams{
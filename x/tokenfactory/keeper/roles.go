@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+// HasRole returns true if the address is granted the role of the denom.
+func (k Keeper) HasRole(ctx context.Context, denom string, role types.DenomRole, addr string) (bool, error) {
+	return k.RoleGrants.Has(ctx, collections.Join3(denom, int32(role), addr))
+}
+
+// authorize returns the authority metadata of the denom if the sender is the
+// admin of the denom or is granted the role.
+func (k Keeper) authorize(ctx context.Context, denom string, role types.DenomRole, sender string) (types.DenomAuthorityMetadata, error) {
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return authorityMetadata, err
+	}
+
+	if sender == authorityMetadata.GetAdmin() {
+		return authorityMetadata, nil
+	}
+
+	if has, err := k.HasRole(ctx, denom, role, sender); err != nil {
+		return authorityMetadata, err
+	} else if !has {
+		return authorityMetadata, types.ErrUnauthorized
+	}
+
+	return authorityMetadata, nil
+}
+
+func (k Keeper) grantRole(ctx context.Context, denom string, role types.DenomRole, addr string) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+
+	return k.RoleGrants.Set(ctx, collections.Join3(denom, int32(role), addr))
+}
+
+func (k Keeper) revokeRole(ctx context.Context, denom string, role types.DenomRole, addr string) error {
+	if has, err := k.HasRole(ctx, denom, role, addr); err != nil {
+		return err
+	} else if !has {
+		return types.ErrInvalidRole.Wrapf("%s is not granted %s", addr, role)
+	}
+
+	if role == types.DenomRoleMinter {
+		if err := k.MintAllowances.Remove(ctx, collections.Join(denom, addr)); err != nil {
+			return err
+		}
+	}
+
+	return k.RoleGrants.Remove(ctx, collections.Join3(denom, int32(role), addr))
+}
+
+// setMintAllowance sets the mint allowance of a minter. A nil allowance
+// removes the limit.
+func (k Keeper) setMintAllowance(ctx context.Context, denom string, minter string, allowance *math.Int) error {
+	if has, err := k.HasRole(ctx, denom, types.DenomRoleMinter, minter); err != nil {
+		return err
+	} else if !has {
+		return types.ErrInvalidRole.Wrapf("%s is not a minter", minter)
+	}
+
+	if allowance == nil {
+		return k.MintAllowances.Remove(ctx, collections.Join(denom, minter))
+	}
+
+	return k.MintAllowances.Set(ctx, collections.Join(denom, minter), *allowance)
+}
+
+// GetMintAllowance returns the remaining mint allowance of a minter and
+// false if the minter can mint without limit.
+func (k Keeper) GetMintAllowance(ctx context.Context, denom string, minter string) (math.Int, bool, error) {
+	allowance, err := k.MintAllowances.Get(ctx, collections.Join(denom, minter))
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), false, nil
+	} else if err != nil {
+		return math.ZeroInt(), false, err
+	}
+
+	return allowance, true, nil
+}
+
+// useMintAllowance deducts the amount from the mint allowance of the minter,
+// if the minter has one.
+func (k Keeper) useMintAllowance(ctx context.Context, denom string, minter string, amount math.Int) error {
+	allowance, limited, err := k.GetMintAllowance(ctx, denom, minter)
+	if err != nil || !limited {
+		return err
+	}
+
+	if allowance.LT(amount) {
+		return types.ErrMintAllowanceExceeded.Wrapf("remaining allowance %s, requested %s", allowance, amount)
+	}
+
+	return k.MintAllowances.Set(ctx, collections.Join(denom, minter), allowance.Sub(amount))
+}
+
+// GetDenomRoles returns the roles granted for the denom.
+func (k Keeper) GetDenomRoles(ctx context.Context, denom string) ([]types.DenomRoleGrant, error) {
+	grants := []types.DenomRoleGrant{}
+	rng := collections.NewPrefixedTripleRange[string, int32, string](denom)
+	err := k.RoleGrants.Walk(ctx, rng, func(key collections.Triple[string, int32, string]) (bool, error) {
+		grants = append(grants, types.DenomRoleGrant{
+			Role:    types.DenomRole(key.K2()),
+			Address: key.K3(),
+		})
+		return false, nil
+	})
+
+	return grants, err
+}
+
+// GetMintAllowances returns the mint allowances of the limited minters of the
+// denom.
+func (k Keeper) GetMintAllowances(ctx context.Context, denom string) ([]types.MintAllowance, error) {
+	allowances := []types.MintAllowance{}
+	rng := collections.NewPrefixedPairRange[string, string](denom)
+	err := k.MintAllowances.Walk(ctx, rng, func(key collections.Pair[string, string], allowance math.Int) (bool, error) {
+		allowances = append(allowances, types.MintAllowance{
+			Minter:    key.K2(),
+			Allowance: allowance,
+		})
+		return false, nil
+	})
+
+	return allowances, err
+}
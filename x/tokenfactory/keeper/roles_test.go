@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/keeper"
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+func TestDenomRoles(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	msgServer := keeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+	querier := keeper.Querier{Keeper: input.TokenFactoryKeeper}
+
+	admin, minter, burner, manager := addrs[0].String(), addrs[1].String(), addrs[2].String(), addrs[3].String()
+
+	res, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(admin, "bitcoin"))
+	require.NoError(t, err)
+	denom := res.GetNewTokenDenom()
+
+	// nobody but the admin can mint before roles are granted
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(denom, 10)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// only the admin can manage roles
+	_, err = msgServer.GrantRole(ctx, types.NewMsgGrantRole(minter, denom, types.DenomRoleMinter, minter))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, denom, types.DenomRoleUnspecified, minter))
+	require.ErrorIs(t, err, types.ErrInvalidRole)
+
+	_, err = msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, denom, types.DenomRoleMinter, minter))
+	require.NoError(t, err)
+	_, err = msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, denom, types.DenomRoleBurner, burner))
+	require.NoError(t, err)
+	_, err = msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, denom, types.DenomRoleMetadataManager, manager))
+	require.NoError(t, err)
+
+	rolesRes, err := querier.DenomRoles(ctx, &types.QueryDenomRolesRequest{Denom: denom})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.DenomRoleGrant{
+		{Role: types.DenomRoleMinter, Address: minter},
+		{Role: types.DenomRoleBurner, Address: burner},
+		{Role: types.DenomRoleMetadataManager, Address: manager},
+	}, rolesRes.Roles)
+
+	// minter without allowance mints without limit
+	_, err = msgServer.Mint(ctx, types.NewMsgMintTo(minter, sdk.NewInt64Coin(denom, 1000), burner))
+	require.NoError(t, err)
+
+	// roles are scoped
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(burner, sdk.NewInt64Coin(denom, 10)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.Burn(ctx, types.NewMsgBurn(minter, sdk.NewInt64Coin(denom, 10)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(manager, denom, ""))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(manager, denom, manager))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.Burn(ctx, types.NewMsgBurn(burner, sdk.NewInt64Coin(denom, 10)))
+	require.NoError(t, err)
+
+	// burners cannot burn from other accounts
+	_, err = msgServer.BurnFrom(ctx, types.NewMsgBurnFrom(burner, sdk.NewInt64Coin(denom, 10), minter))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(manager, banktypes.Metadata{
+		Description: "bitcoin",
+		DenomUnits: []*banktypes.DenomUnit{{
+			Denom:    denom,
+			Exponent: 0,
+		}},
+		Base:    denom,
+		Display: denom,
+		Name:    "bitcoin",
+		Symbol:  "BTC",
+	}))
+	require.NoError(t, err)
+
+	// mint allowance bounds the minter
+	_, err = msgServer.SetMintAllowance(ctx, types.NewMsgSetMintAllowance(admin, denom, burner, math.NewInt(100)))
+	require.ErrorIs(t, err, types.ErrInvalidRole)
+	_, err = msgServer.SetMintAllowance(ctx, types.NewMsgSetMintAllowance(admin, denom, minter, math.NewInt(100)))
+	require.NoError(t, err)
+
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(denom, 60)))
+	require.NoError(t, err)
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(denom, 60)))
+	require.ErrorIs(t, err, types.ErrMintAllowanceExceeded)
+
+	allowanceRes, err := querier.MintAllowance(ctx, &types.QueryMintAllowanceRequest{Denom: denom, Minter: minter})
+	require.NoError(t, err)
+	require.True(t, allowanceRes.IsMinter)
+	require.False(t, allowanceRes.Unlimited)
+	require.Equal(t, math.NewInt(40), allowanceRes.Allowance)
+
+	// the admin is not bounded by allowances
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 1000)))
+	require.NoError(t, err)
+
+	// removing the limit
+	msg := types.NewMsgSetMintAllowance(admin, denom, minter, math.ZeroInt())
+	msg.Unlimited = true
+	_, err = msgServer.SetMintAllowance(ctx, msg)
+	require.NoError(t, err)
+	allowanceRes, err = querier.MintAllowance(ctx, &types.QueryMintAllowanceRequest{Denom: denom, Minter: minter})
+	require.NoError(t, err)
+	require.True(t, allowanceRes.Unlimited)
+
+	// revoking the minter role removes the allowance
+	_, err = msgServer.SetMintAllowance(ctx, types.NewMsgSetMintAllowance(admin, denom, minter, math.NewInt(5)))
+	require.NoError(t, err)
+	_, err = msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(admin, denom, types.DenomRoleMinter, minter))
+	require.NoError(t, err)
+	_, err = msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(admin, denom, types.DenomRoleMinter, minter))
+	require.ErrorIs(t, err, types.ErrInvalidRole)
+
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(denom, 1)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	allowances, err := input.TokenFactoryKeeper.GetMintAllowances(ctx, denom)
+	require.NoError(t, err)
+	require.Empty(t, allowances)
+}
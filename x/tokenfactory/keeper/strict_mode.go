@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+// IsDenomStrict returns true if the module to module sends of the denom go
+// through the blocking checks.
+func (k Keeper) IsDenomStrict(ctx context.Context, denom string) (bool, error) {
+	return k.StrictDenoms.Has(ctx, denom)
+}
+
+func (k Keeper) setDenomStrictMode(ctx context.Context, denom string, strict bool) error {
+	if !strict {
+		return k.StrictDenoms.Remove(ctx, denom)
+	}
+
+	return k.StrictDenoms.Set(ctx, denom)
+}
+
+// blockBeforeModuleSend checks the pause and freeze state and calls the
+// blocking before send hooks for the strict mode denoms of the amount. A
+// rejected send emits a module_send_blocked event, so that the failure is
+// visible even when the calling module swallows the error.
+func (k Keeper) blockBeforeModuleSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) (err error) {
+	strictAmount := sdk.NewCoins()
+	for _, coin := range amount {
+		if strict, err := k.IsDenomStrict(ctx, coin.Denom); err != nil {
+			return err
+		} else if strict {
+			strictAmount = strictAmount.Add(coin)
+		}
+	}
+	if strictAmount.IsZero() {
+		return nil
+	}
+
+	fromAddr, err := k.ac.BytesToString(from)
+	if err != nil {
+		return err
+	}
+
+	toAddr, err := k.ac.BytesToString(to)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeModuleSendBlocked,
+			sdk.NewAttribute(types.AttributeSender, fromAddr),
+			sdk.NewAttribute(types.AttributeRecipient, toAddr),
+			sdk.NewAttribute(types.AttributeAmount, strictAmount.String()),
+			sdk.NewAttribute(types.AttributeError, err.Error()),
+		))
+	}()
+
+	if err := k.checkTransferRestrictions(ctx, from, to, strictAmount); err != nil {
+		return err
+	}
+
+	return k.callBeforeSendListener(ctx, from, to, strictAmount, true)
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/keeper"
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+func TestDenomStrictMode(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	msgServer := keeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+	querier := keeper.Querier{Keeper: input.TokenFactoryKeeper}
+
+	admin := addrs[0].String()
+
+	res, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(admin, "bitcoin"))
+	require.NoError(t, err)
+	denom := res.GetNewTokenDenom()
+
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 100)))
+	require.NoError(t, err)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(ctx, addrs[0], authtypes.FeeCollectorName, amount.Add(amount...)))
+
+	_, err = msgServer.SetDenomPaused(ctx, types.NewMsgSetDenomPaused(admin, denom, true))
+	require.NoError(t, err)
+
+	// module to module sends are not checked by default
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, govtypes.ModuleName, amount))
+
+	// only the admin can set the strict mode
+	_, err = msgServer.SetDenomStrictMode(ctx, types.NewMsgSetDenomStrictMode(addrs[1].String(), denom, true))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.SetDenomStrictMode(ctx, types.NewMsgSetDenomStrictMode(admin, denom, true))
+	require.NoError(t, err)
+
+	strictRes, err := querier.DenomStrictMode(ctx, &types.QueryDenomStrictModeRequest{Denom: denom})
+	require.NoError(t, err)
+	require.True(t, strictRes.Strict)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = input.BankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, govtypes.ModuleName, amount)
+	require.ErrorIs(t, err, types.ErrDenomPaused)
+
+	var blocked *sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeModuleSendBlocked {
+			blocked = &event
+		}
+	}
+	require.NotNil(t, blocked)
+	amountAttr, ok := blocked.GetAttribute(types.AttributeAmount)
+	require.True(t, ok)
+	require.Equal(t, amount.String(), amountAttr.Value)
+
+	// the other denoms of the send are not checked
+	input.Faucet.Fund(ctx, input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), sdk.NewInt64Coin("uinit", 10))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, govtypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uinit", 10))))
+
+	_, err = msgServer.SetDenomPaused(ctx, types.NewMsgSetDenomPaused(admin, denom, false))
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, govtypes.ModuleName, amount))
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+// GetSupplyCap returns the max supply of the denom and false if the denom is
+// not capped.
+func (k Keeper) GetSupplyCap(ctx context.Context, denom string) (math.Int, bool, error) {
+	supplyCap, err := k.SupplyCaps.Get(ctx, denom)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), false, nil
+	} else if err != nil {
+		return math.ZeroInt(), false, err
+	}
+
+	return supplyCap, true, nil
+}
+
+// setSupplyCap sets the max supply of the denom. The supply cap cannot be
+// below the current supply and, once set, can only be lowered.
+func (k Keeper) setSupplyCap(ctx context.Context, denom string, supplyCap math.Int) error {
+	currentCap, capped, err := k.GetSupplyCap(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if capped && supplyCap.GT(currentCap) {
+		return types.ErrInvalidSupplyCap.Wrapf("supply cap can only be lowered; current %s, got %s", currentCap, supplyCap)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if supplyCap.LT(supply) {
+		return types.ErrInvalidSupplyCap.Wrapf("supply cap %s is below the current supply %s", supplyCap, supply)
+	}
+
+	return k.SupplyCaps.Set(ctx, denom, supplyCap)
+}
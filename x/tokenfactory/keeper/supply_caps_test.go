@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/keeper"
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+func TestSupplyCap(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	msgServer := keeper.NewMsgServerImpl(input.TokenFactoryKeeper)
+	querier := keeper.Querier{Keeper: input.TokenFactoryKeeper}
+
+	admin := addrs[0].String()
+
+	res, err := msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(admin, "bitcoin"))
+	require.NoError(t, err)
+	denom := res.GetNewTokenDenom()
+
+	capRes, err := querier.SupplyCap(ctx, &types.QuerySupplyCapRequest{Denom: denom})
+	require.NoError(t, err)
+	require.False(t, capRes.Capped)
+
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 100)))
+	require.NoError(t, err)
+
+	// only the admin can set the supply cap
+	_, err = msgServer.SetSupplyCap(ctx, types.NewMsgSetSupplyCap(addrs[1].String(), denom, math.NewInt(1000)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// the supply cap cannot be below the current supply
+	_, err = msgServer.SetSupplyCap(ctx, types.NewMsgSetSupplyCap(admin, denom, math.NewInt(99)))
+	require.ErrorIs(t, err, types.ErrInvalidSupplyCap)
+
+	_, err = msgServer.SetSupplyCap(ctx, types.NewMsgSetSupplyCap(admin, denom, math.NewInt(1000)))
+	require.NoError(t, err)
+
+	capRes, err = querier.SupplyCap(ctx, &types.QuerySupplyCapRequest{Denom: denom})
+	require.NoError(t, err)
+	require.True(t, capRes.Capped)
+	require.Equal(t, math.NewInt(1000), capRes.SupplyCap)
+
+	// the supply cap can only be lowered
+	_, err = msgServer.SetSupplyCap(ctx, types.NewMsgSetSupplyCap(admin, denom, math.NewInt(1001)))
+	require.ErrorIs(t, err, types.ErrInvalidSupplyCap)
+	_, err = msgServer.SetSupplyCap(ctx, types.NewMsgSetSupplyCap(admin, denom, math.NewInt(500)))
+	require.NoError(t, err)
+
+	// mints are bounded by the supply cap
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 401)))
+	require.ErrorIs(t, err, types.ErrSupplyCapExceeded)
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 400)))
+	require.NoError(t, err)
+	require.Equal(t, int64(500), input.BankKeeper.GetSupply(ctx, denom).Amount.Int64())
+
+	// burning frees room under the supply cap
+	_, err = msgServer.Burn(ctx, types.NewMsgBurn(admin, sdk.NewInt64Coin(denom, 50)))
+	require.NoError(t, err)
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 50)))
+	require.NoError(t, err)
+}
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"time"
+
 	"cosmossdk.io/core/address"
+
+	errorsmod "cosmossdk.io/errors"
 )
 
 func (metadata DenomAuthorityMetadata) Validate(ac address.Codec) error {
@@ -13,3 +17,18 @@ func (metadata DenomAuthorityMetadata) Validate(ac address.Codec) error {
 	}
 	return nil
 }
+
+func (transfer PendingAdminTransfer) Validate(ac address.Codec) error {
+	if addr, err := ac.StringToBytes(transfer.PendingAdmin); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return errorsmod.Wrap(ErrInvalidAdminTransfer, "empty pending admin")
+	}
+	return nil
+}
+
+// IsExpired returns true if the transfer has an expiry which is not after the
+// given block time.
+func (transfer PendingAdminTransfer) IsExpired(blockTime time.Time) bool {
+	return !transfer.ExpiresAt.IsZero() && !blockTime.Before(transfer.ExpiresAt)
+}
@@ -4,18 +4,25 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,12 +30,60 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DenomRole defines the roles the admin of a denom can grant to other
+// accounts. The admin implicitly holds every role.
+type DenomRole int32
+
+const (
+	// DENOM_ROLE_UNSPECIFIED is the default value, which is not a valid role.
+	DenomRoleUnspecified DenomRole = 0
+	// DENOM_ROLE_MINTER allows minting, optionally bounded by a mint allowance.
+	DenomRoleMinter DenomRole = 1
+	// DENOM_ROLE_BURNER allows burning from the balance of the burner.
+	DenomRoleBurner DenomRole = 2
+	// DENOM_ROLE_METADATA_MANAGER allows setting the bank metadata.
+	DenomRoleMetadataManager DenomRole = 3
+	// DENOM_ROLE_HOOK_MANAGER allows setting the before send hooks.
+	DenomRoleHookManager DenomRole = 4
+)
+
+var DenomRole_name = map[int32]string{
+	0: "DENOM_ROLE_UNSPECIFIED",
+	1: "DENOM_ROLE_MINTER",
+	2: "DENOM_ROLE_BURNER",
+	3: "DENOM_ROLE_METADATA_MANAGER",
+	4: "DENOM_ROLE_HOOK_MANAGER",
+}
+
+var DenomRole_value = map[string]int32{
+	"DENOM_ROLE_UNSPECIFIED":      0,
+	"DENOM_ROLE_MINTER":           1,
+	"DENOM_ROLE_BURNER":           2,
+	"DENOM_ROLE_METADATA_MANAGER": 3,
+	"DENOM_ROLE_HOOK_MANAGER":     4,
+}
+
+func (x DenomRole) String() string {
+	return proto.EnumName(DenomRole_name, int32(x))
+}
+
+func (DenomRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b94758c81266c5ba, []int{0}
+}
+
 // DenomAuthorityMetadata specifies metadata for addresses that have specific
-// capabilities over a token factory denom. Right now there is only one Admin
-// permission, but is planned to be extended to the future.
+// capabilities over a token factory denom, the Admin permission and the
+// admin capabilities the denom opted into at creation.
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// burn_from_enabled allows the admin to burn tokens from any account. It can
+	// only be enabled at denom creation and can only be turned off afterwards.
+	BurnFromEnabled bool `protobuf:"varint,2,opt,name=burn_from_enabled,json=burnFromEnabled,proto3" json:"burn_from_enabled,omitempty" yaml:"burn_from_enabled"`
+	// force_transfer_enabled allows the admin to transfer tokens between any
+	// accounts. It can only be enabled at denom creation and can only be turned
+	// off afterwards.
+	ForceTransferEnabled bool `protobuf:"varint,3,opt,name=force_transfer_enabled,json=forceTransferEnabled,proto3" json:"force_transfer_enabled,omitempty" yaml:"force_transfer_enabled"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -71,8 +126,248 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetBurnFromEnabled() bool {
+	if m != nil {
+		return m.BurnFromEnabled
+	}
+	return false
+}
+
+func (m *DenomAuthorityMetadata) GetForceTransferEnabled() bool {
+	if m != nil {
+		return m.ForceTransferEnabled
+	}
+	return false
+}
+
+// DenomRoleGrant is a role granted to an address for a denom.
+type DenomRoleGrant struct {
+	Role    DenomRole `protobuf:"varint,1,opt,name=role,proto3,enum=miniwasm.tokenfactory.v1.DenomRole" json:"role,omitempty" yaml:"role"`
+	Address string    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+}
+
+func (m *DenomRoleGrant) Reset()         { *m = DenomRoleGrant{} }
+func (m *DenomRoleGrant) String() string { return proto.CompactTextString(m) }
+func (*DenomRoleGrant) ProtoMessage()    {}
+func (*DenomRoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b94758c81266c5ba, []int{1}
+}
+func (m *DenomRoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRoleGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRoleGrant.Merge(m, src)
+}
+func (m *DenomRoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRoleGrant proto.InternalMessageInfo
+
+func (m *DenomRoleGrant) GetRole() DenomRole {
+	if m != nil {
+		return m.Role
+	}
+	return DenomRoleUnspecified
+}
+
+func (m *DenomRoleGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MintAllowance is the remaining amount a minter can mint. Minters without
+// a mint allowance can mint without limit.
+type MintAllowance struct {
+	Minter    string                `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty" yaml:"minter"`
+	Allowance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance" yaml:"allowance"`
+}
+
+func (m *MintAllowance) Reset()         { *m = MintAllowance{} }
+func (m *MintAllowance) String() string { return proto.CompactTextString(m) }
+func (*MintAllowance) ProtoMessage()    {}
+func (*MintAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b94758c81266c5ba, []int{2}
+}
+func (m *MintAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintAllowance.Merge(m, src)
+}
+func (m *MintAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintAllowance proto.InternalMessageInfo
+
+func (m *MintAllowance) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+// PendingAdminTransfer is an admin transfer proposed by the admin of a denom
+// that waits for the acceptance of the pending admin.
+type PendingAdminTransfer struct {
+	PendingAdmin string `protobuf:"bytes,1,opt,name=pending_admin,json=pendingAdmin,proto3" json:"pending_admin,omitempty" yaml:"pending_admin"`
+	// expires_at is the time after which the transfer cannot be accepted
+	// anymore. The zero time means no expiry.
+	ExpiresAt time.Time `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at" yaml:"expires_at"`
+}
+
+func (m *PendingAdminTransfer) Reset()         { *m = PendingAdminTransfer{} }
+func (m *PendingAdminTransfer) String() string { return proto.CompactTextString(m) }
+func (*PendingAdminTransfer) ProtoMessage()    {}
+func (*PendingAdminTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b94758c81266c5ba, []int{3}
+}
+func (m *PendingAdminTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAdminTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAdminTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingAdminTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAdminTransfer.Merge(m, src)
+}
+func (m *PendingAdminTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAdminTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAdminTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAdminTransfer proto.InternalMessageInfo
+
+func (m *PendingAdminTransfer) GetPendingAdmin() string {
+	if m != nil {
+		return m.PendingAdmin
+	}
+	return ""
+}
+
+func (m *PendingAdminTransfer) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+// BeforeSendHookFailures counts the failed before send hook calls of a denom,
+// so that the issuer can see when its hook contracts are broken. The count
+// saturates at types.MaxBeforeSendHookFailures.
+type BeforeSendHookFailures struct {
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty" yaml:"count"`
+	// last_height is the block height of the last failure.
+	LastHeight int64 `protobuf:"varint,2,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty" yaml:"last_height"`
+	// last_contract is the hook contract of the last failure.
+	LastContract string `protobuf:"bytes,3,opt,name=last_contract,json=lastContract,proto3" json:"last_contract,omitempty" yaml:"last_contract"`
+}
+
+func (m *BeforeSendHookFailures) Reset()         { *m = BeforeSendHookFailures{} }
+func (m *BeforeSendHookFailures) String() string { return proto.CompactTextString(m) }
+func (*BeforeSendHookFailures) ProtoMessage()    {}
+func (*BeforeSendHookFailures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b94758c81266c5ba, []int{4}
+}
+func (m *BeforeSendHookFailures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeforeSendHookFailures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeforeSendHookFailures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeforeSendHookFailures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeforeSendHookFailures.Merge(m, src)
+}
+func (m *BeforeSendHookFailures) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeforeSendHookFailures) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeforeSendHookFailures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeforeSendHookFailures proto.InternalMessageInfo
+
+func (m *BeforeSendHookFailures) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *BeforeSendHookFailures) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+func (m *BeforeSendHookFailures) GetLastContract() string {
+	if m != nil {
+		return m.LastContract
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("miniwasm.tokenfactory.v1.DenomRole", DenomRole_name, DenomRole_value)
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "miniwasm.tokenfactory.v1.DenomAuthorityMetadata")
+	proto.RegisterType((*DenomRoleGrant)(nil), "miniwasm.tokenfactory.v1.DenomRoleGrant")
+	proto.RegisterType((*MintAllowance)(nil), "miniwasm.tokenfactory.v1.MintAllowance")
+	proto.RegisterType((*PendingAdminTransfer)(nil), "miniwasm.tokenfactory.v1.PendingAdminTransfer")
+	proto.RegisterType((*BeforeSendHookFailures)(nil), "miniwasm.tokenfactory.v1.BeforeSendHookFailures")
 }
 
 func init() {
@@ -80,21 +375,62 @@ func init() {
 }
 
 var fileDescriptor_b94758c81266c5ba = []byte{
-	// 221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcc, 0xcd, 0xcc, 0xcb,
-	0x2c, 0x4f, 0x2c, 0xce, 0xd5, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f,
-	0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0x8c, 0xcf,
-	0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x80,
-	0x69, 0xd1, 0x43, 0xd6, 0xa2, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa4,
-	0x0f, 0x62, 0x41, 0xd4, 0x2b, 0xb9, 0x71, 0x89, 0xb9, 0xa4, 0xe6, 0xe5, 0xe7, 0x3a, 0xc2, 0x0c,
-	0xf4, 0x85, 0x9a, 0x27, 0xa4, 0xc6, 0xc5, 0x9a, 0x98, 0x92, 0x9b, 0x99, 0x27, 0xc1, 0xa8, 0xc0,
-	0xa8, 0xc1, 0xe9, 0x24, 0xf0, 0xe9, 0x9e, 0x3c, 0x4f, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x58,
-	0x58, 0x29, 0x08, 0x22, 0x6d, 0xc5, 0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x93, 0xdf, 0x89, 0x47, 0x72,
-	0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7,
-	0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25,
-	0xe7, 0xe7, 0xea, 0x67, 0xe6, 0x65, 0x96, 0x64, 0x26, 0xea, 0xe6, 0x24, 0x26, 0x15, 0xeb, 0xc3,
-	0xfd, 0x56, 0x81, 0xea, 0xbb, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0xf3, 0x8c, 0x01,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x14, 0x86, 0x7e, 0x0b, 0x03, 0x01, 0x00, 0x00,
+	// 873 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x41, 0x6f, 0xe3, 0x44,
+	0x18, 0x8d, 0xdb, 0xb0, 0xd0, 0xe9, 0xb6, 0x4d, 0x4d, 0x08, 0x21, 0x2c, 0x71, 0x30, 0x12, 0x5a,
+	0x2a, 0xad, 0x4d, 0x97, 0x45, 0x48, 0x2b, 0x71, 0xb0, 0x37, 0xee, 0x26, 0x82, 0xa4, 0x2b, 0x37,
+	0x15, 0x12, 0x12, 0xb2, 0x26, 0xf6, 0xc4, 0x19, 0xd5, 0x9e, 0x89, 0xc6, 0x93, 0xdd, 0xed, 0x3f,
+	0x40, 0x3d, 0xed, 0x85, 0x63, 0x25, 0x24, 0x24, 0xc4, 0x91, 0x03, 0x47, 0x7e, 0xc0, 0x1e, 0x57,
+	0x7b, 0x40, 0x88, 0x83, 0x41, 0xed, 0x01, 0xb8, 0xfa, 0x17, 0x20, 0x7b, 0xc6, 0x69, 0x5a, 0x28,
+	0x97, 0x28, 0xf3, 0xbe, 0xf7, 0xde, 0x7c, 0x6f, 0xe6, 0xf3, 0x80, 0xdd, 0x18, 0x13, 0xfc, 0x04,
+	0x26, 0xb1, 0xc9, 0xe9, 0x11, 0x22, 0x13, 0xe8, 0x73, 0xca, 0x8e, 0xcd, 0xc7, 0xbb, 0x26, 0x9c,
+	0xf3, 0x29, 0x65, 0x98, 0x1f, 0x7b, 0x31, 0xe2, 0x30, 0x80, 0x1c, 0x1a, 0x33, 0x46, 0x39, 0x55,
+	0x9b, 0xa5, 0xc4, 0x58, 0x96, 0x18, 0x8f, 0x77, 0x5b, 0xdb, 0x30, 0xc6, 0x84, 0x9a, 0xc5, 0xaf,
+	0x20, 0xb7, 0xde, 0xf2, 0x69, 0x12, 0xd3, 0xc4, 0x2b, 0x56, 0xa6, 0x58, 0xc8, 0x52, 0x3d, 0xa4,
+	0x21, 0x15, 0x78, 0xfe, 0x4f, 0xa2, 0x5a, 0x48, 0x69, 0x18, 0x21, 0xb3, 0x58, 0x8d, 0xe7, 0x13,
+	0x93, 0xe3, 0x18, 0x25, 0x1c, 0xc6, 0x33, 0x41, 0xd0, 0xff, 0x56, 0x40, 0xa3, 0x8b, 0x08, 0x8d,
+	0xad, 0xb2, 0xc1, 0x81, 0xec, 0x4f, 0x7d, 0x1f, 0xbc, 0x02, 0x83, 0x18, 0x93, 0xa6, 0xd2, 0x51,
+	0x6e, 0xaf, 0xd9, 0xb5, 0x2c, 0xd5, 0x6e, 0x1e, 0xc3, 0x38, 0xba, 0xaf, 0x17, 0xb0, 0xee, 0x8a,
+	0xb2, 0xda, 0x03, 0xdb, 0xe3, 0x39, 0x23, 0xde, 0x84, 0xd1, 0xd8, 0x43, 0x04, 0x8e, 0x23, 0x14,
+	0x34, 0x57, 0x3a, 0xca, 0xed, 0xd7, 0xec, 0x5b, 0x59, 0xaa, 0x35, 0x85, 0xe6, 0x5f, 0x14, 0xdd,
+	0xdd, 0xca, 0xb1, 0x3d, 0x46, 0x63, 0x47, 0x20, 0xea, 0x17, 0xa0, 0x31, 0xa1, 0xcc, 0x47, 0x1e,
+	0x67, 0x90, 0x24, 0x13, 0xc4, 0x16, 0x76, 0xab, 0x85, 0xdd, 0xbb, 0x59, 0xaa, 0xbd, 0x23, 0xec,
+	0xfe, 0x9b, 0xa7, 0xbb, 0xf5, 0xa2, 0x30, 0x92, 0xb8, 0x34, 0xbe, 0x5f, 0xfd, 0xeb, 0x5b, 0x4d,
+	0xd1, 0xbf, 0x57, 0xc0, 0x66, 0x91, 0xd5, 0xa5, 0x11, 0x7a, 0xc8, 0x20, 0xe1, 0x6a, 0x0f, 0x54,
+	0x19, 0x8d, 0x50, 0x11, 0x71, 0xf3, 0xee, 0x7b, 0xc6, 0x75, 0x97, 0x61, 0x2c, 0x74, 0xf6, 0x56,
+	0x96, 0x6a, 0xeb, 0xa2, 0x89, 0x5c, 0xaa, 0xbb, 0x85, 0x83, 0xda, 0x05, 0xaf, 0xc2, 0x20, 0x60,
+	0x28, 0x49, 0x8a, 0xec, 0x6b, 0xf6, 0x4e, 0x96, 0x6a, 0x9b, 0xe5, 0x79, 0x15, 0x05, 0xfd, 0xe5,
+	0x4f, 0x77, 0xea, 0xf2, 0xd2, 0x2c, 0x01, 0x1d, 0x70, 0x86, 0x49, 0xe8, 0x96, 0x52, 0xd9, 0xe8,
+	0xcf, 0x0a, 0xd8, 0x18, 0x60, 0xc2, 0xad, 0x28, 0xa2, 0x4f, 0x20, 0xf1, 0x91, 0x6a, 0x81, 0x1b,
+	0x31, 0x26, 0x1c, 0x31, 0x79, 0x19, 0x1f, 0x64, 0xa9, 0xb6, 0x21, 0xcc, 0x05, 0x7e, 0xbd, 0xb7,
+	0x14, 0xaa, 0x63, 0xb0, 0x06, 0x4b, 0x3f, 0xd9, 0x62, 0xf7, 0x79, 0xaa, 0x55, 0x7e, 0x4b, 0xb5,
+	0x37, 0x84, 0x30, 0x09, 0x8e, 0x0c, 0x4c, 0xcd, 0x18, 0xf2, 0xa9, 0xd1, 0x27, 0x3c, 0x4b, 0xb5,
+	0x9a, 0xec, 0xbf, 0xd4, 0xe5, 0xbb, 0x00, 0xb9, 0x4b, 0x9f, 0xf0, 0x1f, 0xfe, 0xfc, 0x71, 0x47,
+	0x71, 0x2f, 0x6c, 0x65, 0xfb, 0x2f, 0x15, 0x50, 0x7f, 0x84, 0x48, 0x80, 0x49, 0x68, 0xe5, 0x13,
+	0x52, 0xde, 0x86, 0x7a, 0x08, 0x36, 0x66, 0x02, 0xf7, 0x96, 0x27, 0xeb, 0xc3, 0x2c, 0xd5, 0xea,
+	0x62, 0xa7, 0x4b, 0xe5, 0xeb, 0x33, 0xdd, 0x9c, 0x2d, 0xd9, 0xab, 0x5f, 0x01, 0x80, 0x9e, 0xce,
+	0x30, 0x43, 0x89, 0x07, 0x79, 0x11, 0x6d, 0xfd, 0x6e, 0xcb, 0x10, 0x93, 0x6f, 0x94, 0x93, 0x6f,
+	0x8c, 0xca, 0xc9, 0xb7, 0xf5, 0x3c, 0x76, 0x96, 0x6a, 0xdb, 0x62, 0xcf, 0x0b, 0xad, 0xfe, 0xec,
+	0x77, 0x4d, 0x91, 0xa1, 0x24, 0x6a, 0x71, 0x19, 0xea, 0x17, 0x05, 0x34, 0x6c, 0x34, 0xa1, 0x0c,
+	0x1d, 0x20, 0x12, 0xf4, 0x28, 0x3d, 0xda, 0x83, 0x38, 0x9a, 0x33, 0x94, 0xe4, 0x1f, 0x8a, 0x4f,
+	0xe7, 0x84, 0x17, 0x71, 0xaa, 0xcb, 0x1f, 0x4a, 0x01, 0xeb, 0xae, 0x28, 0xab, 0x9f, 0x80, 0xf5,
+	0x08, 0x26, 0xdc, 0x9b, 0x22, 0x1c, 0x4e, 0x45, 0xa3, 0xab, 0x76, 0x23, 0x4b, 0x35, 0x55, 0xb0,
+	0x97, 0x8a, 0xba, 0x0b, 0xf2, 0x55, 0xaf, 0x58, 0xe4, 0xe7, 0x56, 0xd4, 0x7c, 0x4a, 0x38, 0x83,
+	0x3e, 0x6f, 0xae, 0x5e, 0x3d, 0xb7, 0x4b, 0xe5, 0xff, 0x39, 0xb7, 0x9c, 0xf7, 0x40, 0xd2, 0x44,
+	0xb0, 0x9d, 0x6f, 0x56, 0xc0, 0xda, 0x62, 0xba, 0xd5, 0x7b, 0xa0, 0xd1, 0x75, 0x86, 0xfb, 0x03,
+	0xcf, 0xdd, 0xff, 0xdc, 0xf1, 0x0e, 0x87, 0x07, 0x8f, 0x9c, 0x07, 0xfd, 0xbd, 0xbe, 0xd3, 0xad,
+	0x55, 0x5a, 0xcd, 0x93, 0xd3, 0x4e, 0x7d, 0x41, 0x3d, 0x24, 0xc9, 0x0c, 0xf9, 0x78, 0x82, 0x51,
+	0xa0, 0xee, 0x80, 0xed, 0x25, 0xd5, 0xa0, 0x3f, 0x1c, 0x39, 0x6e, 0x4d, 0x69, 0xbd, 0x7e, 0x72,
+	0xda, 0xd9, 0x5a, 0x08, 0x06, 0x62, 0x0e, 0x2f, 0x73, 0xed, 0x43, 0x77, 0xe8, 0xb8, 0xb5, 0x95,
+	0x2b, 0x5c, 0x7b, 0xce, 0x08, 0x62, 0xea, 0xa7, 0xe0, 0xed, 0x65, 0x5f, 0x67, 0x64, 0x75, 0xad,
+	0x91, 0xe5, 0x0d, 0xac, 0xa1, 0xf5, 0xd0, 0x71, 0x6b, 0xab, 0xad, 0x5b, 0x27, 0xa7, 0x9d, 0xe6,
+	0xc5, 0x0e, 0xf2, 0xe9, 0x1a, 0x40, 0x02, 0x43, 0xc4, 0xd4, 0x8f, 0xc1, 0x9b, 0x4b, 0xf2, 0xde,
+	0xfe, 0xfe, 0x67, 0x0b, 0x69, 0xf5, 0x4a, 0x9a, 0xfc, 0x42, 0xa5, 0xac, 0x55, 0xfd, 0xfa, 0xbb,
+	0x76, 0xc5, 0x1e, 0x3e, 0x3f, 0x6b, 0x2b, 0x2f, 0xce, 0xda, 0xca, 0x1f, 0x67, 0x6d, 0xe5, 0xd9,
+	0x79, 0xbb, 0xf2, 0xe2, 0xbc, 0x5d, 0xf9, 0xf5, 0xbc, 0x5d, 0xf9, 0xf2, 0x5e, 0x88, 0xf9, 0x74,
+	0x3e, 0x36, 0x7c, 0x1a, 0x9b, 0x98, 0x60, 0x8e, 0xe1, 0x9d, 0x08, 0x8e, 0x13, 0x73, 0xf1, 0xf8,
+	0x3f, 0xbd, 0xfc, 0xfc, 0xf3, 0xe3, 0x19, 0x4a, 0xc6, 0x37, 0x8a, 0x49, 0xfc, 0xe8, 0x9f, 0x01,
+	0x00, 0x73, 0x77, 0xfb, 0xbb, 0x24, 0x06, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -119,6 +455,123 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if this.BurnFromEnabled != that1.BurnFromEnabled {
+		return false
+	}
+	if this.ForceTransferEnabled != that1.ForceTransferEnabled {
+		return false
+	}
+	return true
+}
+func (this *DenomRoleGrant) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomRoleGrant)
+	if !ok {
+		that2, ok := that.(DenomRoleGrant)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	return true
+}
+func (this *MintAllowance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintAllowance)
+	if !ok {
+		that2, ok := that.(MintAllowance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Minter != that1.Minter {
+		return false
+	}
+	if !this.Allowance.Equal(that1.Allowance) {
+		return false
+	}
+	return true
+}
+func (this *PendingAdminTransfer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingAdminTransfer)
+	if !ok {
+		that2, ok := that.(PendingAdminTransfer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PendingAdmin != that1.PendingAdmin {
+		return false
+	}
+	if !this.ExpiresAt.Equal(that1.ExpiresAt) {
+		return false
+	}
+	return true
+}
+func (this *BeforeSendHookFailures) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BeforeSendHookFailures)
+	if !ok {
+		that2, ok := that.(BeforeSendHookFailures)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.LastHeight != that1.LastHeight {
+		return false
+	}
+	if this.LastContract != that1.LastContract {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -141,6 +594,26 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ForceTransferEnabled {
+		i--
+		if m.ForceTransferEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BurnFromEnabled {
+		i--
+		if m.BurnFromEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -151,13 +624,166 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuthorityMetadata(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuthorityMetadata(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DenomRoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAdminTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAdminTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAdminTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthorityMetadata(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.PendingAdmin) > 0 {
+		i -= len(m.PendingAdmin)
+		copy(dAtA[i:], m.PendingAdmin)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.PendingAdmin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeforeSendHookFailures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeforeSendHookFailures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeforeSendHookFailures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastContract) > 0 {
+		i -= len(m.LastContract)
+		copy(dAtA[i:], m.LastContract)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.LastContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LastHeight != 0 {
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthorityMetadata(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthorityMetadata(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -172,6 +798,77 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	if m.BurnFromEnabled {
+		n += 2
+	}
+	if m.ForceTransferEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *DenomRoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAuthorityMetadata(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *MintAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
+	return n
+}
+
+func (m *PendingAdminTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PendingAdmin)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
+	return n
+}
+
+func (m *BeforeSendHookFailures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovAuthorityMetadata(uint64(m.Count))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovAuthorityMetadata(uint64(m.LastHeight))
+	}
+	l = len(m.LastContract)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
 	return n
 }
 
@@ -242,6 +939,498 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFromEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnFromEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTransferEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceTransferEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomRoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= DenomRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAdminTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAdminTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAdminTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeforeSendHookFailures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeforeSendHookFailures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeforeSendHookFailures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
@@ -15,8 +15,23 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMint{}, "tokenfactory/MsgMint")
 	legacy.RegisterAminoMsg(cdc, &MsgBurn{}, "tokenfactory/MsgBurn")
 	legacy.RegisterAminoMsg(cdc, &MsgChangeAdmin{}, "tokenfactory/MsgChangeAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomPaused{}, "tokenfactory/MsgSetDenomPaused")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAccountFrozen{}, "tokenfactory/MsgSetAccountFrozen")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomStrictMode{}, "tokenfactory/MsgSetDenomStrictMode")
+	legacy.RegisterAminoMsg(cdc, &MsgProposeAdmin{}, "tokenfactory/MsgProposeAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptAdmin{}, "tokenfactory/MsgAcceptAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelAdminTransfer{}, "tokenfactory/MsgCancelAdminTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgRenounceAdmin{}, "tokenfactory/MsgRenounceAdmin")
 	legacy.RegisterAminoMsg(cdc, &MsgSetDenomMetadata{}, "tokenfactory/MsgSetDenomMetadata")
 	legacy.RegisterAminoMsg(cdc, &MsgSetBeforeSendHook{}, "tokenfactory/MsgSetBeforeSendHook")
+	legacy.RegisterAminoMsg(cdc, &MsgSetBeforeSendHooks{}, "tokenfactory/MsgSetBeforeSendHooks")
+	legacy.RegisterAminoMsg(cdc, &MsgBurnFrom{}, "tokenfactory/MsgBurnFrom")
+	legacy.RegisterAminoMsg(cdc, &MsgForceTransfer{}, "tokenfactory/MsgForceTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgDisableDenomCapabilities{}, "tokenfactory/MsgDisableCapabilities")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantRole{}, "tokenfactory/MsgGrantRole")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeRole{}, "tokenfactory/MsgRevokeRole")
+	legacy.RegisterAminoMsg(cdc, &MsgSetMintAllowance{}, "tokenfactory/MsgSetMintAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgSetSupplyCap{}, "tokenfactory/MsgSetSupplyCap")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "tokenfactory/MsgUpdateParams")
 }
 
@@ -27,8 +42,23 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgMint{},
 		&MsgBurn{},
 		&MsgChangeAdmin{},
+		&MsgSetDenomPaused{},
+		&MsgSetAccountFrozen{},
+		&MsgSetDenomStrictMode{},
+		&MsgProposeAdmin{},
+		&MsgAcceptAdmin{},
+		&MsgCancelAdminTransfer{},
+		&MsgRenounceAdmin{},
 		&MsgSetDenomMetadata{},
 		&MsgSetBeforeSendHook{},
+		&MsgSetBeforeSendHooks{},
+		&MsgBurnFrom{},
+		&MsgForceTransfer{},
+		&MsgDisableDenomCapabilities{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgSetMintAllowance{},
+		&MsgSetSupplyCap{},
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -2,4 +2,16 @@ package types
 
 var (
 	BeforeSendHookGasLimit = uint64(500_000)
+
+	// DefaultMaxBeforeSendHookGasLimit is the default upper bound of the
+	// per-denom before send hook gas limit.
+	DefaultMaxBeforeSendHookGasLimit = uint64(2_000_000)
+
+	// MaxBeforeSendHooks is the maximum number of before send hook contracts
+	// a denom can have.
+	MaxBeforeSendHooks = 8
+
+	// MaxBeforeSendHookFailures is the value at which the failure counter of
+	// the before send hooks of a denom saturates.
+	MaxBeforeSendHookFailures = uint64(1_000_000)
 )
@@ -26,4 +26,18 @@ var (
 	ErrDenomDoesNotExist        = errorsmod.Register(ModuleName, 15, "denom does not exist")
 	ErrBurnFromModuleAccount    = errorsmod.Register(ModuleName, 16, "burning from Module Account is not allowed")
 	ErrBeforeSendHookOutOfGas   = errorsmod.Register(ModuleName, 17, "gas meter hit maximum limit")
+	ErrInvalidHookGasLimit      = errorsmod.Register(ModuleName, 18, "invalid before send hook gas limit")
+	ErrInvalidBeforeSendHooks   = errorsmod.Register(ModuleName, 19, "invalid before send hooks")
+	ErrCapabilityDisabled       = errorsmod.Register(ModuleName, 20, "denom capability is disabled")
+	ErrInvalidRole              = errorsmod.Register(ModuleName, 21, "invalid denom role")
+	ErrMintAllowanceExceeded    = errorsmod.Register(ModuleName, 22, "mint allowance exceeded")
+	ErrInvalidSupplyCap         = errorsmod.Register(ModuleName, 23, "invalid supply cap")
+	ErrSupplyCapExceeded        = errorsmod.Register(ModuleName, 24, "supply cap exceeded")
+	ErrInvalidAdminTransfer     = errorsmod.Register(ModuleName, 25, "invalid admin transfer")
+	ErrNoPendingAdminTransfer   = errorsmod.Register(ModuleName, 26, "no pending admin transfer")
+	ErrAdminTransferExpired     = errorsmod.Register(ModuleName, 27, "admin transfer expired")
+	ErrDenomQuotaExceeded       = errorsmod.Register(ModuleName, 28, "denom creation quota exceeded")
+	ErrDenomPaused              = errorsmod.Register(ModuleName, 29, "denom transfers are paused")
+	ErrAccountFrozen            = errorsmod.Register(ModuleName, 30, "account is frozen")
+	ErrDenomRestricted          = errorsmod.Register(ModuleName, 31, "denom has active transfer restrictions")
 )
@@ -14,4 +14,37 @@ const (
 	AttributeNewAdmin              = "new_admin"
 	AttributeDenomMetadata         = "denom_metadata"
 	AttributeBeforeSendHookAddress = "before_send_hook_address"
+	AttributeBurnFromEnabled       = "burn_from_enabled"
+	AttributeForceTransferEnabled  = "force_transfer_enabled"
+	AttributeRole                  = "role"
+	AttributeAddress               = "address"
+	AttributeMinter                = "minter"
+	AttributeAllowance             = "allowance"
+	AttributeSupplyCap             = "supply_cap"
+	AttributePendingAdmin          = "pending_admin"
+	AttributeExpiresAt             = "expires_at"
+	AttributePaused                = "paused"
+	AttributeFrozen                = "frozen"
+	AttributeStrictMode            = "strict_mode"
+	AttributeSender                = "sender"
+	AttributeRecipient             = "recipient"
+	AttributeError                 = "error"
+	AttributeContract              = "contract"
+	AttributeMode                  = "mode"
+
+	// AttributeValueModeTrack and AttributeValueModeBlock are the values of
+	// the mode attribute of a before_send_hook_failed event.
+	AttributeValueModeTrack = "track"
+	AttributeValueModeBlock = "block"
+)
+
+// event types which are not emitted by a msg handler
+const (
+	// EventTypeModuleSendBlocked is emitted when a module to module send of a
+	// strict mode denom is rejected.
+	EventTypeModuleSendBlocked = "module_send_blocked"
+	// EventTypeBeforeSendHookFailed is emitted when a before send hook
+	// contract call fails, including the track calls of which the error is
+	// ignored.
+	EventTypeBeforeSendHookFailed = "before_send_hook_failed"
 )
@@ -45,6 +45,86 @@ func (gs GenesisState) Validate(ac address.Codec) error {
 				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if err := ValidateBeforeSendHooks(ac, denom.BeforeSendHooks); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: %s", denom.GetDenom(), err)
+		}
+
+		minters := map[string]bool{}
+		seenRoles := map[DenomRoleGrant]bool{}
+		for _, grant := range denom.Roles {
+			if err := grant.Validate(ac); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: %s", denom.GetDenom(), err)
+			}
+
+			if seenRoles[grant] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: duplicate role %s of %s", denom.GetDenom(), grant.Role, grant.Address)
+			}
+			seenRoles[grant] = true
+
+			if grant.Role == DenomRoleMinter {
+				minters[grant.Address] = true
+			}
+		}
+
+		seenAllowances := map[string]bool{}
+		for _, allowance := range denom.MintAllowances {
+			if err := allowance.Validate(ac); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: %s", denom.GetDenom(), err)
+			}
+
+			if !minters[allowance.Minter] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: mint allowance of %s which is not a minter", denom.GetDenom(), allowance.Minter)
+			}
+
+			if seenAllowances[allowance.Minter] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: duplicate mint allowance of %s", denom.GetDenom(), allowance.Minter)
+			}
+			seenAllowances[allowance.Minter] = true
+		}
+
+		if denom.SupplyCap != nil && (denom.SupplyCap.IsNil() || denom.SupplyCap.IsNegative()) {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: invalid supply cap", denom.GetDenom())
+		}
+
+		seenFrozen := make(map[string]bool, len(denom.FrozenAccounts))
+		for _, frozenAccount := range denom.FrozenAccounts {
+			if _, err := ac.StringToBytes(frozenAccount); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: invalid frozen account %s: %s", denom.GetDenom(), frozenAccount, err)
+			}
+
+			if seenFrozen[frozenAccount] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: duplicate frozen account %s", denom.GetDenom(), frozenAccount)
+			}
+			seenFrozen[frozenAccount] = true
+		}
+
+		if failures := denom.BeforeSendHookFailures; failures != nil {
+			if failures.Count > MaxBeforeSendHookFailures {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: before send hook failure count %d exceeds %d", denom.GetDenom(), failures.Count, MaxBeforeSendHookFailures)
+			}
+
+			if _, err := ac.StringToBytes(failures.LastContract); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: invalid before send hook failure contract %s: %s", denom.GetDenom(), failures.LastContract, err)
+			}
+		}
+
+		if denom.PendingAdminTransfer != nil {
+			if denom.AuthorityMetadata.Admin == "" {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: pending admin transfer without admin", denom.GetDenom())
+			}
+
+			if err := denom.PendingAdminTransfer.Validate(ac); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: invalid pending admin transfer: %s", denom.GetDenom(), err)
+			}
+		}
+
+		// the gas limit is not checked against the max gas limit param, which
+		// can be lowered after the gas limit is set; it is bounded when the hook
+		// is called instead
+		if denom.BeforeSendHookGasLimit != 0 && len(denom.BeforeSendHooks) == 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: gas limit cannot be set without a hook contract", denom.GetDenom())
+		}
 	}
 
 	return nil
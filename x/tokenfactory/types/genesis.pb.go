@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -83,6 +85,29 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	// before_send_hooks are the ordered before send hook contracts of the denom.
+	BeforeSendHooks []string `protobuf:"bytes,3,rep,name=before_send_hooks,json=beforeSendHooks,proto3" json:"before_send_hooks,omitempty" yaml:"before_send_hooks"`
+	// before_send_hook_gas_limit is the per-denom gas limit of a hook call,
+	// zero uses the default gas limit.
+	BeforeSendHookGasLimit uint64 `protobuf:"varint,4,opt,name=before_send_hook_gas_limit,json=beforeSendHookGasLimit,proto3" json:"before_send_hook_gas_limit,omitempty" yaml:"before_send_hook_gas_limit"`
+	// roles are the roles granted for the denom.
+	Roles []DenomRoleGrant `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles" yaml:"roles"`
+	// mint_allowances are the mint allowances of the limited minters.
+	MintAllowances []MintAllowance `protobuf:"bytes,6,rep,name=mint_allowances,json=mintAllowances,proto3" json:"mint_allowances" yaml:"mint_allowances"`
+	// supply_cap is the max supply of the denom, unset when uncapped.
+	SupplyCap *cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=supply_cap,json=supplyCap,proto3,customtype=cosmossdk.io/math.Int" json:"supply_cap,omitempty" yaml:"supply_cap"`
+	// pending_admin_transfer is the pending admin transfer of the denom.
+	PendingAdminTransfer *PendingAdminTransfer `protobuf:"bytes,8,opt,name=pending_admin_transfer,json=pendingAdminTransfer,proto3" json:"pending_admin_transfer,omitempty" yaml:"pending_admin_transfer"`
+	// paused is true when the transfers of the denom are paused.
+	Paused bool `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	// frozen_accounts are the accounts which cannot send or receive the denom.
+	FrozenAccounts []string `protobuf:"bytes,10,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts,omitempty" yaml:"frozen_accounts"`
+	// strict_mode is true when the module to module sends of the denom go
+	// through the blocking checks.
+	StrictMode bool `protobuf:"varint,11,opt,name=strict_mode,json=strictMode,proto3" json:"strict_mode,omitempty" yaml:"strict_mode"`
+	// before_send_hook_failures is the failure counter of the before send
+	// hooks, unset when no hook call failed.
+	BeforeSendHookFailures *BeforeSendHookFailures `protobuf:"bytes,12,opt,name=before_send_hook_failures,json=beforeSendHookFailures,proto3" json:"before_send_hook_failures,omitempty" yaml:"before_send_hook_failures"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +157,69 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetBeforeSendHooks() []string {
+	if m != nil {
+		return m.BeforeSendHooks
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetBeforeSendHookGasLimit() uint64 {
+	if m != nil {
+		return m.BeforeSendHookGasLimit
+	}
+	return 0
+}
+
+func (m *GenesisDenom) GetRoles() []DenomRoleGrant {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetMintAllowances() []MintAllowance {
+	if m != nil {
+		return m.MintAllowances
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetPendingAdminTransfer() *PendingAdminTransfer {
+	if m != nil {
+		return m.PendingAdminTransfer
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisDenom) GetFrozenAccounts() []string {
+	if m != nil {
+		return m.FrozenAccounts
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetStrictMode() bool {
+	if m != nil {
+		return m.StrictMode
+	}
+	return false
+}
+
+func (m *GenesisDenom) GetBeforeSendHookFailures() *BeforeSendHookFailures {
+	if m != nil {
+		return m.BeforeSendHookFailures
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "miniwasm.tokenfactory.v1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "miniwasm.tokenfactory.v1.GenesisDenom")
@@ -142,30 +230,55 @@ func init() {
 }
 
 var fileDescriptor_529283f7a70aeb23 = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0xcd, 0xcc, 0xcb,
-	0x2c, 0x4f, 0x2c, 0xce, 0xd5, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f,
-	0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x80, 0xa9, 0xd3, 0x43, 0x56, 0xa7, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e,
-	0x9f, 0x9e, 0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x4b, 0x19, 0xe2, 0x34, 0x37, 0xb1, 0xb4,
-	0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x32, 0x3e, 0x37, 0xb5, 0x24, 0x31, 0x25, 0xb1, 0x24, 0x11,
-	0xaa, 0x45, 0x15, 0xa7, 0x96, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x4b, 0x94, 0xf6, 0x30, 0x72,
-	0xf1, 0xb8, 0x43, 0xdc, 0x16, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc7, 0xc5, 0x06, 0x51, 0x20,
-	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa0, 0x87, 0xcb, 0xad, 0x7a, 0x01, 0x60, 0x75, 0x4e,
-	0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x09, 0xe5, 0x70, 0xf1, 0x41, 0x95, 0xc4, 0xa7,
-	0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0xa9, 0xe1, 0x36, 0x07,
-	0x6a, 0xbf, 0x0b, 0x48, 0xb9, 0x93, 0x2c, 0xc8, 0xb4, 0x4f, 0xf7, 0xe4, 0x45, 0x2b, 0x13, 0x73,
-	0x73, 0xac, 0x94, 0x50, 0xcd, 0x52, 0x0a, 0xe2, 0x85, 0x0a, 0xb8, 0x40, 0xf8, 0x07, 0x11, 0xce,
-	0x07, 0x8b, 0x08, 0xa9, 0x71, 0xb1, 0x82, 0x95, 0x82, 0x5d, 0xcf, 0xe9, 0x24, 0xf0, 0xe9, 0x9e,
-	0x3c, 0x0f, 0xc4, 0x24, 0xb0, 0xb0, 0x52, 0x10, 0x44, 0x5a, 0xa8, 0x89, 0x91, 0x4b, 0x08, 0x33,
-	0xec, 0x24, 0x98, 0xc0, 0x7e, 0x36, 0xc0, 0xed, 0x56, 0xb0, 0x2d, 0x8e, 0x30, 0x8d, 0xbe, 0x50,
-	0x7d, 0x4e, 0x8a, 0x50, 0x57, 0x4b, 0x42, 0xec, 0xc2, 0x34, 0x59, 0x29, 0x48, 0x30, 0x11, 0x5d,
-	0x97, 0x15, 0xcb, 0x8b, 0x05, 0xf2, 0x8c, 0x4e, 0x7e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0x65, 0x92, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f,
-	0x99, 0x97, 0x59, 0x92, 0x99, 0xa8, 0x9b, 0x93, 0x98, 0x54, 0xac, 0x0f, 0x8f, 0xda, 0x0a, 0xd4,
-	0xc8, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xc7, 0xac, 0x31, 0x20, 0x00, 0x00, 0xff,
-	0xff, 0xfc, 0xc2, 0x79, 0x63, 0x8d, 0x02, 0x00, 0x00,
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x41, 0x4f, 0xf3, 0x36,
+	0x18, 0xc7, 0x9b, 0x97, 0xc2, 0xa8, 0xcb, 0x0b, 0xab, 0xc5, 0x5b, 0x85, 0x6a, 0x34, 0x25, 0x1a,
+	0xac, 0x3b, 0x90, 0x0e, 0x36, 0x69, 0x12, 0x87, 0x69, 0x0d, 0x68, 0x80, 0x34, 0xa6, 0x29, 0x70,
+	0xda, 0x25, 0x72, 0x13, 0xb7, 0xb5, 0x48, 0xec, 0x28, 0x76, 0x61, 0xdd, 0x71, 0x97, 0x5d, 0xb7,
+	0x6f, 0xb0, 0x0f, 0xb1, 0xe3, 0x3e, 0x00, 0x47, 0xb4, 0xd3, 0xb4, 0x43, 0x34, 0xc1, 0x65, 0xe7,
+	0x7c, 0x82, 0xa9, 0xb6, 0x4b, 0x69, 0x4b, 0x5f, 0x6e, 0xf5, 0xdf, 0xff, 0xff, 0xef, 0x79, 0xea,
+	0x3c, 0x36, 0xd8, 0x8b, 0x09, 0x25, 0xb7, 0x88, 0xc7, 0x2d, 0xc1, 0xae, 0x31, 0xed, 0xa2, 0x40,
+	0xb0, 0x74, 0xd8, 0xba, 0x39, 0x68, 0xf5, 0x30, 0xc5, 0x9c, 0x70, 0x27, 0x49, 0x99, 0x60, 0xd0,
+	0x1c, 0xfb, 0x9c, 0xe7, 0x3e, 0xe7, 0xe6, 0xa0, 0xb6, 0x15, 0x30, 0x1e, 0x33, 0xee, 0x4b, 0x5f,
+	0x4b, 0x2d, 0x54, 0xa8, 0xb6, 0xd9, 0x63, 0x3d, 0xa6, 0xf4, 0xd1, 0x2f, 0xad, 0x1e, 0x2c, 0x2c,
+	0x89, 0x06, 0xa2, 0xcf, 0x52, 0x22, 0x86, 0x7e, 0x8c, 0x05, 0x0a, 0x91, 0x40, 0x3a, 0xb2, 0xbb,
+	0x30, 0x92, 0xa0, 0x14, 0xc5, 0xba, 0x9e, 0xfd, 0xa7, 0x01, 0xd6, 0x4e, 0x55, 0xdb, 0x97, 0x02,
+	0x09, 0x0c, 0xbf, 0x02, 0x2b, 0xca, 0x60, 0x1a, 0x0d, 0xa3, 0x59, 0x3e, 0x6c, 0x38, 0x8b, 0xfe,
+	0x86, 0xf3, 0xbd, 0xf4, 0xb9, 0xc5, 0xbb, 0xcc, 0x2a, 0x78, 0x3a, 0x05, 0x23, 0xb0, 0xae, 0x2d,
+	0x7e, 0x88, 0x29, 0x8b, 0xb9, 0xf9, 0xa6, 0xb1, 0xd4, 0x2c, 0x1f, 0xee, 0x2d, 0xe6, 0xe8, 0xfa,
+	0x27, 0x23, 0xbb, 0xbb, 0x3d, 0xa2, 0xe5, 0x99, 0xf5, 0x6e, 0x88, 0xe2, 0xe8, 0xc8, 0x9e, 0x66,
+	0xd9, 0xde, 0x5b, 0x2d, 0x9c, 0xa8, 0x75, 0xb6, 0xfa, 0xd4, 0xbe, 0x54, 0xe0, 0x1e, 0x58, 0x96,
+	0x56, 0xd9, 0x7d, 0xc9, 0xfd, 0x30, 0xcf, 0xac, 0x35, 0x45, 0x92, 0xb2, 0xed, 0xa9, 0x6d, 0xf8,
+	0xb3, 0x01, 0xe0, 0xfc, 0xd9, 0x99, 0x6f, 0xe4, 0x7f, 0xfe, 0x6c, 0x71, 0xaf, 0xb2, 0x4a, 0x7b,
+	0x1c, 0xbc, 0xd0, 0x39, 0x77, 0x47, 0x77, 0xbd, 0xa5, 0x6a, 0xcd, 0x93, 0x6d, 0xaf, 0x82, 0x66,
+	0x53, 0xf0, 0x0c, 0x54, 0x3a, 0xb8, 0xcb, 0x52, 0xec, 0x73, 0x4c, 0x43, 0xbf, 0xcf, 0xd8, 0x35,
+	0x37, 0x97, 0x1a, 0x4b, 0xcd, 0x92, 0xfb, 0x51, 0x9e, 0x59, 0xa6, 0x82, 0xcd, 0x59, 0x6c, 0x6f,
+	0x43, 0x69, 0x97, 0x98, 0x86, 0x67, 0x23, 0x05, 0x22, 0x50, 0x9b, 0xb5, 0xf9, 0x3d, 0xc4, 0xfd,
+	0x88, 0xc4, 0x44, 0x98, 0xc5, 0x86, 0xd1, 0x2c, 0xba, 0xbb, 0x79, 0x66, 0xed, 0xbc, 0x8c, 0x9c,
+	0x78, 0x6d, 0xaf, 0x3a, 0xcd, 0x3e, 0x45, 0xfc, 0xdb, 0xd1, 0x06, 0xbc, 0x02, 0xcb, 0x29, 0x8b,
+	0x30, 0x37, 0x97, 0xe5, 0xf7, 0x6c, 0xbe, 0x72, 0x46, 0x1e, 0x8b, 0xf0, 0x69, 0x8a, 0xa8, 0x70,
+	0x37, 0xf5, 0xd9, 0xe8, 0xef, 0x20, 0x21, 0xb6, 0xa7, 0x60, 0x30, 0x01, 0x1b, 0x31, 0xa1, 0xc2,
+	0x47, 0x51, 0xc4, 0x6e, 0x11, 0x0d, 0x30, 0x37, 0x57, 0x24, 0xff, 0x93, 0xc5, 0xfc, 0x0b, 0x42,
+	0x45, 0x7b, 0xec, 0x77, 0xeb, 0x1a, 0x5f, 0x55, 0xf8, 0x19, 0x9a, 0xed, 0xad, 0xc7, 0xcf, 0xed,
+	0x1c, 0xfa, 0x00, 0xf0, 0x41, 0x92, 0x44, 0x43, 0x3f, 0x40, 0x89, 0xf9, 0x81, 0x1c, 0x93, 0xaf,
+	0xef, 0x32, 0xcb, 0xf8, 0x27, 0xb3, 0xde, 0xa9, 0xbb, 0xc8, 0xc3, 0x6b, 0x87, 0xb0, 0x56, 0x8c,
+	0x44, 0xdf, 0x39, 0xa7, 0x22, 0xcf, 0xac, 0x8a, 0x82, 0x4f, 0x82, 0xf6, 0x5f, 0x7f, 0xec, 0x03,
+	0x7d, 0x73, 0xcf, 0xa9, 0xf0, 0x4a, 0x6a, 0xeb, 0x18, 0x25, 0xf0, 0x17, 0x03, 0x54, 0x13, 0x4c,
+	0x43, 0x42, 0x7b, 0x3e, 0x0a, 0x63, 0x42, 0x7d, 0x91, 0x22, 0xca, 0xbb, 0x38, 0x35, 0x57, 0xe5,
+	0x78, 0x39, 0xef, 0xb9, 0x52, 0x2a, 0xd7, 0x1e, 0xc5, 0xae, 0x74, 0xca, 0xdd, 0xc9, 0x33, 0x6b,
+	0x5b, 0x35, 0xf0, 0x32, 0xd7, 0xf6, 0x36, 0x93, 0x17, 0x82, 0xf0, 0xd3, 0xd1, 0x5d, 0x1e, 0x70,
+	0x1c, 0x9a, 0xa5, 0x86, 0xd1, 0x5c, 0x75, 0x2b, 0x79, 0x66, 0xbd, 0xd5, 0x20, 0xa9, 0xdb, 0x9e,
+	0x36, 0xc0, 0x63, 0xb0, 0xd1, 0x4d, 0xd9, 0x4f, 0x98, 0xfa, 0x28, 0x08, 0xd8, 0x80, 0x0a, 0x6e,
+	0x02, 0x39, 0x88, 0xb5, 0xc9, 0xd1, 0xce, 0x18, 0x6c, 0x6f, 0x5d, 0x29, 0x6d, 0x2d, 0xc0, 0x2f,
+	0x41, 0x99, 0x8b, 0x94, 0x04, 0xc2, 0x8f, 0x59, 0x88, 0xcd, 0xb2, 0x2c, 0x5a, 0xcd, 0x33, 0x0b,
+	0xea, 0xe3, 0x9b, 0x6c, 0xda, 0x1e, 0x50, 0xab, 0x0b, 0x16, 0x62, 0xf8, 0x9b, 0x01, 0xb6, 0xe6,
+	0x66, 0xb2, 0x8b, 0x48, 0x34, 0x48, 0x31, 0x37, 0xd7, 0x5e, 0xbb, 0x94, 0xee, 0xd4, 0xc4, 0x7e,
+	0xa3, 0x73, 0xee, 0xc7, 0x79, 0x66, 0x35, 0x16, 0x0c, 0xfc, 0x18, 0x3e, 0x37, 0xef, 0xe3, 0xf4,
+	0x51, 0xf1, 0xbf, 0xdf, 0x2d, 0xc3, 0xfd, 0xee, 0xee, 0xa1, 0x6e, 0xdc, 0x3f, 0xd4, 0x8d, 0x7f,
+	0x1f, 0xea, 0xc6, 0xaf, 0x8f, 0xf5, 0xc2, 0xfd, 0x63, 0xbd, 0xf0, 0xf7, 0x63, 0xbd, 0xf0, 0xc3,
+	0x17, 0x3d, 0x22, 0xfa, 0x83, 0x8e, 0x13, 0xb0, 0xb8, 0x45, 0x28, 0x11, 0x04, 0xed, 0x47, 0xa8,
+	0xc3, 0x5b, 0x4f, 0xef, 0xee, 0x8f, 0xd3, 0x2f, 0xaf, 0x18, 0x26, 0x98, 0x77, 0x56, 0xe4, 0xb3,
+	0xfb, 0xf9, 0xff, 0x03, 0x00, 0x30, 0x27, 0x7e, 0x82, 0x45, 0x06, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -193,6 +306,60 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if len(this.BeforeSendHooks) != len(that1.BeforeSendHooks) {
+		return false
+	}
+	for i := range this.BeforeSendHooks {
+		if this.BeforeSendHooks[i] != that1.BeforeSendHooks[i] {
+			return false
+		}
+	}
+	if this.BeforeSendHookGasLimit != that1.BeforeSendHookGasLimit {
+		return false
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if !this.Roles[i].Equal(&that1.Roles[i]) {
+			return false
+		}
+	}
+	if len(this.MintAllowances) != len(that1.MintAllowances) {
+		return false
+	}
+	for i := range this.MintAllowances {
+		if !this.MintAllowances[i].Equal(&that1.MintAllowances[i]) {
+			return false
+		}
+	}
+	if that1.SupplyCap == nil {
+		if this.SupplyCap != nil {
+			return false
+		}
+	} else if !this.SupplyCap.Equal(*that1.SupplyCap) {
+		return false
+	}
+	if !this.PendingAdminTransfer.Equal(that1.PendingAdminTransfer) {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if len(this.FrozenAccounts) != len(that1.FrozenAccounts) {
+		return false
+	}
+	for i := range this.FrozenAccounts {
+		if this.FrozenAccounts[i] != that1.FrozenAccounts[i] {
+			return false
+		}
+	}
+	if this.StrictMode != that1.StrictMode {
+		return false
+	}
+	if !this.BeforeSendHookFailures.Equal(that1.BeforeSendHookFailures) {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -262,6 +429,113 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BeforeSendHookFailures != nil {
+		{
+			size, err := m.BeforeSendHookFailures.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.StrictMode {
+		i--
+		if m.StrictMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAccounts[iNdEx])
+			copy(dAtA[i:], m.FrozenAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PendingAdminTransfer != nil {
+		{
+			size, err := m.PendingAdminTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SupplyCap != nil {
+		{
+			size := m.SupplyCap.Size()
+			i -= size
+			if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MintAllowances) > 0 {
+		for iNdEx := len(m.MintAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BeforeSendHookGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BeforeSendHookGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BeforeSendHooks) > 0 {
+		for iNdEx := len(m.BeforeSendHooks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BeforeSendHooks[iNdEx])
+			copy(dAtA[i:], m.BeforeSendHooks[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BeforeSendHooks[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -322,6 +596,51 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BeforeSendHooks) > 0 {
+		for _, s := range m.BeforeSendHooks {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BeforeSendHookGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.BeforeSendHookGasLimit))
+	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintAllowances) > 0 {
+		for _, e := range m.MintAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SupplyCap != nil {
+		l = m.SupplyCap.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PendingAdminTransfer != nil {
+		l = m.PendingAdminTransfer.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, s := range m.FrozenAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StrictMode {
+		n += 2
+	}
+	if m.BeforeSendHookFailures != nil {
+		l = m.BeforeSendHookFailures.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -542,6 +861,305 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHooks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHooks = append(m.BeforeSendHooks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookGasLimit", wireType)
+			}
+			m.BeforeSendHookGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeforeSendHookGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, DenomRoleGrant{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAllowances = append(m.MintAllowances, MintAllowance{})
+			if err := m.MintAllowances[len(m.MintAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.SupplyCap = &v
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdminTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingAdminTransfer == nil {
+				m.PendingAdminTransfer = &PendingAdminTransfer{}
+			}
+			if err := m.PendingAdminTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictMode = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BeforeSendHookFailures == nil {
+				m.BeforeSendHookFailures = &BeforeSendHookFailures{}
+			}
+			if err := m.BeforeSendHookFailures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
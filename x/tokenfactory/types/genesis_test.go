@@ -7,6 +7,8 @@ import (
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 
@@ -21,6 +23,7 @@ func TestGenesisState_Validate(t *testing.T) {
 
 	bitcoin := fmt.Sprintf("factory/%s/bitcoin", creator)
 	litecoin := fmt.Sprintf("factory/%s/litecoin", creator)
+	negativeSupplyCap := sdkmath.NewInt(-1)
 
 	for _, tc := range []struct {
 		desc     string
@@ -139,6 +142,283 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "fee recipient address destination",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					DenomCreationFeeDestination: types.FeeDestinationAddress,
+					DenomCreationFeeRecipient:   creator,
+					MaxDenomsPerCreator:         10,
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "address destination without fee recipient",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					DenomCreationFeeDestination: types.FeeDestinationAddress,
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "fee recipient with burn destination",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					DenomCreationFeeDestination: types.FeeDestinationBurn,
+					DenomCreationFeeRecipient:   creator,
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid before send hooks",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: bitcoin,
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: creator,
+						},
+						BeforeSendHooks:        []string{creator},
+						BeforeSendHookGasLimit: types.DefaultMaxBeforeSendHookGasLimit,
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate before send hooks",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:           bitcoin,
+						BeforeSendHooks: []string{creator, creator},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid before send hook address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:           bitcoin,
+						BeforeSendHooks: []string{"invalid"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "before send hook gas limit without hooks",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:                  bitcoin,
+						BeforeSendHookGasLimit: 1_000_000,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "before send hook gas limit above the max",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:                  bitcoin,
+						BeforeSendHooks:        []string{creator},
+						BeforeSendHookGasLimit: types.DefaultMaxBeforeSendHookGasLimit + 1,
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "valid roles",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: bitcoin,
+						Roles: []types.DenomRoleGrant{
+							{Role: types.DenomRoleMinter, Address: creator},
+							{Role: types.DenomRoleBurner, Address: creator},
+						},
+						MintAllowances: []types.MintAllowance{
+							{Minter: creator, Allowance: sdkmath.NewInt(100)},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate role",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: bitcoin,
+						Roles: []types.DenomRoleGrant{
+							{Role: types.DenomRoleMinter, Address: creator},
+							{Role: types.DenomRoleMinter, Address: creator},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unspecified role",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: bitcoin,
+						Roles: []types.DenomRoleGrant{
+							{Role: types.DenomRoleUnspecified, Address: creator},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "mint allowance of a non minter",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: bitcoin,
+						Roles: []types.DenomRoleGrant{
+							{Role: types.DenomRoleBurner, Address: creator},
+						},
+						MintAllowances: []types.MintAllowance{
+							{Minter: creator, Allowance: sdkmath.NewInt(100)},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending admin transfer",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: bitcoin,
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: creator,
+						},
+						PendingAdminTransfer: &types.PendingAdminTransfer{PendingAdmin: creator},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "pending admin transfer without admin",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:                bitcoin,
+						PendingAdminTransfer: &types.PendingAdminTransfer{PendingAdmin: creator},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending admin transfer with invalid pending admin",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: bitcoin,
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: creator,
+						},
+						PendingAdminTransfer: &types.PendingAdminTransfer{PendingAdmin: "invalid"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "paused with frozen accounts",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:          bitcoin,
+						Paused:         true,
+						FrozenAccounts: []string{creator},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid frozen account",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:          bitcoin,
+						FrozenAccounts: []string{"invalid"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate frozen account",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:          bitcoin,
+						FrozenAccounts: []string{creator, creator},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "before send hook failures",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:                  bitcoin,
+						BeforeSendHookFailures: &types.BeforeSendHookFailures{Count: 1, LastContract: creator},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "before send hook failure count exceeding the max",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:                  bitcoin,
+						BeforeSendHookFailures: &types.BeforeSendHookFailures{Count: types.MaxBeforeSendHookFailures + 1, LastContract: creator},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "negative supply cap",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:     bitcoin,
+						SupplyCap: &negativeSupplyCap,
+					},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate(ac)
@@ -22,4 +22,17 @@ var (
 	DenomAuthorityPrefix = []byte{0x12}
 	DenomHookAddrPrefix  = []byte{0x13}
 	ParamsKeyPrefix      = []byte{0x14}
+	DenomHookGasPrefix   = []byte{0x15}
+	DenomHooksPrefix     = []byte{0x16}
+	DenomRolesPrefix     = []byte{0x17}
+	MintAllowancesPrefix = []byte{0x18}
+	SupplyCapsPrefix     = []byte{0x19}
+
+	PendingAdminTransfersPrefix = []byte{0x1a}
+	AdminDenomsPrefix           = []byte{0x1b}
+	HookContractDenomsPrefix    = []byte{0x1c}
+	PausedDenomsPrefix          = []byte{0x1d}
+	FrozenAccountsPrefix        = []byte{0x1e}
+	StrictDenomsPrefix          = []byte{0x1f}
+	HookFailuresPrefix          = []byte{0x20}
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"cosmossdk.io/core/address"
 
 	sdkmath "cosmossdk.io/math"
@@ -14,22 +16,51 @@ import (
 
 // constants
 const (
-	TypeMsgCreateDenom       = "create_denom"
-	TypeMsgMint              = "tf_mint"
-	TypeMsgBurn              = "tf_burn"
-	TypeMsgForceTransfer     = "force_transfer"
-	TypeMsgChangeAdmin       = "change_admin"
-	TypeMsgSetDenomMetadata  = "set_denom_metadata"
-	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+	TypeMsgCreateDenom              = "create_denom"
+	TypeMsgMint                     = "tf_mint"
+	TypeMsgBurn                     = "tf_burn"
+	TypeMsgBurnFrom                 = "tf_burn_from"
+	TypeMsgForceTransfer            = "force_transfer"
+	TypeMsgChangeAdmin              = "change_admin"
+	TypeMsgSetDenomPaused           = "set_denom_paused"
+	TypeMsgSetAccountFrozen         = "set_account_frozen"
+	TypeMsgSetDenomStrictMode       = "set_denom_strict_mode"
+	TypeMsgProposeAdmin             = "propose_admin"
+	TypeMsgAcceptAdmin              = "accept_admin"
+	TypeMsgCancelAdminTransfer      = "cancel_admin_transfer"
+	TypeMsgRenounceAdmin            = "renounce_admin"
+	TypeMsgSetDenomMetadata         = "set_denom_metadata"
+	TypeMsgSetBeforeSendHook        = "set_before_send_hook"
+	TypeMsgSetBeforeSendHooks       = "set_before_send_hooks"
+	TypeMsgDisableDenomCapabilities = "disable_denom_capabilities"
+	TypeMsgGrantRole                = "grant_role"
+	TypeMsgRevokeRole               = "revoke_role"
+	TypeMsgSetMintAllowance         = "set_mint_allowance"
+	TypeMsgSetSupplyCap             = "set_supply_cap"
 )
 
 var (
 	_ sdk.Msg = &MsgCreateDenom{}
 	_ sdk.Msg = &MsgMint{}
 	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgBurnFrom{}
+	_ sdk.Msg = &MsgForceTransfer{}
+	_ sdk.Msg = &MsgDisableDenomCapabilities{}
+	_ sdk.Msg = &MsgGrantRole{}
+	_ sdk.Msg = &MsgRevokeRole{}
+	_ sdk.Msg = &MsgSetMintAllowance{}
+	_ sdk.Msg = &MsgSetSupplyCap{}
 	_ sdk.Msg = &MsgChangeAdmin{}
+	_ sdk.Msg = &MsgSetDenomPaused{}
+	_ sdk.Msg = &MsgSetAccountFrozen{}
+	_ sdk.Msg = &MsgSetDenomStrictMode{}
+	_ sdk.Msg = &MsgProposeAdmin{}
+	_ sdk.Msg = &MsgAcceptAdmin{}
+	_ sdk.Msg = &MsgCancelAdminTransfer{}
+	_ sdk.Msg = &MsgRenounceAdmin{}
 	_ sdk.Msg = &MsgSetDenomMetadata{}
 	_ sdk.Msg = &MsgSetBeforeSendHook{}
+	_ sdk.Msg = &MsgSetBeforeSendHooks{}
 )
 
 // NewMsgCreateDenom creates a msg to create a new denom
@@ -106,6 +137,207 @@ func (m MsgBurn) Validate(accAddrCodec address.Codec) error {
 	return nil
 }
 
+// NewMsgBurnFrom creates a message to burn tokens from an account
+func NewMsgBurnFrom(sender string, amount sdk.Coin, burnFromAddress string) *MsgBurnFrom {
+	return &MsgBurnFrom{
+		Sender:          sender,
+		Amount:          amount,
+		BurnFromAddress: burnFromAddress,
+	}
+}
+
+func (m MsgBurnFrom) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if !m.Amount.IsValid() || m.Amount.Amount.Equal(sdkmath.ZeroInt()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	if m.BurnFromAddress == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "empty burn-from-address")
+	} else if _, err := accAddrCodec.StringToBytes(m.BurnFromAddress); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewMsgForceTransfer creates a message to transfer tokens between accounts
+func NewMsgForceTransfer(sender string, amount sdk.Coin, fromAddr, toAddr string) *MsgForceTransfer {
+	return &MsgForceTransfer{
+		Sender:              sender,
+		Amount:              amount,
+		TransferFromAddress: fromAddr,
+		TransferToAddress:   toAddr,
+	}
+}
+
+func (m MsgForceTransfer) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if !m.Amount.IsValid() || m.Amount.Amount.Equal(sdkmath.ZeroInt()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	if m.TransferFromAddress == "" {
+		return ErrEmptyTransferFromAddress
+	} else if _, err := accAddrCodec.StringToBytes(m.TransferFromAddress); err != nil {
+		return err
+	}
+
+	if m.TransferToAddress == "" {
+		return ErrEmptyTransferToAddress
+	} else if _, err := accAddrCodec.StringToBytes(m.TransferToAddress); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewMsgDisableDenomCapabilities creates a message to turn off capabilities of a denom
+func NewMsgDisableDenomCapabilities(sender, denom string, disableBurnFrom, disableForceTransfer bool) *MsgDisableDenomCapabilities {
+	return &MsgDisableDenomCapabilities{
+		Sender:               sender,
+		Denom:                denom,
+		DisableBurnFrom:      disableBurnFrom,
+		DisableForceTransfer: disableForceTransfer,
+	}
+}
+
+func (m MsgDisableDenomCapabilities) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if _, _, err := DeconstructDenom(accAddrCodec, m.Denom); err != nil {
+		return err
+	}
+
+	if !m.DisableBurnFrom && !m.DisableForceTransfer {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no capability to disable")
+	}
+
+	return nil
+}
+
+// NewMsgGrantRole creates a message to grant a role of a denom
+func NewMsgGrantRole(sender, denom string, role DenomRole, addr string) *MsgGrantRole {
+	return &MsgGrantRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: addr,
+	}
+}
+
+func (m MsgGrantRole) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if _, _, err := DeconstructDenom(accAddrCodec, m.Denom); err != nil {
+		return err
+	}
+
+	return DenomRoleGrant{Role: m.Role, Address: m.Address}.Validate(accAddrCodec)
+}
+
+// NewMsgRevokeRole creates a message to revoke a role of a denom
+func NewMsgRevokeRole(sender, denom string, role DenomRole, addr string) *MsgRevokeRole {
+	return &MsgRevokeRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: addr,
+	}
+}
+
+func (m MsgRevokeRole) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if _, _, err := DeconstructDenom(accAddrCodec, m.Denom); err != nil {
+		return err
+	}
+
+	return DenomRoleGrant{Role: m.Role, Address: m.Address}.Validate(accAddrCodec)
+}
+
+// NewMsgSetMintAllowance creates a message to set the mint allowance of a
+// minter
+func NewMsgSetMintAllowance(sender, denom, minter string, allowance sdkmath.Int) *MsgSetMintAllowance {
+	return &MsgSetMintAllowance{
+		Sender:    sender,
+		Denom:     denom,
+		Minter:    minter,
+		Allowance: allowance,
+	}
+}
+
+func (m MsgSetMintAllowance) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if _, _, err := DeconstructDenom(accAddrCodec, m.Denom); err != nil {
+		return err
+	}
+
+	if m.Unlimited {
+		if _, err := accAddrCodec.StringToBytes(m.Minter); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	return MintAllowance{Minter: m.Minter, Allowance: m.Allowance}.Validate(accAddrCodec)
+}
+
+// NewMsgSetSupplyCap creates a message to set the supply cap of a denom
+func NewMsgSetSupplyCap(sender, denom string, supplyCap sdkmath.Int) *MsgSetSupplyCap {
+	return &MsgSetSupplyCap{
+		Sender:    sender,
+		Denom:     denom,
+		SupplyCap: supplyCap,
+	}
+}
+
+func (m MsgSetSupplyCap) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if _, _, err := DeconstructDenom(accAddrCodec, m.Denom); err != nil {
+		return err
+	}
+
+	if m.SupplyCap.IsNil() || m.SupplyCap.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidSupplyCap, "supply cap must be non-negative")
+	}
+
+	return nil
+}
+
 // NewMsgChangeAdmin creates a message to change the admin of a denom
 func NewMsgChangeAdmin(sender, denom, newAdmin string) *MsgChangeAdmin {
 	return &MsgChangeAdmin{
@@ -134,6 +366,184 @@ func (m MsgChangeAdmin) Validate(accAddrCodec address.Codec) error {
 	return nil
 }
 
+// NewMsgSetDenomPaused creates a message to pause or unpause the transfers of
+// a denom
+func NewMsgSetDenomPaused(sender, denom string, paused bool) *MsgSetDenomPaused {
+	return &MsgSetDenomPaused{
+		Sender: sender,
+		Denom:  denom,
+		Paused: paused,
+	}
+}
+
+func (m MsgSetDenomPaused) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if _, _, err := DeconstructDenom(accAddrCodec, m.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewMsgSetAccountFrozen creates a message to freeze or unfreeze the
+// transfers of a denom from and to an account
+func NewMsgSetAccountFrozen(sender, denom, addr string, frozen bool) *MsgSetAccountFrozen {
+	return &MsgSetAccountFrozen{
+		Sender:  sender,
+		Denom:   denom,
+		Address: addr,
+		Frozen:  frozen,
+	}
+}
+
+func (m MsgSetAccountFrozen) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if _, _, err := DeconstructDenom(accAddrCodec, m.Denom); err != nil {
+		return err
+	}
+
+	if addr, err := accAddrCodec.StringToBytes(m.Address); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "empty address")
+	}
+
+	return nil
+}
+
+// NewMsgSetDenomStrictMode creates a message to opt a denom in or out of the
+// blocking checks on module to module sends
+func NewMsgSetDenomStrictMode(sender, denom string, strict bool) *MsgSetDenomStrictMode {
+	return &MsgSetDenomStrictMode{
+		Sender: sender,
+		Denom:  denom,
+		Strict: strict,
+	}
+}
+
+func (m MsgSetDenomStrictMode) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if _, _, err := DeconstructDenom(accAddrCodec, m.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewMsgProposeAdmin creates a message to propose a new admin of a denom
+func NewMsgProposeAdmin(sender, denom, newAdmin string, expiresAt time.Time) *MsgProposeAdmin {
+	return &MsgProposeAdmin{
+		Sender:    sender,
+		Denom:     denom,
+		NewAdmin:  newAdmin,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (m MsgProposeAdmin) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if addr, err := accAddrCodec.StringToBytes(m.NewAdmin); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return errorsmod.Wrap(ErrInvalidAdminTransfer, "empty new admin; use MsgRenounceAdmin instead")
+	}
+
+	if _, _, err := DeconstructDenom(accAddrCodec, m.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewMsgAcceptAdmin creates a message to accept the pending admin transfer of
+// a denom
+func NewMsgAcceptAdmin(sender, denom string) *MsgAcceptAdmin {
+	return &MsgAcceptAdmin{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgAcceptAdmin) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if _, _, err := DeconstructDenom(accAddrCodec, m.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewMsgCancelAdminTransfer creates a message to cancel the pending admin
+// transfer of a denom
+func NewMsgCancelAdminTransfer(sender, denom string) *MsgCancelAdminTransfer {
+	return &MsgCancelAdminTransfer{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgCancelAdminTransfer) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if _, _, err := DeconstructDenom(accAddrCodec, m.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewMsgRenounceAdmin creates a message to permanently give up the admin of a
+// denom
+func NewMsgRenounceAdmin(sender, denom string) *MsgRenounceAdmin {
+	return &MsgRenounceAdmin{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgRenounceAdmin) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if _, _, err := DeconstructDenom(accAddrCodec, m.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // NewMsgChangeAdmin creates a message to set metadata for a denom
 func NewMsgSetDenomMetadata(sender string, metadata banktypes.Metadata) *MsgSetDenomMetadata {
 	return &MsgSetDenomMetadata{
@@ -178,5 +588,59 @@ func (m MsgSetBeforeSendHook) Validate(accAddrCodec address.Codec) error {
 	if _, _, err := DeconstructDenom(accAddrCodec, m.Denom); err != nil {
 		return ErrInvalidDenom
 	}
+
+	if m.CosmwasmAddress == "" && m.GasLimit != 0 {
+		return errorsmod.Wrap(ErrInvalidHookGasLimit, "gas limit cannot be set without a hook contract")
+	}
+	return nil
+}
+
+// NewMsgSetBeforeSendHooks create a message to set the ordered before-send
+// hooks of a denom
+func NewMsgSetBeforeSendHooks(sender string, denom string, cosmwasmAddresses []string) *MsgSetBeforeSendHooks {
+	return &MsgSetBeforeSendHooks{
+		Sender:            sender,
+		Denom:             denom,
+		CosmwasmAddresses: cosmwasmAddresses,
+	}
+}
+
+func (m MsgSetBeforeSendHooks) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	if _, _, err := DeconstructDenom(accAddrCodec, m.Denom); err != nil {
+		return ErrInvalidDenom
+	}
+
+	if len(m.CosmwasmAddresses) == 0 && m.GasLimit != 0 {
+		return errorsmod.Wrap(ErrInvalidHookGasLimit, "gas limit cannot be set without a hook contract")
+	}
+
+	return ValidateBeforeSendHooks(accAddrCodec, m.CosmwasmAddresses)
+}
+
+// ValidateBeforeSendHooks checks the number of hooks, the hook addresses and
+// that no contract is registered twice.
+func ValidateBeforeSendHooks(accAddrCodec address.Codec, cosmwasmAddresses []string) error {
+	if len(cosmwasmAddresses) > MaxBeforeSendHooks {
+		return errorsmod.Wrapf(ErrInvalidBeforeSendHooks, "too many hooks; max %d, got %d", MaxBeforeSendHooks, len(cosmwasmAddresses))
+	}
+
+	seen := make(map[string]bool, len(cosmwasmAddresses))
+	for _, cosmwasmAddress := range cosmwasmAddresses {
+		if _, err := accAddrCodec.StringToBytes(cosmwasmAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidBeforeSendHooks, "invalid hook address %s: %s", cosmwasmAddress, err)
+		}
+
+		if seen[cosmwasmAddress] {
+			return errorsmod.Wrapf(ErrInvalidBeforeSendHooks, "duplicate hook address %s", cosmwasmAddress)
+		}
+		seen[cosmwasmAddress] = true
+	}
+
 	return nil
 }
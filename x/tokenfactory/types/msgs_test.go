@@ -110,6 +110,31 @@ func TestAuthzMsg(t *testing.T) {
 				Amount: coin,
 			},
 		},
+		{
+			name: "MsgBurnFrom",
+			msg: &types.MsgBurnFrom{
+				Sender:          addr1,
+				Amount:          coin,
+				BurnFromAddress: addr1,
+			},
+		},
+		{
+			name: "MsgForceTransfer",
+			msg: &types.MsgForceTransfer{
+				Sender:              addr1,
+				Amount:              coin,
+				TransferFromAddress: addr1,
+				TransferToAddress:   addr1,
+			},
+		},
+		{
+			name: "MsgDisableDenomCapabilities",
+			msg: &types.MsgDisableDenomCapabilities{
+				Sender:          addr1,
+				Denom:           "denom",
+				DisableBurnFrom: true,
+			},
+		},
 		{
 			name: "MsgChangeAdmin",
 			msg: &types.MsgChangeAdmin{
@@ -118,6 +143,47 @@ func TestAuthzMsg(t *testing.T) {
 				NewAdmin: "init1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu",
 			},
 		},
+		{
+			name: "MsgProposeAdmin",
+			msg: &types.MsgProposeAdmin{
+				Sender:    addr1,
+				Denom:     "denom",
+				NewAdmin:  "init1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu",
+				ExpiresAt: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "MsgRenounceAdmin",
+			msg: &types.MsgRenounceAdmin{
+				Sender: addr1,
+				Denom:  "denom",
+			},
+		},
+		{
+			name: "MsgSetDenomPaused",
+			msg: &types.MsgSetDenomPaused{
+				Sender: addr1,
+				Denom:  "denom",
+				Paused: true,
+			},
+		},
+		{
+			name: "MsgSetAccountFrozen",
+			msg: &types.MsgSetAccountFrozen{
+				Sender:  addr1,
+				Denom:   "denom",
+				Address: addr1,
+				Frozen:  true,
+			},
+		},
+		{
+			name: "MsgSetDenomStrictMode",
+			msg: &types.MsgSetDenomStrictMode{
+				Sender: addr1,
+				Denom:  "denom",
+				Strict: true,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -426,6 +492,83 @@ func TestMsgChangeAdmin(t *testing.T) {
 	}
 }
 
+// TestMsgProposeAdmin tests if valid/invalid propose admin messages are properly validated/invalidated
+func TestMsgProposeAdmin(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	baseMsg := types.NewMsgProposeAdmin(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+		time.Time{},
+	)
+
+	ac := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgProposeAdmin
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgProposeAdmin {
+				msg := *baseMsg
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper msg with expiry",
+			msg: func() types.MsgProposeAdmin {
+				msg := *baseMsg
+				msg.ExpiresAt = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgProposeAdmin {
+				msg := *baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty newAdmin",
+			msg: func() types.MsgProposeAdmin {
+				msg := *baseMsg
+				msg.NewAdmin = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgProposeAdmin {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().Validate(ac), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().Validate(ac), "test: %v", test.name)
+		}
+	}
+}
+
 // TestMsgSetDenomMetadata tests if valid/invalid create denom messages are properly validated/invalidated
 func TestMsgSetDenomMetadata(t *testing.T) {
 	// generate a private/public key pair and get the respective address
@@ -535,3 +678,193 @@ func TestMsgSetDenomMetadata(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgSetBeforeSendHook tests if valid/invalid set before send hook messages are properly validated/invalidated
+func TestMsgSetBeforeSendHook(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setBeforeSendHook message
+	baseMsg := types.NewMsgSetBeforeSendHook(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+	)
+
+	ac := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgSetBeforeSendHook
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper msg with gas limit",
+			msg: func() types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.GasLimit = 1_000_000
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty cosmwasm address",
+			msg: func() types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.CosmwasmAddress = ""
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "gas limit without cosmwasm address",
+			msg: func() types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.CosmwasmAddress = ""
+				msg.GasLimit = 1_000_000
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().Validate(ac), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().Validate(ac), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSetBeforeSendHooks(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	pk3 := ed25519.GenPrivKey().PubKey()
+	addr3 := sdk.AccAddress(pk3.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setBeforeSendHooks message
+	baseMsg := types.NewMsgSetBeforeSendHooks(
+		addr1.String(),
+		tokenFactoryDenom,
+		[]string{addr2.String(), addr3.String()},
+	)
+
+	ac := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgSetBeforeSendHooks
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgSetBeforeSendHooks {
+				msg := *baseMsg
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty hooks",
+			msg: func() types.MsgSetBeforeSendHooks {
+				msg := *baseMsg
+				msg.CosmwasmAddresses = nil
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "gas limit without hooks",
+			msg: func() types.MsgSetBeforeSendHooks {
+				msg := *baseMsg
+				msg.CosmwasmAddresses = nil
+				msg.GasLimit = 1_000_000
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate hooks",
+			msg: func() types.MsgSetBeforeSendHooks {
+				msg := *baseMsg
+				msg.CosmwasmAddresses = []string{addr2.String(), addr2.String()}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid hook address",
+			msg: func() types.MsgSetBeforeSendHooks {
+				msg := *baseMsg
+				msg.CosmwasmAddresses = []string{"invalid"}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "too many hooks",
+			msg: func() types.MsgSetBeforeSendHooks {
+				msg := *baseMsg
+				msg.CosmwasmAddresses = nil
+				for i := 0; i <= types.MaxBeforeSendHooks; i++ {
+					msg.CosmwasmAddresses = append(msg.CosmwasmAddresses, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String())
+				}
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().Validate(ac), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().Validate(ac), "test: %v", test.name)
+		}
+	}
+}
+
+func TestParseDenomRole(t *testing.T) {
+	for input, expected := range map[string]types.DenomRole{
+		"minter":                      types.DenomRoleMinter,
+		"burner":                      types.DenomRoleBurner,
+		"metadata-manager":            types.DenomRoleMetadataManager,
+		"hook_manager":                types.DenomRoleHookManager,
+		"DENOM_ROLE_METADATA_MANAGER": types.DenomRoleMetadataManager,
+	} {
+		role, err := types.ParseDenomRole(input)
+		require.NoError(t, err, input)
+		require.Equal(t, expected, role, input)
+	}
+
+	for _, input := range []string{"", "unspecified", "admin"} {
+		_, err := types.ParseDenomRole(input)
+		require.ErrorIs(t, err, types.ErrInvalidRole, input)
+	}
+}
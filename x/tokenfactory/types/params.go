@@ -10,15 +10,20 @@ import (
 var (
 	KeyDenomCreationFee        = []byte("DenomCreationFee")
 	KeyDenomCreationGasConsume = []byte("DenomCreationGasConsume")
+	KeyMaxBeforeSendHookGas    = []byte("MaxBeforeSendHookGasLimit")
+	KeyFeeDestination          = []byte("DenomCreationFeeDestination")
+	KeyFeeRecipient            = []byte("DenomCreationFeeRecipient")
+	KeyMaxDenomsPerCreator     = []byte("MaxDenomsPerCreator")
 
 	// chosen as an arbitrary large number, less than the max_gas_wanted_per_tx in config.
 	DefaultCreationGasFee = 1_000_000
 )
 
-func NewParams(denomCreationFee sdk.Coins, denomCreationGasConsume uint64) Params {
+func NewParams(denomCreationFee sdk.Coins, denomCreationGasConsume, maxBeforeSendHookGasLimit uint64) Params {
 	return Params{
-		DenomCreationFee:        denomCreationFee,
-		DenomCreationGasConsume: denomCreationGasConsume,
+		DenomCreationFee:          denomCreationFee,
+		DenomCreationGasConsume:   denomCreationGasConsume,
+		MaxBeforeSendHookGasLimit: maxBeforeSendHookGasLimit,
 	}
 }
 
@@ -26,8 +31,9 @@ func NewParams(denomCreationFee sdk.Coins, denomCreationGasConsume uint64) Param
 func DefaultParams() Params {
 	return Params{
 		// For choice, see: https://github.com/osmosis-labs/osmosis/pull/4983
-		DenomCreationFee:        sdk.NewCoins(), // used to be 10 OSMO at launch.
-		DenomCreationGasConsume: uint64(DefaultCreationGasFee),
+		DenomCreationFee:          sdk.NewCoins(), // used to be 10 OSMO at launch.
+		DenomCreationGasConsume:   uint64(DefaultCreationGasFee),
+		MaxBeforeSendHookGasLimit: DefaultMaxBeforeSendHookGasLimit,
 	}
 }
 
@@ -41,6 +47,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxBeforeSendHookGasLimit(p.MaxBeforeSendHookGasLimit); err != nil {
+		return err
+	}
+
+	if err := validateFeeDestination(p.DenomCreationFeeDestination, p.DenomCreationFeeRecipient); err != nil {
+		return err
+	}
+
+	if err := validateMaxDenomsPerCreator(p.MaxDenomsPerCreator); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -65,3 +83,38 @@ func validateDenomCreationGasConsume(i any) error {
 
 	return nil
 }
+
+func validateMaxBeforeSendHookGasLimit(i any) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFeeDestination(destination FeeDestination, recipient string) error {
+	switch destination {
+	case FeeDestinationFeeCollector, FeeDestinationBurn:
+		if recipient != "" {
+			return fmt.Errorf("denom creation fee recipient can only be set with %s", FeeDestinationAddress)
+		}
+	case FeeDestinationAddress:
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return fmt.Errorf("invalid denom creation fee recipient: %w", err)
+		}
+	default:
+		return fmt.Errorf("invalid denom creation fee destination: %d", destination)
+	}
+
+	return nil
+}
+
+func validateMaxDenomsPerCreator(i any) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -25,6 +26,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FeeDestination defines where the denom creation fee is sent.
+type FeeDestination int32
+
+const (
+	// FEE_DESTINATION_FEE_COLLECTOR sends the fee to the fee collector.
+	FeeDestinationFeeCollector FeeDestination = 0
+	// FEE_DESTINATION_BURN burns the fee.
+	FeeDestinationBurn FeeDestination = 1
+	// FEE_DESTINATION_ADDRESS sends the fee to denom_creation_fee_recipient.
+	FeeDestinationAddress FeeDestination = 2
+)
+
+var FeeDestination_name = map[int32]string{
+	0: "FEE_DESTINATION_FEE_COLLECTOR",
+	1: "FEE_DESTINATION_BURN",
+	2: "FEE_DESTINATION_ADDRESS",
+}
+
+var FeeDestination_value = map[string]int32{
+	"FEE_DESTINATION_FEE_COLLECTOR": 0,
+	"FEE_DESTINATION_BURN":          1,
+	"FEE_DESTINATION_ADDRESS":       2,
+}
+
+func (x FeeDestination) String() string {
+	return proto.EnumName(FeeDestination_name, int32(x))
+}
+
+func (FeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d4485882fe34268d, []int{0}
+}
+
 // Params defines the parameters for the tokenfactory module.
 type Params struct {
 	// DenomCreationFee defines the fee to be charged on the creation of a new
@@ -36,6 +69,19 @@ type Params struct {
 	//
 	// See: https://github.com/CosmWasm/token-factory/issues/11
 	DenomCreationGasConsume uint64 `protobuf:"varint,2,opt,name=denom_creation_gas_consume,json=denomCreationGasConsume,proto3" json:"denom_creation_gas_consume,omitempty" yaml:"denom_creation_gas_consume"`
+	// MaxBeforeSendHookGasLimit defines the upper bound of the gas limit a denom
+	// admin can set for the before send hook of a denom. Zero disables per-denom
+	// gas limits.
+	MaxBeforeSendHookGasLimit uint64 `protobuf:"varint,3,opt,name=max_before_send_hook_gas_limit,json=maxBeforeSendHookGasLimit,proto3" json:"max_before_send_hook_gas_limit,omitempty" yaml:"max_before_send_hook_gas_limit"`
+	// DenomCreationFeeDestination defines where the denom creation fee goes.
+	DenomCreationFeeDestination FeeDestination `protobuf:"varint,4,opt,name=denom_creation_fee_destination,json=denomCreationFeeDestination,proto3,enum=miniwasm.tokenfactory.v1.FeeDestination" json:"denom_creation_fee_destination,omitempty" yaml:"denom_creation_fee_destination"`
+	// DenomCreationFeeRecipient defines the address receiving the denom
+	// creation fee when the destination is FEE_DESTINATION_ADDRESS.
+	DenomCreationFeeRecipient string `protobuf:"bytes,5,opt,name=denom_creation_fee_recipient,json=denomCreationFeeRecipient,proto3" json:"denom_creation_fee_recipient,omitempty" yaml:"denom_creation_fee_recipient"`
+	// MaxDenomsPerCreator defines the maximum number of denoms an account can
+	// create. Zero means no limit. Accounts on the opchild fee whitelist are
+	// exempted from the limit and the denom creation fee.
+	MaxDenomsPerCreator uint64 `protobuf:"varint,6,opt,name=max_denoms_per_creator,json=maxDenomsPerCreator,proto3" json:"max_denoms_per_creator,omitempty" yaml:"max_denoms_per_creator"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -85,7 +131,36 @@ func (m *Params) GetDenomCreationGasConsume() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxBeforeSendHookGasLimit() uint64 {
+	if m != nil {
+		return m.MaxBeforeSendHookGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetDenomCreationFeeDestination() FeeDestination {
+	if m != nil {
+		return m.DenomCreationFeeDestination
+	}
+	return FeeDestinationFeeCollector
+}
+
+func (m *Params) GetDenomCreationFeeRecipient() string {
+	if m != nil {
+		return m.DenomCreationFeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetMaxDenomsPerCreator() uint64 {
+	if m != nil {
+		return m.MaxDenomsPerCreator
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("miniwasm.tokenfactory.v1.FeeDestination", FeeDestination_name, FeeDestination_value)
 	proto.RegisterType((*Params)(nil), "miniwasm.tokenfactory.v1.Params")
 }
 
@@ -94,28 +169,48 @@ func init() {
 }
 
 var fileDescriptor_d4485882fe34268d = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0x3a, 0x41,
-	0x10, 0xc6, 0x6f, 0xf9, 0xff, 0x43, 0x71, 0x36, 0xe6, 0x62, 0x22, 0x50, 0xec, 0xe1, 0x25, 0x26,
-	0x58, 0xb0, 0x9b, 0x53, 0x2b, 0x4b, 0x48, 0xb4, 0xd2, 0x18, 0x4a, 0x1b, 0xb2, 0x77, 0x2c, 0xe7,
-	0x06, 0x76, 0x87, 0xdc, 0x2c, 0x28, 0x8f, 0x60, 0x67, 0xe5, 0x43, 0xf8, 0x24, 0x94, 0x94, 0x56,
-	0xa7, 0x81, 0x37, 0xe0, 0x09, 0x0c, 0x77, 0x68, 0x40, 0xad, 0x76, 0xb2, 0xfb, 0xcd, 0x6f, 0xbe,
-	0x9d, 0xcf, 0x3d, 0xd6, 0xca, 0xa8, 0x07, 0x81, 0x9a, 0x5b, 0x18, 0x48, 0xd3, 0x17, 0xb1, 0x85,
-	0x74, 0xca, 0x27, 0x21, 0x1f, 0x89, 0x54, 0x68, 0x64, 0xa3, 0x14, 0x2c, 0x78, 0x95, 0x2f, 0x19,
-	0xdb, 0x96, 0xb1, 0x49, 0x58, 0xa3, 0x31, 0xa0, 0x06, 0xe4, 0x91, 0x40, 0xc9, 0x27, 0x61, 0x24,
-	0xad, 0x08, 0x79, 0x0c, 0xca, 0x14, 0x9d, 0xb5, 0x83, 0x04, 0x12, 0xc8, 0x4b, 0xbe, 0xae, 0x8a,
-	0xdb, 0xe0, 0xa9, 0xe4, 0x96, 0x6f, 0xf3, 0x01, 0xde, 0x0b, 0x71, 0xbd, 0x9e, 0x34, 0xa0, 0xbb,
-	0x71, 0x2a, 0x85, 0x55, 0x60, 0xba, 0x7d, 0x29, 0x2b, 0xa4, 0xfe, 0xaf, 0xb1, 0x77, 0x5a, 0x65,
-	0x05, 0x9e, 0xad, 0xf1, 0x6c, 0x83, 0x67, 0x6d, 0x50, 0xa6, 0x75, 0x3d, 0xcb, 0x7c, 0x67, 0x95,
-	0xf9, 0xd5, 0xa9, 0xd0, 0xc3, 0x8b, 0xe0, 0x37, 0x22, 0x78, 0x7d, 0xf7, 0x1b, 0x89, 0xb2, 0xf7,
-	0xe3, 0x88, 0xc5, 0xa0, 0xf9, 0xc6, 0x68, 0x71, 0x34, 0xb1, 0x37, 0xe0, 0x76, 0x3a, 0x92, 0x98,
-	0xd3, 0xb0, 0xb3, 0x9f, 0x03, 0xda, 0x9b, 0xfe, 0x4b, 0x29, 0xbd, 0xbe, 0x5b, 0xfb, 0x01, 0x4d,
-	0x04, 0x76, 0x63, 0x30, 0x38, 0xd6, 0xb2, 0x52, 0xaa, 0x93, 0xc6, 0xff, 0xd6, 0xc9, 0x2c, 0xf3,
-	0xc9, 0x2a, 0xf3, 0x8f, 0xfe, 0x34, 0xb1, 0xa5, 0x0f, 0x3a, 0x87, 0x3b, 0x03, 0xae, 0x04, 0xb6,
-	0x8b, 0x97, 0xd6, 0xcd, 0x6c, 0x41, 0xc9, 0x7c, 0x41, 0xc9, 0xc7, 0x82, 0x92, 0xe7, 0x25, 0x75,
-	0xe6, 0x4b, 0xea, 0xbc, 0x2d, 0xa9, 0x73, 0x77, 0xbe, 0xe5, 0x5e, 0x19, 0x65, 0x95, 0x68, 0x0e,
-	0x45, 0x84, 0xfc, 0x3b, 0xb3, 0xc7, 0xdd, 0xd4, 0xf2, 0xff, 0x44, 0xe5, 0x7c, 0xc5, 0x67, 0x9f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x68, 0x00, 0xf9, 0xdb, 0x01, 0x00, 0x00,
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xbd, 0x6f, 0xd3, 0x5a,
+	0x14, 0xcf, 0xed, 0x97, 0xf4, 0xfc, 0xa4, 0x2a, 0xf2, 0xeb, 0x6b, 0x1d, 0x43, 0x6d, 0xd7, 0xa8,
+	0x52, 0x40, 0xaa, 0x4d, 0x0a, 0x62, 0x60, 0x41, 0x89, 0x93, 0x94, 0x4a, 0x25, 0xad, 0x9c, 0xc2,
+	0xc0, 0x62, 0xdd, 0xd8, 0x27, 0xe9, 0x55, 0xe2, 0x7b, 0x23, 0x5f, 0xb7, 0xa4, 0x1b, 0x23, 0xea,
+	0xc4, 0x84, 0x58, 0x3a, 0xb1, 0x31, 0xf3, 0x1f, 0xb0, 0x74, 0xac, 0x98, 0x98, 0x0c, 0x6a, 0x67,
+	0x96, 0xfc, 0x05, 0x28, 0xb6, 0xdb, 0x26, 0xee, 0xc7, 0x94, 0x9c, 0x73, 0x7e, 0x1f, 0xc7, 0xe7,
+	0xdc, 0x7b, 0x85, 0x55, 0x9f, 0x50, 0xf2, 0x0e, 0x73, 0xdf, 0x0c, 0x59, 0x17, 0x68, 0x1b, 0xbb,
+	0x21, 0x0b, 0x0e, 0xcd, 0x83, 0x92, 0xd9, 0xc7, 0x01, 0xf6, 0xb9, 0xd1, 0x0f, 0x58, 0xc8, 0x44,
+	0xe9, 0x02, 0x66, 0x8c, 0xc3, 0x8c, 0x83, 0x92, 0xac, 0xb8, 0x8c, 0xfb, 0x8c, 0x9b, 0x2d, 0xcc,
+	0xc1, 0x3c, 0x28, 0xb5, 0x20, 0xc4, 0x25, 0xd3, 0x65, 0x84, 0x26, 0x4c, 0xb9, 0x90, 0xd4, 0x9d,
+	0x38, 0x32, 0x93, 0x20, 0x2d, 0x2d, 0x74, 0x58, 0x87, 0x25, 0xf9, 0xd1, 0xbf, 0x24, 0xab, 0xff,
+	0x99, 0x15, 0xe6, 0x76, 0x62, 0x6f, 0xf1, 0x13, 0x12, 0x44, 0x0f, 0x28, 0xf3, 0x1d, 0x37, 0x00,
+	0x1c, 0x12, 0x46, 0x9d, 0x36, 0x80, 0x84, 0xb4, 0xe9, 0xe2, 0xbf, 0xeb, 0x05, 0x23, 0x15, 0x1b,
+	0x39, 0x1b, 0xa9, 0xb3, 0x61, 0x31, 0x42, 0x2b, 0xaf, 0x4e, 0x22, 0x35, 0x37, 0x8c, 0xd4, 0xc2,
+	0x21, 0xf6, 0x7b, 0xcf, 0xf5, 0xeb, 0x12, 0xfa, 0xd7, 0x5f, 0x6a, 0xb1, 0x43, 0xc2, 0xbd, 0xfd,
+	0x96, 0xe1, 0x32, 0x3f, 0x6d, 0x2b, 0xfd, 0x59, 0xe3, 0x5e, 0xd7, 0x0c, 0x0f, 0xfb, 0xc0, 0x63,
+	0x35, 0x6e, 0xe7, 0x63, 0x01, 0x2b, 0xe5, 0xd7, 0x01, 0xc4, 0xb6, 0x20, 0x67, 0x44, 0x3b, 0x98,
+	0x3b, 0x2e, 0xa3, 0x7c, 0xdf, 0x07, 0x69, 0x4a, 0x43, 0xc5, 0x99, 0xca, 0xc3, 0x93, 0x48, 0x45,
+	0xc3, 0x48, 0x5d, 0xb9, 0xb1, 0x89, 0x31, 0xbc, 0x6e, 0x2f, 0x4d, 0x18, 0x6c, 0x60, 0x6e, 0x25,
+	0x15, 0xb1, 0x27, 0x28, 0x3e, 0x1e, 0x38, 0x2d, 0x68, 0xb3, 0x00, 0x1c, 0x0e, 0xd4, 0x73, 0xf6,
+	0x18, 0xeb, 0xc6, 0xec, 0x1e, 0xf1, 0x49, 0x28, 0x4d, 0x27, 0x5e, 0xc3, 0x48, 0x5d, 0x4d, 0x7c,
+	0xee, 0xc6, 0xeb, 0x76, 0xc1, 0xc7, 0x83, 0x4a, 0x5c, 0x6f, 0x02, 0xf5, 0x5e, 0x32, 0xd6, 0xdd,
+	0xc0, 0x7c, 0x6b, 0x54, 0x13, 0x3f, 0x23, 0x41, 0xb9, 0x3e, 0x2b, 0xc7, 0x03, 0x1e, 0x12, 0x1a,
+	0xc7, 0xd2, 0x8c, 0x86, 0x8a, 0xf3, 0xeb, 0x45, 0xe3, 0xb6, 0xe3, 0x60, 0xd4, 0x01, 0xaa, 0x57,
+	0xf8, 0xf1, 0xc6, 0xee, 0x56, 0xd6, 0xed, 0x7b, 0xd9, 0x29, 0x8f, 0xe9, 0x88, 0xef, 0x91, 0x70,
+	0xff, 0x06, 0x81, 0x00, 0x5c, 0xd2, 0x27, 0x40, 0x43, 0x69, 0x56, 0x43, 0xc5, 0x7f, 0x2a, 0x2f,
+	0x86, 0x91, 0xfa, 0xe0, 0x56, 0xbb, 0x4b, 0xb4, 0xfe, 0xe3, 0xdb, 0xda, 0x42, 0x7a, 0x7a, 0xca,
+	0x9e, 0x17, 0x00, 0xe7, 0xcd, 0x30, 0x20, 0xb4, 0x63, 0x17, 0xb2, 0x4d, 0xd8, 0x17, 0x1c, 0xf1,
+	0x8d, 0xb0, 0x38, 0x9a, 0x6d, 0x0c, 0xe0, 0x4e, 0x1f, 0x82, 0x44, 0x9c, 0x05, 0xd2, 0x5c, 0xbc,
+	0x83, 0x95, 0x61, 0xa4, 0x2e, 0x5f, 0xed, 0xe0, 0x3a, 0x4e, 0xb7, 0xff, 0xf3, 0xf1, 0xa0, 0x1a,
+	0xe7, 0x77, 0x20, 0xb0, 0x92, 0xec, 0xa3, 0xef, 0x48, 0x98, 0xcf, 0x7c, 0x6d, 0x59, 0x58, 0xae,
+	0xd7, 0x6a, 0x4e, 0xb5, 0xd6, 0xdc, 0xdd, 0x6c, 0x94, 0x77, 0x37, 0xb7, 0x1b, 0xce, 0x28, 0xb6,
+	0xb6, 0xb7, 0xb6, 0x6a, 0xd6, 0xee, 0xb6, 0x9d, 0xcf, 0xc9, 0xca, 0xd1, 0xb1, 0x26, 0x4f, 0xd2,
+	0xea, 0x00, 0x16, 0xeb, 0xf5, 0x60, 0xb4, 0x0b, 0xf1, 0xb1, 0xb0, 0x90, 0x95, 0xa8, 0xbc, 0xb6,
+	0x1b, 0x79, 0x24, 0x2f, 0x1e, 0x1d, 0x6b, 0x62, 0x66, 0x4d, 0xfb, 0x01, 0x15, 0x9f, 0x09, 0x4b,
+	0x59, 0x46, 0xb9, 0x5a, 0xb5, 0x6b, 0xcd, 0x66, 0x7e, 0x4a, 0x2e, 0x1c, 0x1d, 0x6b, 0xff, 0x4f,
+	0x92, 0xd2, 0xc1, 0xc9, 0x33, 0x1f, 0xbe, 0x28, 0xb9, 0x4a, 0xe3, 0xe4, 0x4c, 0x41, 0xa7, 0x67,
+	0x0a, 0xfa, 0x7d, 0xa6, 0xa0, 0x8f, 0xe7, 0x4a, 0xee, 0xf4, 0x5c, 0xc9, 0xfd, 0x3c, 0x57, 0x72,
+	0x6f, 0x9f, 0x8e, 0xdd, 0x33, 0x42, 0x49, 0x48, 0xf0, 0x5a, 0x0f, 0xb7, 0xb8, 0x79, 0xf9, 0xf0,
+	0x0c, 0x26, 0x9f, 0x9e, 0xf8, 0xe6, 0xb5, 0xe6, 0xe2, 0xc7, 0xe0, 0xc9, 0xdf, 0x01, 0x00, 0x83,
+	0x44, 0xe3, 0x2d, 0xa0, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -138,6 +233,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDenomsPerCreator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDenomsPerCreator))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DenomCreationFeeRecipient) > 0 {
+		i -= len(m.DenomCreationFeeRecipient)
+		copy(dAtA[i:], m.DenomCreationFeeRecipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DenomCreationFeeRecipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DenomCreationFeeDestination != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DenomCreationFeeDestination))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxBeforeSendHookGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBeforeSendHookGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DenomCreationGasConsume != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DenomCreationGasConsume))
 		i--
@@ -186,6 +303,19 @@ func (m *Params) Size() (n int) {
 	if m.DenomCreationGasConsume != 0 {
 		n += 1 + sovParams(uint64(m.DenomCreationGasConsume))
 	}
+	if m.MaxBeforeSendHookGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.MaxBeforeSendHookGasLimit))
+	}
+	if m.DenomCreationFeeDestination != 0 {
+		n += 1 + sovParams(uint64(m.DenomCreationFeeDestination))
+	}
+	l = len(m.DenomCreationFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxDenomsPerCreator != 0 {
+		n += 1 + sovParams(uint64(m.MaxDenomsPerCreator))
+	}
 	return n
 }
 
@@ -277,6 +407,95 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBeforeSendHookGasLimit", wireType)
+			}
+			m.MaxBeforeSendHookGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBeforeSendHookGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFeeDestination", wireType)
+			}
+			m.DenomCreationFeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomCreationFeeDestination |= FeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDenomsPerCreator", wireType)
+			}
+			m.MaxDenomsPerCreator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDenomsPerCreator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate returns an error if the role is not a grantable role.
+func (r DenomRole) Validate() error {
+	switch r {
+	case DenomRoleMinter, DenomRoleBurner, DenomRoleMetadataManager, DenomRoleHookManager:
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrInvalidRole, "%d", r)
+	}
+}
+
+// ParseDenomRole parses a role from its proto name, e.g. DENOM_ROLE_MINTER,
+// or its short name, e.g. minter or metadata-manager.
+func ParseDenomRole(s string) (DenomRole, error) {
+	name := strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+	if !strings.HasPrefix(name, "DENOM_ROLE_") {
+		name = "DENOM_ROLE_" + name
+	}
+
+	role := DenomRole(DenomRole_value[name])
+	if err := role.Validate(); err != nil {
+		return DenomRoleUnspecified, errorsmod.Wrap(ErrInvalidRole, s)
+	}
+
+	return role, nil
+}
+
+// Validate performs basic validation of the role grant.
+func (g DenomRoleGrant) Validate(ac address.Codec) error {
+	if err := g.Role.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := ac.StringToBytes(g.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRole, "invalid address %s: %s", g.Address, err)
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the mint allowance.
+func (a MintAllowance) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(a.Minter); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRole, "invalid minter %s: %s", a.Minter, err)
+	}
+
+	if a.Allowance.IsNil() || a.Allowance.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRole, "invalid mint allowance of %s", a.Minter)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package wasmextension
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	wasmextensionv1 "github.com/initia-labs/miniwasm/api/miniwasm/wasmextension/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: wasmextensionv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "AcceptedStargateMsgs",
+					Use:       "accepted-stargate-msgs",
+					Short:     "Returns the msg type urls contracts are allowed to dispatch as stargate messages",
+				},
+				{
+					RpcMethod: "AdminCodes",
+					Use:       "admin-codes",
+					Short:     "Returns the codes stored with admin permission",
+				},
+				{
+					RpcMethod: "AdminCode",
+					Use:       "admin-code [code-id]",
+					Short:     "Get the admin store record of a specific code",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "code_id"},
+					},
+				},
+				{
+					RpcMethod: "RegisteredChecksums",
+					Use:       "registered-checksums",
+					Short:     "Returns the code checksums allowed to be stored with admin permission",
+				},
+				{
+					RpcMethod: "RegisteredChecksum",
+					Use:       "registered-checksum [checksum]",
+					Short:     "Get the provenance metadata of a registered code checksum",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "checksum"},
+					},
+				},
+				{
+					RpcMethod: "FailedCallbacks",
+					Use:       "failed-callbacks",
+					Short:     "Returns the failed ibc lifecycle callbacks pending to be retried",
+				},
+				{
+					RpcMethod: "FailedCallback",
+					Use:       "failed-callback [id]",
+					Short:     "Get a failed ibc lifecycle callback",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: wasmextensionv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "RetryFailedCallback",
+					Use:       "retry-failed-callback [id]",
+					Short:     "Retry a failed ibc lifecycle callback",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+
+	"github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+// storeAdminCode stores the wasm code with admin permission. The code is
+// rejected when its checksum is not in the registry, and the store is
+// recorded as an admin code otherwise.
+func (k Keeper) storeAdminCode(ctx context.Context, creator sdk.AccAddress, authority string, wasmCode []byte, instantiateAccess *wasmtypes.AccessConfig) (uint64, []byte, error) {
+	// the checksum is checked before the code is handed to the vm, because the
+	// compiled code stays in the vm cache even if the msg is reverted
+	checksum, err := codeChecksum(wasmCode)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if found, err := k.IsRegisteredChecksum(ctx, checksum); err != nil {
+		return 0, nil, err
+	} else if !found {
+		return 0, nil, types.ErrChecksumNotRegistered.Wrapf("%X", checksum)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	codeID, checksum, err := wasmkeeper.NewGovPermissionKeeper(k.wasmKeeper).Create(sdkCtx, creator, wasmCode, instantiateAccess)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if err := k.recordAdminCode(ctx, codeID, creator.String(), authority, checksum); err != nil {
+		return 0, nil, err
+	}
+
+	return codeID, checksum, nil
+}
+
+// codeChecksum returns the checksum of the wasm code the same way the wasm
+// keeper computes it, from the uncompressed code.
+func codeChecksum(wasmCode []byte) ([]byte, error) {
+	if ioutils.IsGzip(wasmCode) {
+		var err error
+		wasmCode, err = ioutils.Uncompress(wasmCode, int64(wasmtypes.DefaultMaxProposalWasmSize))
+		if err != nil {
+			return nil, wasmtypes.ErrCreateFailed.Wrapf("uncompress wasm archive: %s", err)
+		}
+	}
+
+	checksum, err := wasmvm.CreateChecksum(wasmCode)
+	if err != nil {
+		return nil, wasmtypes.ErrCreateFailed.Wrap(err.Error())
+	}
+
+	return checksum, nil
+}
+
+// recordAdminCode stores the record of a code stored with admin permission.
+func (k Keeper) recordAdminCode(ctx context.Context, codeID uint64, creator, authority string, checksum []byte) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.AdminCodes.Set(ctx, codeID, types.AdminCodeInfo{
+		CodeID:    codeID,
+		Creator:   creator,
+		Authority: authority,
+		Height:    sdkCtx.BlockHeight(),
+		Checksum:  checksum,
+	})
+}
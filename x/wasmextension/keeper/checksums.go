@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+// IsRegisteredChecksum returns whether the code checksum is allowed to be
+// stored with admin permission.
+func (k Keeper) IsRegisteredChecksum(ctx context.Context, checksum []byte) (bool, error) {
+	return k.RegisteredChecksums.Has(ctx, checksum)
+}
+
+// RegisterChecksums adds the checksums to the registry, overwriting the
+// metadata of already registered checksums.
+func (k Keeper) RegisterChecksums(ctx context.Context, checksums []types.RegisteredChecksum) error {
+	for _, checksum := range checksums {
+		if err := checksum.Validate(); err != nil {
+			return err
+		}
+
+		if err := k.RegisteredChecksums.Set(ctx, checksum.Checksum, checksum); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnregisterChecksums removes the checksums from the registry.
+func (k Keeper) UnregisterChecksums(ctx context.Context, checksums [][]byte) error {
+	for _, checksum := range checksums {
+		if found, err := k.RegisteredChecksums.Has(ctx, checksum); err != nil {
+			return err
+		} else if !found {
+			return types.ErrChecksumNotRegistered.Wrapf("%X", checksum)
+		}
+
+		if err := k.RegisteredChecksums.Remove(ctx, checksum); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmextensionkeeper "github.com/initia-labs/miniwasm/x/wasmextension/keeper"
+	wasmextensiontypes "github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+func TestRegisterChecksums(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+
+	code, err := os.ReadFile("../../../app/ibc-hooks/contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+	checksum := sha256.Sum256(code)
+
+	authority := input.WasmExtensionKeeper.GetAuthority()
+	wasmMsgServer := wasmextensionkeeper.NewMsgServerImpl(input.WasmExtensionKeeper)
+	querier := wasmextensionkeeper.Querier{Keeper: input.WasmExtensionKeeper}
+
+	registeredChecksum := wasmextensiontypes.RegisteredChecksum{
+		Checksum:     checksum[:],
+		SourceURL:    "https://github.com/initia-labs/miniwasm/tree/main/app/ibc-hooks/contracts",
+		BuilderImage: "cosmwasm/optimizer:0.16.0",
+	}
+
+	// invalid authority
+	_, err = wasmMsgServer.RegisterChecksums(ctx, &wasmextensiontypes.MsgRegisterChecksums{
+		Authority: addr.String(),
+		Checksums: []wasmextensiontypes.RegisteredChecksum{registeredChecksum},
+	})
+	require.Error(t, err)
+
+	// invalid checksum length
+	_, err = wasmMsgServer.RegisterChecksums(ctx, &wasmextensiontypes.MsgRegisterChecksums{
+		Authority: authority,
+		Checksums: []wasmextensiontypes.RegisteredChecksum{{Checksum: []byte("short")}},
+	})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrInvalidChecksum)
+
+	// duplicate checksums
+	_, err = wasmMsgServer.RegisterChecksums(ctx, &wasmextensiontypes.MsgRegisterChecksums{
+		Authority: authority,
+		Checksums: []wasmextensiontypes.RegisteredChecksum{registeredChecksum, registeredChecksum},
+	})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrInvalidChecksum)
+
+	// the gzip compressed code is rejected before registration
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err = zw.Write(code)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	_, err = wasmMsgServer.StoreCodeAdmin(ctx, &wasmextensiontypes.MsgStoreCodeAdmin{
+		Authority:    authority,
+		Creator:      addr.String(),
+		WASMByteCode: buf.Bytes(),
+	})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrChecksumNotRegistered)
+
+	// the unregistered code is rejected before it is stored
+	require.Nil(t, input.WasmKeeper.GetCodeInfo(ctx, 1))
+
+	// register
+	_, err = wasmMsgServer.RegisterChecksums(ctx, &wasmextensiontypes.MsgRegisterChecksums{
+		Authority: authority,
+		Checksums: []wasmextensiontypes.RegisteredChecksum{registeredChecksum},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.RegisteredChecksum(ctx, &wasmextensiontypes.QueryRegisteredChecksumRequest{
+		Checksum: hex.EncodeToString(checksum[:]),
+	})
+	require.NoError(t, err)
+	require.Equal(t, registeredChecksum, res.Checksum)
+
+	listRes, err := querier.RegisteredChecksums(ctx, &wasmextensiontypes.QueryRegisteredChecksumsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []wasmextensiontypes.RegisteredChecksum{registeredChecksum}, listRes.Checksums)
+
+	// the checksum is matched against the uncompressed code
+	storeRes, err := wasmMsgServer.StoreCodeAdmin(ctx, &wasmextensiontypes.MsgStoreCodeAdmin{
+		Authority:    authority,
+		Creator:      addr.String(),
+		WASMByteCode: buf.Bytes(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, checksum[:], storeRes.Checksum)
+
+	// unregister
+	_, err = wasmMsgServer.UnregisterChecksums(ctx, &wasmextensiontypes.MsgUnregisterChecksums{
+		Authority: authority,
+		Checksums: [][]byte{checksum[:]},
+	})
+	require.NoError(t, err)
+
+	_, err = querier.RegisteredChecksum(ctx, &wasmextensiontypes.QueryRegisteredChecksumRequest{
+		Checksum: hex.EncodeToString(checksum[:]),
+	})
+	require.Error(t, err)
+
+	_, err = wasmMsgServer.UnregisterChecksums(ctx, &wasmextensiontypes.MsgUnregisterChecksums{
+		Authority: authority,
+		Checksums: [][]byte{checksum[:]},
+	})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrChecksumNotRegistered)
+
+	_, err = wasmMsgServer.StoreCodeAdmin(ctx, &wasmextensiontypes.MsgStoreCodeAdmin{
+		Authority:    authority,
+		Creator:      addr.String(),
+		WASMByteCode: code,
+	})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrChecksumNotRegistered)
+}
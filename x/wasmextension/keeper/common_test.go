@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"context"
+	"crypto/sha256"
 	"encoding/binary"
 	"slices"
 	"testing"
@@ -53,6 +55,8 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	"github.com/initia-labs/miniwasm/x/wasmextension"
+	wasmextensionkeeper "github.com/initia-labs/miniwasm/x/wasmextension/keeper"
+	wasmextensiontypes "github.com/initia-labs/miniwasm/x/wasmextension/types"
 )
 
 var ModuleBasics = module.NewBasicManager(
@@ -175,6 +179,8 @@ type TestKeepers struct {
 	WasmKeeper    wasmkeeper.Keeper
 	OPChildKeeper *opchildkeeper.Keeper
 
+	WasmExtensionKeeper *wasmextensionkeeper.Keeper
+
 	EncodingConfig EncodingConfig
 	Faucet         *TestFaucet
 	MultiStore     storetypes.CommitMultiStore
@@ -215,6 +221,7 @@ func _createTestInput(
 	keys := storetypes.NewKVStoreKeys(
 		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
 		distributiontypes.StoreKey, wasmtypes.StoreKey, opchildtypes.StoreKey,
+		wasmextensiontypes.StoreKey,
 	)
 	ms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	for _, v := range keys {
@@ -314,6 +321,14 @@ func _createTestInput(
 	)
 	require.NoError(t, opchildKeeper.Params.Set(ctx, opchildtypes.DefaultParams()))
 
+	wasmExtensionKeeper := wasmextensionkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[wasmextensiontypes.StoreKey]),
+		&wasmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	require.NoError(t, wasmExtensionKeeper.SetParams(ctx, wasmextensiontypes.DefaultParams()))
+
 	faucet := NewTestFaucet(t, ctx, bankKeeper, authtypes.Minter, initialTotalSupply()...)
 
 	keepers := TestKeepers{
@@ -324,6 +339,13 @@ func _createTestInput(
 		EncodingConfig: encodingConfig,
 		Faucet:         faucet,
 		MultiStore:     ms,
+
+		WasmExtensionKeeper: &wasmExtensionKeeper,
 	}
 	return ctx, keepers
 }
+
+func registerChecksum(t testing.TB, ctx context.Context, k *wasmextensionkeeper.Keeper, code []byte) {
+	checksum := sha256.Sum256(code)
+	require.NoError(t, k.RegisterChecksums(ctx, []wasmextensiontypes.RegisteredChecksum{{Checksum: checksum[:]}}))
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+// EnqueueFailedCallback persists an ibc lifecycle callback which failed to be
+// executed, so it can be retried later. It returns false if the retry queue is
+// disabled by the params or the contract already has the max number of failed
+// callbacks in the queue.
+func (k Keeper) EnqueueFailedCallback(ctx context.Context, contractAddr sdk.AccAddress, msg []byte, execErr error) (uint64, bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, false, err
+	} else if params.MaxCallbackRetries == 0 {
+		return 0, false, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	count, err := k.FailedCallbackCounts.Get(ctx, contractAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, false, err
+	} else if count >= params.MaxFailedCallbacksPerContract {
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeFailedCallbackDropped,
+			sdk.NewAttribute(types.AttributeKeyContract, contractAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyError, execErr.Error()),
+		))
+
+		return 0, false, nil
+	}
+
+	id, err := k.nextFailedCallbackID(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	callback := types.FailedCallback{
+		ID:              id,
+		Contract:        contractAddr.String(),
+		Msg:             msg,
+		Height:          sdkCtx.BlockHeight(),
+		NextRetryHeight: sdkCtx.BlockHeight() + int64(params.CallbackRetryInterval),
+	}
+	if err := k.setFailedCallback(ctx, callback); err != nil {
+		return 0, false, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFailedCallbackQueued,
+		sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+		sdk.NewAttribute(types.AttributeKeyError, execErr.Error()),
+	))
+
+	return id, true, nil
+}
+
+// RetryFailedCallback executes the failed callback again. The callback is
+// removed from the queue when it succeeds or when it reaches the max retries,
+// and the failure of the execution itself is not returned as an error.
+func (k Keeper) RetryFailedCallback(ctx context.Context, id uint64) (success bool, dropped bool, err error) {
+	callback, err := k.FailedCallbacks.Get(ctx, id)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return false, false, types.ErrFailedCallbackNotFound.Wrapf("id %d", id)
+	} else if err != nil {
+		return false, false, err
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		return false, false, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockHeight() < callback.NextRetryHeight {
+		return false, false, types.ErrFailedCallbackRetryTooEarly.Wrapf("id %d can be retried from height %d", id, callback.NextRetryHeight)
+	}
+
+	// execute the callback in a cache context to discard the state changes of
+	// a failed execution
+	cacheCtx, write := sdkCtx.CacheContext()
+	if _, execErr := k.wasmKeeper.Sudo(cacheCtx, contractAddr, callback.Msg); execErr != nil {
+		callback.Retries++
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeFailedCallbackRetried,
+			sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+			sdk.NewAttribute(types.AttributeKeyRetries, strconv.FormatUint(uint64(callback.Retries), 10)),
+			sdk.NewAttribute(types.AttributeKeySuccess, "false"),
+			sdk.NewAttribute(types.AttributeKeyError, execErr.Error()),
+		))
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return false, false, err
+		}
+		if callback.Retries < params.MaxCallbackRetries {
+			callback.NextRetryHeight = sdkCtx.BlockHeight() + int64(params.CallbackRetryInterval)
+			return false, false, k.FailedCallbacks.Set(ctx, id, callback)
+		}
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeFailedCallbackDropped,
+			sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+		))
+
+		return false, true, k.removeFailedCallback(ctx, contractAddr, id)
+	}
+
+	write()
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFailedCallbackRetried,
+		sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+		sdk.NewAttribute(types.AttributeKeyRetries, strconv.FormatUint(uint64(callback.Retries), 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, "true"),
+	))
+
+	return true, false, k.removeFailedCallback(ctx, contractAddr, id)
+}
+
+// setFailedCallback stores a new failed callback and increases the number of
+// failed callbacks of its contract.
+func (k Keeper) setFailedCallback(ctx context.Context, callback types.FailedCallback) error {
+	contractAddr, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		return err
+	}
+
+	count, err := k.FailedCallbackCounts.Get(ctx, contractAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err := k.FailedCallbackCounts.Set(ctx, contractAddr, count+1); err != nil {
+		return err
+	}
+
+	return k.FailedCallbacks.Set(ctx, callback.ID, callback)
+}
+
+// removeFailedCallback removes a failed callback and decreases the number of
+// failed callbacks of its contract.
+func (k Keeper) removeFailedCallback(ctx context.Context, contractAddr sdk.AccAddress, id uint64) error {
+	count, err := k.FailedCallbackCounts.Get(ctx, contractAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if count <= 1 {
+		if err := k.FailedCallbackCounts.Remove(ctx, contractAddr); err != nil {
+			return err
+		}
+	} else if err := k.FailedCallbackCounts.Set(ctx, contractAddr, count-1); err != nil {
+		return err
+	}
+
+	return k.FailedCallbacks.Remove(ctx, id)
+}
+
+// nextFailedCallbackID returns the next failed callback id. The ids start from
+// 1, so the zero of a sequence which is not initialized by genesis is skipped.
+func (k Keeper) nextFailedCallbackID(ctx context.Context) (uint64, error) {
+	id, err := k.NextFailedCallbackID.Next(ctx)
+	if err != nil {
+		return 0, err
+	} else if id == 0 {
+		return k.NextFailedCallbackID.Next(ctx)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	wasmextensionkeeper "github.com/initia-labs/miniwasm/x/wasmextension/keeper"
+	wasmextensiontypes "github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+func TestRetryFailedCallback(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+
+	code, err := os.ReadFile("../../../app/ibc-hooks/contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	permissionKeeper := wasmkeeper.NewDefaultPermissionKeeper(input.WasmKeeper)
+	codeID, _, err := permissionKeeper.Create(ctx, addr, code, nil)
+	require.NoError(t, err)
+	contractAddr, _, err := permissionKeeper.Instantiate(ctx, codeID, addr, addr, []byte("{}"), "Counter", nil)
+	require.NoError(t, err)
+
+	params := wasmextensiontypes.DefaultParams()
+	params.MaxCallbackRetries = 2
+	params.CallbackRetryInterval = 5
+	params.MaxFailedCallbacksPerContract = 2
+	require.NoError(t, input.WasmExtensionKeeper.SetParams(ctx, params))
+
+	msgServer := wasmextensionkeeper.NewMsgServerImpl(input.WasmExtensionKeeper)
+	querier := wasmextensionkeeper.Querier{Keeper: input.WasmExtensionKeeper}
+
+	ctx = ctx.WithBlockHeight(10)
+	timeoutMsg := []byte(`{"ibc_lifecycle_complete": {"ibc_timeout": {"channel": "channel-0", "sequence": 7}}}`)
+	id, queued, err := input.WasmExtensionKeeper.EnqueueFailedCallback(ctx, contractAddr, timeoutMsg, errors.New("out of gas"))
+	require.NoError(t, err)
+	require.True(t, queued)
+	require.Equal(t, uint64(1), id)
+
+	unknownMsg := []byte(`{"unknown": {}}`)
+	unknownID, _, err := input.WasmExtensionKeeper.EnqueueFailedCallback(ctx, contractAddr, unknownMsg, errors.New("unknown"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), unknownID)
+
+	// the contract already has the max number of failed callbacks
+	_, queued, err = input.WasmExtensionKeeper.EnqueueFailedCallback(ctx, contractAddr, timeoutMsg, errors.New("out of gas"))
+	require.NoError(t, err)
+	require.False(t, queued)
+
+	res, err := querier.FailedCallback(ctx, &wasmextensiontypes.QueryFailedCallbackRequest{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, wasmextensiontypes.FailedCallback{
+		ID:              id,
+		Contract:        contractAddr.String(),
+		Msg:             timeoutMsg,
+		Height:          10,
+		NextRetryHeight: 15,
+	}, res.FailedCallback)
+
+	listRes, err := querier.FailedCallbacks(ctx, &wasmextensiontypes.QueryFailedCallbacksRequest{})
+	require.NoError(t, err)
+	require.Len(t, listRes.FailedCallbacks, 2)
+
+	// genesis round trip
+	genState := input.WasmExtensionKeeper.ExportGenesis(ctx)
+	require.NoError(t, genState.Validate())
+	require.Len(t, genState.FailedCallbacks, 2)
+	require.Equal(t, uint64(3), genState.NextFailedCallbackID)
+
+	// the callback cannot be retried before the retry interval has passed
+	_, err = msgServer.RetryFailedCallback(ctx, &wasmextensiontypes.MsgRetryFailedCallback{Sender: addr.String(), ID: id})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrFailedCallbackRetryTooEarly)
+
+	// any account can retry; the successful callback is removed
+	ctx = ctx.WithBlockHeight(15)
+	retryRes, err := msgServer.RetryFailedCallback(ctx, &wasmextensiontypes.MsgRetryFailedCallback{Sender: addr.String(), ID: id})
+	require.NoError(t, err)
+	require.True(t, retryRes.Success)
+
+	queryRes, err := input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "7", string(queryRes))
+
+	_, err = querier.FailedCallback(ctx, &wasmextensiontypes.QueryFailedCallbackRequest{Id: id})
+	require.Error(t, err)
+
+	_, err = msgServer.RetryFailedCallback(ctx, &wasmextensiontypes.MsgRetryFailedCallback{Sender: addr.String(), ID: id})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrFailedCallbackNotFound)
+
+	// the failing callback is kept until it reaches the max retries
+	retryRes, err = msgServer.RetryFailedCallback(ctx, &wasmextensiontypes.MsgRetryFailedCallback{Sender: addr.String(), ID: unknownID})
+	require.NoError(t, err)
+	require.False(t, retryRes.Success)
+	require.False(t, retryRes.Dropped)
+
+	res, err = querier.FailedCallback(ctx, &wasmextensiontypes.QueryFailedCallbackRequest{Id: unknownID})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.FailedCallback.Retries)
+	require.Equal(t, int64(20), res.FailedCallback.NextRetryHeight)
+
+	_, err = msgServer.RetryFailedCallback(ctx, &wasmextensiontypes.MsgRetryFailedCallback{Sender: addr.String(), ID: unknownID})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrFailedCallbackRetryTooEarly)
+
+	ctx = ctx.WithBlockHeight(20)
+	retryRes, err = msgServer.RetryFailedCallback(ctx, &wasmextensiontypes.MsgRetryFailedCallback{Sender: addr.String(), ID: unknownID})
+	require.NoError(t, err)
+	require.False(t, retryRes.Success)
+	require.True(t, retryRes.Dropped)
+
+	_, err = querier.FailedCallback(ctx, &wasmextensiontypes.QueryFailedCallbackRequest{Id: unknownID})
+	require.Error(t, err)
+
+	// the removed callbacks free the slots of the contract
+	has, err := input.WasmExtensionKeeper.FailedCallbackCounts.Has(ctx, contractAddr)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, queued, err = input.WasmExtensionKeeper.EnqueueFailedCallback(ctx, contractAddr, timeoutMsg, errors.New("out of gas"))
+	require.NoError(t, err)
+	require.True(t, queued)
+
+	// the queue is disabled with zero max retries
+	params.MaxCallbackRetries = 0
+	require.NoError(t, input.WasmExtensionKeeper.SetParams(ctx, params))
+	_, queued, err = input.WasmExtensionKeeper.EnqueueFailedCallback(ctx, contractAddr, timeoutMsg, errors.New("out of gas"))
+	require.NoError(t, err)
+	require.False(t, queued)
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+)
+
+// SetForwardRefundAddress stores the refund address of an ics20 transfer
+// forwarded by an ibc hook until the transfer is acknowledged or timed out.
+func (k Keeper) SetForwardRefundAddress(ctx context.Context, portID, channelID string, sequence uint64, refundAddr string) error {
+	return k.ForwardRefunds.Set(ctx, collections.Join3(portID, channelID, sequence), refundAddr)
+}
+
+// PopForwardRefundAddress removes and returns the refund address of a
+// forwarded transfer. It returns false if the transfer has no refund address.
+func (k Keeper) PopForwardRefundAddress(ctx context.Context, portID, channelID string, sequence uint64) (string, bool, error) {
+	key := collections.Join3(portID, channelID, sequence)
+	refundAddr, err := k.ForwardRefunds.Get(ctx, key)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+
+	return refundAddr, true, k.ForwardRefunds.Remove(ctx, key)
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmextensiontypes "github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+func TestForwardRefundAddress(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+
+	keeper := input.WasmExtensionKeeper
+	require.NoError(t, keeper.SetForwardRefundAddress(ctx, "transfer", "channel-1", 1, addr.String()))
+	require.NoError(t, keeper.SetForwardRefundAddress(ctx, "transfer", "channel-1", 2, addr.String()))
+
+	// genesis round trip
+	genState := keeper.ExportGenesis(ctx)
+	require.NoError(t, genState.Validate())
+	require.Equal(t, []wasmextensiontypes.ForwardRefund{
+		{PortId: "transfer", ChannelId: "channel-1", Sequence: 1, RefundAddress: addr.String()},
+		{PortId: "transfer", ChannelId: "channel-1", Sequence: 2, RefundAddress: addr.String()},
+	}, genState.ForwardRefunds)
+
+	// the refund address is removed once popped
+	refundAddr, found, err := keeper.PopForwardRefundAddress(ctx, "transfer", "channel-1", 1)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, addr.String(), refundAddr)
+
+	_, found, err = keeper.PopForwardRefundAddress(ctx, "transfer", "channel-1", 1)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	genState = keeper.ExportGenesis(ctx)
+	require.Len(t, genState.ForwardRefunds, 1)
+
+	// duplicate refunds are rejected
+	genState.ForwardRefunds = append(genState.ForwardRefunds, genState.ForwardRefunds[0])
+	require.Error(t, genState.Validate())
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+// InitGenesis initializes the wasmextension module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	if err := k.validateAcceptedStargateQueries(genState.Params.AcceptedStargateQueries); err != nil {
+		panic(err)
+	}
+
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
+	if err := k.AddAcceptedStargateMsgs(ctx, genState.AcceptedStargateMsgs); err != nil {
+		panic(err)
+	}
+
+	if err := k.RegisterChecksums(ctx, genState.RegisteredChecksums); err != nil {
+		panic(err)
+	}
+
+	for _, adminCode := range genState.AdminCodes {
+		if err := k.AdminCodes.Set(ctx, adminCode.CodeID, adminCode); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, callback := range genState.FailedCallbacks {
+		if err := k.setFailedCallback(ctx, callback); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.NextFailedCallbackID.Set(ctx, genState.NextFailedCallbackID); err != nil {
+		panic(err)
+	}
+
+	for _, refund := range genState.ForwardRefunds {
+		if err := k.SetForwardRefundAddress(ctx, refund.PortId, refund.ChannelId, refund.Sequence, refund.RefundAddress); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the wasmextension module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	acceptedStargateMsgs := []string{}
+	err := k.AcceptedStargateMsgs.Walk(ctx, nil, func(typeURL string) (stop bool, err error) {
+		acceptedStargateMsgs = append(acceptedStargateMsgs, typeURL)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	adminCodes := []types.AdminCodeInfo{}
+	err = k.AdminCodes.Walk(ctx, nil, func(_ uint64, adminCode types.AdminCodeInfo) (stop bool, err error) {
+		adminCodes = append(adminCodes, adminCode)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	registeredChecksums := []types.RegisteredChecksum{}
+	err = k.RegisteredChecksums.Walk(ctx, nil, func(_ []byte, checksum types.RegisteredChecksum) (stop bool, err error) {
+		registeredChecksums = append(registeredChecksums, checksum)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	failedCallbacks := []types.FailedCallback{}
+	err = k.FailedCallbacks.Walk(ctx, nil, func(_ uint64, callback types.FailedCallback) (stop bool, err error) {
+		failedCallbacks = append(failedCallbacks, callback)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	nextFailedCallbackID, err := k.NextFailedCallbackID.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	forwardRefunds := []types.ForwardRefund{}
+	err = k.ForwardRefunds.Walk(ctx, nil, func(key collections.Triple[string, string, uint64], refundAddr string) (stop bool, err error) {
+		forwardRefunds = append(forwardRefunds, types.ForwardRefund{
+			PortId:        key.K1(),
+			ChannelId:     key.K2(),
+			Sequence:      key.K3(),
+			RefundAddress: refundAddr,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params:               params,
+		AcceptedStargateMsgs: acceptedStargateMsgs,
+		AdminCodes:           adminCodes,
+		RegisteredChecksums:  registeredChecksums,
+		FailedCallbacks:      failedCallbacks,
+		NextFailedCallbackID: nextFailedCallbackID,
+		ForwardRefunds:       forwardRefunds,
+	}
+}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+type Querier struct {
+	*Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// AcceptedStargateMsgs returns the msg type urls contracts are allowed to
+// dispatch as stargate messages.
+func (q Querier) AcceptedStargateMsgs(ctx context.Context, req *types.QueryAcceptedStargateMsgsRequest) (*types.QueryAcceptedStargateMsgsResponse, error) {
+	typeURLs, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.AcceptedStargateMsgs, req.Pagination, func(typeURL string, _ collections.NoValue) (string, error) {
+		return typeURL, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAcceptedStargateMsgsResponse{
+		TypeURLs:   typeURLs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AdminCodes returns the codes stored with admin permission.
+func (q Querier) AdminCodes(ctx context.Context, req *types.QueryAdminCodesRequest) (*types.QueryAdminCodesResponse, error) {
+	adminCodes, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.AdminCodes, req.Pagination, func(_ uint64, adminCode types.AdminCodeInfo) (types.AdminCodeInfo, error) {
+		return adminCode, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAdminCodesResponse{
+		AdminCodes: adminCodes,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AdminCode returns the admin store record of a code.
+func (q Querier) AdminCode(ctx context.Context, req *types.QueryAdminCodeRequest) (*types.QueryAdminCodeResponse, error) {
+	if req.CodeId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "code id is required")
+	}
+
+	adminCode, err := q.Keeper.AdminCodes.Get(ctx, req.CodeId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "code %d was not stored with admin permission", req.CodeId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAdminCodeResponse{
+		AdminCode: adminCode,
+	}, nil
+}
+
+// RegisteredChecksums returns the code checksums allowed to be stored with
+// admin permission.
+func (q Querier) RegisteredChecksums(ctx context.Context, req *types.QueryRegisteredChecksumsRequest) (*types.QueryRegisteredChecksumsResponse, error) {
+	checksums, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.RegisteredChecksums, req.Pagination, func(_ []byte, checksum types.RegisteredChecksum) (types.RegisteredChecksum, error) {
+		return checksum, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRegisteredChecksumsResponse{
+		Checksums:  checksums,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RegisteredChecksum returns the provenance metadata of a registered code
+// checksum.
+func (q Querier) RegisteredChecksum(ctx context.Context, req *types.QueryRegisteredChecksumRequest) (*types.QueryRegisteredChecksumResponse, error) {
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid checksum: %s", err)
+	}
+
+	registeredChecksum, err := q.Keeper.RegisteredChecksums.Get(ctx, checksum)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "checksum %s is not registered", req.Checksum)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRegisteredChecksumResponse{
+		Checksum: registeredChecksum,
+	}, nil
+}
+
+// FailedCallbacks returns the failed ibc lifecycle callbacks pending to be
+// retried.
+func (q Querier) FailedCallbacks(ctx context.Context, req *types.QueryFailedCallbacksRequest) (*types.QueryFailedCallbacksResponse, error) {
+	callbacks, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.FailedCallbacks, req.Pagination, func(_ uint64, callback types.FailedCallback) (types.FailedCallback, error) {
+		return callback, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFailedCallbacksResponse{
+		FailedCallbacks: callbacks,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// FailedCallback returns a failed ibc lifecycle callback.
+func (q Querier) FailedCallback(ctx context.Context, req *types.QueryFailedCallbackRequest) (*types.QueryFailedCallbackResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	callback, err := q.Keeper.FailedCallbacks.Get(ctx, req.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "failed callback %d not found", req.Id)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFailedCallbackResponse{FailedCallback: callback}, nil
+}
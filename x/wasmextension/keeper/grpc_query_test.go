@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	wasmextensionkeeper "github.com/initia-labs/miniwasm/x/wasmextension/keeper"
+	wasmextensiontypes "github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+func TestQueryAdminCodes(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+
+	code, err := os.ReadFile("../../../app/ibc-hooks/contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	authority := input.WasmExtensionKeeper.GetAuthority()
+	registerChecksum(t, ctx, input.WasmExtensionKeeper, code)
+	wasmMsgServer := wasmextensionkeeper.NewMsgServerImpl(input.WasmExtensionKeeper)
+	querier := wasmextensionkeeper.Querier{Keeper: input.WasmExtensionKeeper}
+
+	// code uploaded by a user is not recorded
+	userCodeID, _, err := wasmkeeper.NewDefaultPermissionKeeper(input.WasmKeeper).Create(ctx, addr, code, nil)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(10)
+	storeRes, err := wasmMsgServer.StoreCodeAdmin(ctx, &wasmextensiontypes.MsgStoreCodeAdmin{
+		Authority:    authority,
+		Creator:      addr.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(11)
+	storeCodesRes, err := wasmMsgServer.StoreCodesAdmin(ctx, &wasmextensiontypes.MsgStoreCodesAdmin{
+		Authority: authority,
+		Creator:   addr.String(),
+		Codes:     []wasmextensiontypes.WasmCode{{WASMByteCode: code}},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.AdminCode(ctx, &wasmextensiontypes.QueryAdminCodeRequest{CodeId: storeRes.CodeID})
+	require.NoError(t, err)
+	require.Equal(t, wasmextensiontypes.AdminCodeInfo{
+		CodeID:    storeRes.CodeID,
+		Creator:   addr.String(),
+		Authority: authority,
+		Height:    10,
+		Checksum:  storeRes.Checksum,
+	}, res.AdminCode)
+
+	_, err = querier.AdminCode(ctx, &wasmextensiontypes.QueryAdminCodeRequest{CodeId: userCodeID})
+	require.Error(t, err)
+
+	listRes, err := querier.AdminCodes(ctx, &wasmextensiontypes.QueryAdminCodesRequest{})
+	require.NoError(t, err)
+	require.Len(t, listRes.AdminCodes, 2)
+	require.Equal(t, storeRes.CodeID, listRes.AdminCodes[0].CodeID)
+	require.Equal(t, storeCodesRes.CodeIDs[0], listRes.AdminCodes[1].CodeID)
+	require.Equal(t, int64(11), listRes.AdminCodes[1].Height)
+
+	// pagination
+	listRes, err = querier.AdminCodes(ctx, &wasmextensiontypes.QueryAdminCodesRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, listRes.AdminCodes, 1)
+	require.NotNil(t, listRes.Pagination.NextKey)
+
+	// genesis round trip
+	genState := input.WasmExtensionKeeper.ExportGenesis(ctx)
+	require.NoError(t, genState.Validate())
+	require.Len(t, genState.AdminCodes, 2)
+}
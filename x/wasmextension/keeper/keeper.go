@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	corestoretypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	"github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+type Keeper struct {
+	cdc          codec.Codec
+	storeService corestoretypes.KVStoreService
+
+	wasmKeeper *wasmkeeper.Keeper
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// key = msg type url
+	AcceptedStargateMsgs collections.KeySet[string]
+	// key = code id
+	AdminCodes collections.Map[uint64, types.AdminCodeInfo]
+	// key = code checksum
+	RegisteredChecksums collections.Map[[]byte, types.RegisteredChecksum]
+	// key = failed callback id
+	FailedCallbacks      collections.Map[uint64, types.FailedCallback]
+	NextFailedCallbackID collections.Sequence
+	// key = contract address
+	FailedCallbackCounts collections.Map[[]byte, uint32]
+	// key = (port id, channel id, sequence) of a forwarded transfer
+	ForwardRefunds collections.Map[collections.Triple[string, string, uint64], string]
+
+	authority string
+}
+
+// NewKeeper returns a new instance of the x/wasmextension keeper
+func NewKeeper(
+	cdc codec.Codec,
+	storeService corestoretypes.KVStoreService,
+	wasmKeeper *wasmkeeper.Keeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+
+		wasmKeeper: wasmKeeper,
+
+		Params:               collections.NewItem(sb, types.ParamsKeyPrefix, "params", codec.CollValue[types.Params](cdc)),
+		AcceptedStargateMsgs: collections.NewKeySet(sb, types.AcceptedStargateMsgsKeyPrefix, "accepted_stargate_msgs", collections.StringKey),
+		AdminCodes:           collections.NewMap(sb, types.AdminCodesKeyPrefix, "admin_codes", collections.Uint64Key, codec.CollValue[types.AdminCodeInfo](cdc)),
+		RegisteredChecksums:  collections.NewMap(sb, types.RegisteredChecksumsKeyPrefix, "registered_checksums", collections.BytesKey, codec.CollValue[types.RegisteredChecksum](cdc)),
+		FailedCallbacks:      collections.NewMap(sb, types.FailedCallbacksKeyPrefix, "failed_callbacks", collections.Uint64Key, codec.CollValue[types.FailedCallback](cdc)),
+		NextFailedCallbackID: collections.NewSequence(sb, types.NextFailedCallbackIDKey, "next_failed_callback_id"),
+		FailedCallbackCounts: collections.NewMap(sb, types.FailedCallbackCountsKeyPrefix, "failed_callback_counts", collections.BytesKey, collections.Uint32Value),
+		ForwardRefunds:       collections.NewMap(sb, types.ForwardRefundsKeyPrefix, "forward_refunds", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key), collections.StringValue),
+
+		authority: authority,
+	}
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/wasmextension module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a logger for the x/wasmextension module
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
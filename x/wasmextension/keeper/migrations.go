@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2 by initializing the state of the
+// wasmextension store, which did not exist in version 1. The stargate msg
+// allowlist is seeded with the default accepted stargate msgs.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.keeper.SetParams(ctx, types.DefaultParams()); err != nil {
+		return err
+	}
+
+	if err := m.keeper.AddAcceptedStargateMsgs(ctx, types.DefaultAcceptedStargateMsgs(m.keeper.cdc.InterfaceRegistry())); err != nil {
+		return err
+	}
+
+	return m.keeper.NextFailedCallbackID.Set(ctx, types.DefaultGenesis().NextFailedCallbackID)
+}
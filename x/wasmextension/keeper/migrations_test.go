@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmextensionkeeper "github.com/initia-labs/miniwasm/x/wasmextension/keeper"
+	wasmextensiontypes "github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	k := input.WasmExtensionKeeper
+
+	// version 1 has no wasmextension store state
+	require.NoError(t, k.Params.Remove(ctx))
+	require.NoError(t, k.NextFailedCallbackID.Set(ctx, 0))
+
+	require.NoError(t, wasmextensionkeeper.NewMigrator(k).Migrate1to2(ctx))
+
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Empty(t, params.AcceptedStargateQueries)
+	require.Equal(t, uint32(wasmextensiontypes.DefaultMaxCallbackRetries), params.MaxCallbackRetries)
+
+	// the default msgs are accepted, but not the wrapper msgs
+	accepted, err := k.IsAcceptedStargateMsg(ctx, "/cosmos.bank.v1beta1.MsgSend")
+	require.NoError(t, err)
+	require.True(t, accepted)
+
+	accepted, err = k.IsAcceptedStargateMsg(ctx, "/cosmos.authz.v1beta1.MsgExec")
+	require.NoError(t, err)
+	require.False(t, accepted)
+
+	nextID, err := k.NextFailedCallbackID.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), nextID)
+}
@@ -18,13 +18,12 @@ var _ types.MsgServer = msgServer{}
 
 // grpc message server implementation
 type msgServer struct {
-	keeper    *wasmkeeper.Keeper
-	authority string
+	*Keeper
 }
 
 // NewMsgServerImpl default constructor
-func NewMsgServerImpl(k *wasmkeeper.Keeper, authority string) types.MsgServer {
-	return &msgServer{keeper: k, authority: authority}
+func NewMsgServerImpl(k *Keeper) types.MsgServer {
+	return &msgServer{Keeper: k}
 }
 
 // StoreCode stores a new wasm code on chain
@@ -41,16 +40,290 @@ func (m msgServer) StoreCodeAdmin(ctx context.Context, msg *types.MsgStoreCodeAd
 		return nil, errorsmod.Wrap(err, "invalid creator address")
 	}
 
-	govPermissionKeeper := wasmkeeper.NewGovPermissionKeeper(m.keeper)
+	codeID, checksum, err := m.storeAdminCode(ctx, senderAddr, msg.Authority, msg.WASMByteCode, msg.InstantiatePermission.ToWasmAccessConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgStoreCodeAdminResponse{
+		CodeID:   codeID,
+		Checksum: checksum,
+	}, nil
+}
+
+// StoreCodesAdmin stores multiple wasm codes on chain
+func (m msgServer) StoreCodesAdmin(ctx context.Context, msg *types.MsgStoreCodesAdmin) (*types.MsgStoreCodesAdminResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	codeIDs := make([]uint64, len(msg.Codes))
+	checksums := make([][]byte, len(msg.Codes))
+	for i, code := range msg.Codes {
+		codeIDs[i], checksums[i], err = m.storeAdminCode(ctx, senderAddr, msg.Authority, code.WASMByteCode, code.InstantiatePermission.ToWasmAccessConfig())
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "code at index %d", i)
+		}
+	}
+
+	return &types.MsgStoreCodesAdminResponse{
+		CodeIDs:   codeIDs,
+		Checksums: checksums,
+	}, nil
+}
+
+// StoreAndInstantiateAdmin stores a new wasm code on chain and instantiates a
+// contract from it
+func (m msgServer) StoreAndInstantiateAdmin(ctx context.Context, msg *types.MsgStoreAndInstantiateAdmin) (*types.MsgStoreAndInstantiateAdminResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	var adminAddr sdk.AccAddress
+	if msg.Admin != "" {
+		if adminAddr, err = sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return nil, errorsmod.Wrap(err, "invalid admin address")
+		}
+	}
+
+	codeID, checksum, err := m.storeAdminCode(ctx, senderAddr, msg.Authority, msg.WASMByteCode, msg.InstantiatePermission.ToWasmAccessConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	govPermissionKeeper := wasmkeeper.NewGovPermissionKeeper(m.wasmKeeper)
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	codeID, checksum, err := govPermissionKeeper.Create(sdkCtx, senderAddr, msg.WASMByteCode, msg.InstantiatePermission.ToWasmAccessConfig())
+	contractAddr, data, err := govPermissionKeeper.Instantiate(sdkCtx, codeID, senderAddr, adminAddr, msg.Msg, msg.Label, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgStoreCodeAdminResponse{
+	return &types.MsgStoreAndInstantiateAdminResponse{
 		CodeID:   codeID,
 		Checksum: checksum,
+		Address:  contractAddr.String(),
+		Data:     data,
+	}, nil
+}
+
+// UpdateCodeAccessConfigAdmin updates the instantiate permission of a code
+func (m msgServer) UpdateCodeAccessConfigAdmin(ctx context.Context, msg *types.MsgUpdateCodeAccessConfigAdmin) (*types.MsgUpdateCodeAccessConfigAdminResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	authorityAddr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	govPermissionKeeper := wasmkeeper.NewGovPermissionKeeper(m.wasmKeeper)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := govPermissionKeeper.SetAccessConfig(sdkCtx, msg.CodeID, authorityAddr, *msg.NewInstantiatePermission.ToWasmAccessConfig()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateCodeAccessConfigAdminResponse{}, nil
+}
+
+// MigrateContractAdmin migrates a contract to a new code version regardless
+// of the contract admin
+func (m msgServer) MigrateContractAdmin(ctx context.Context, msg *types.MsgMigrateContractAdmin) (*types.MsgMigrateContractAdminResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	authorityAddr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	govPermissionKeeper := wasmkeeper.NewGovPermissionKeeper(m.wasmKeeper)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	data, err := govPermissionKeeper.Migrate(sdkCtx, contractAddr, authorityAddr, msg.CodeID, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateContractAdminResponse{
+		Data: data,
+	}, nil
+}
+
+// SetContractAdminAdmin sets or clears the admin of a contract regardless of
+// the current contract admin
+func (m msgServer) SetContractAdminAdmin(ctx context.Context, msg *types.MsgSetContractAdminAdmin) (*types.MsgSetContractAdminAdminResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	authorityAddr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	var newAdminAddr sdk.AccAddress
+	if msg.NewAdmin != "" {
+		if newAdminAddr, err = sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+			return nil, errorsmod.Wrap(err, "invalid new admin address")
+		}
+	}
+
+	govPermissionKeeper := wasmkeeper.NewGovPermissionKeeper(m.wasmKeeper)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if newAdminAddr == nil {
+		err = govPermissionKeeper.ClearContractAdmin(sdkCtx, contractAddr, authorityAddr)
+	} else {
+		err = govPermissionKeeper.UpdateContractAdmin(sdkCtx, contractAddr, authorityAddr, newAdminAddr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetContractAdminAdminResponse{}, nil
+}
+
+// RegisterChecksums registers code checksums which are allowed to be stored
+// with admin permission
+func (m msgServer) RegisterChecksums(ctx context.Context, msg *types.MsgRegisterChecksums) (*types.MsgRegisterChecksumsResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.RegisterChecksums(ctx, msg.Checksums); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterChecksumsResponse{}, nil
+}
+
+// UnregisterChecksums removes code checksums from the registry
+func (m msgServer) UnregisterChecksums(ctx context.Context, msg *types.MsgUnregisterChecksums) (*types.MsgUnregisterChecksumsResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.UnregisterChecksums(ctx, msg.Checksums); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnregisterChecksumsResponse{}, nil
+}
+
+// UpdateParams updates the module parameters
+func (m msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// make sure all the accepted queries can be answered
+	if err := m.validateAcceptedStargateQueries(msg.Params.AcceptedStargateQueries); err != nil {
+		return nil, err
+	}
+
+	if err := m.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// AddAcceptedStargateMsgs adds msg type urls to the stargate msg allowlist
+func (m msgServer) AddAcceptedStargateMsgs(ctx context.Context, msg *types.MsgAddAcceptedStargateMsgs) (*types.MsgAddAcceptedStargateMsgsResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.AddAcceptedStargateMsgs(ctx, msg.TypeURLs); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddAcceptedStargateMsgsResponse{}, nil
+}
+
+// RemoveAcceptedStargateMsgs removes msg type urls from the stargate msg allowlist
+func (m msgServer) RemoveAcceptedStargateMsgs(ctx context.Context, msg *types.MsgRemoveAcceptedStargateMsgs) (*types.MsgRemoveAcceptedStargateMsgsResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.RemoveAcceptedStargateMsgs(ctx, msg.TypeURLs); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAcceptedStargateMsgsResponse{}, nil
+}
+
+// RetryFailedCallback retries a failed ibc lifecycle callback
+func (m msgServer) RetryFailedCallback(ctx context.Context, msg *types.MsgRetryFailedCallback) (*types.MsgRetryFailedCallbackResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	success, dropped, err := m.Keeper.RetryFailedCallback(ctx, msg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetryFailedCallbackResponse{
+		Success: success,
+		Dropped: dropped,
 	}, nil
 }
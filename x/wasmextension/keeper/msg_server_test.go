@@ -3,12 +3,16 @@ package keeper_test
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	minitiaapp "github.com/initia-labs/miniwasm/app"
 	wasmextensionkeeper "github.com/initia-labs/miniwasm/x/wasmextension/keeper"
 	wasmextensiontypes "github.com/initia-labs/miniwasm/x/wasmextension/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
@@ -20,9 +24,19 @@ func TestMsgServer_StoreCodeAdmin(t *testing.T) {
 	code, err := os.ReadFile("../../../app/ibc-hooks/contracts/artifacts/counter-aarch64.wasm")
 	require.NoError(t, err)
 
-	authority := input.OPChildKeeper.GetAuthority()
+	authority := input.WasmExtensionKeeper.GetAuthority()
+
+	wasmMsgServer := wasmextensionkeeper.NewMsgServerImpl(input.WasmExtensionKeeper)
+
+	// unregistered checksum
+	_, err = wasmMsgServer.StoreCodeAdmin(ctx, &wasmextensiontypes.MsgStoreCodeAdmin{
+		Authority:    authority,
+		Creator:      addr2.String(),
+		WASMByteCode: code,
+	})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrChecksumNotRegistered)
 
-	wasmMsgServer := wasmextensionkeeper.NewMsgServerImpl(&input.WasmKeeper, authority)
+	registerChecksum(t, ctx, input.WasmExtensionKeeper, code)
 
 	// invalid authority
 	_, err = wasmMsgServer.StoreCodeAdmin(ctx, &wasmextensiontypes.MsgStoreCodeAdmin{
@@ -60,3 +74,481 @@ func TestMsgServer_StoreCodeAdmin(t *testing.T) {
 	require.NotEmpty(t, storeRes.CodeID)
 	require.NotEmpty(t, storeRes.Checksum)
 }
+
+func TestMsgServer_StoreCodesAdmin(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+	_, _, addr2 := keyPubAddr()
+
+	code, err := os.ReadFile("../../../app/ibc-hooks/contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	authority := input.WasmExtensionKeeper.GetAuthority()
+	registerChecksum(t, ctx, input.WasmExtensionKeeper, code)
+	wasmMsgServer := wasmextensionkeeper.NewMsgServerImpl(input.WasmExtensionKeeper)
+
+	// invalid authority
+	_, err = wasmMsgServer.StoreCodesAdmin(ctx, &wasmextensiontypes.MsgStoreCodesAdmin{
+		Authority: addr.String(),
+		Creator:   addr2.String(),
+		Codes:     []wasmextensiontypes.WasmCode{{WASMByteCode: code}},
+	})
+	require.Error(t, err)
+
+	// empty codes
+	_, err = wasmMsgServer.StoreCodesAdmin(ctx, &wasmextensiontypes.MsgStoreCodesAdmin{
+		Authority: authority,
+		Creator:   addr2.String(),
+	})
+	require.Error(t, err)
+
+	// invalid code in the batch
+	_, err = wasmMsgServer.StoreCodesAdmin(ctx, &wasmextensiontypes.MsgStoreCodesAdmin{
+		Authority: authority,
+		Creator:   addr2.String(),
+		Codes: []wasmextensiontypes.WasmCode{
+			{WASMByteCode: code},
+			{WASMByteCode: []byte("invalid code")},
+		},
+	})
+	require.Error(t, err)
+
+	// valid codes
+	storeRes, err := wasmMsgServer.StoreCodesAdmin(ctx, &wasmextensiontypes.MsgStoreCodesAdmin{
+		Authority: authority,
+		Creator:   addr2.String(),
+		Codes: []wasmextensiontypes.WasmCode{
+			{WASMByteCode: code},
+			{
+				WASMByteCode: code,
+				InstantiatePermission: &wasmextensiontypes.AccessConfig{
+					Permission: wasmtypes.AccessTypeNobody,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, storeRes.CodeIDs, 2)
+	require.Len(t, storeRes.Checksums, 2)
+	require.NotEqual(t, storeRes.CodeIDs[0], storeRes.CodeIDs[1])
+	require.Equal(t, storeRes.Checksums[0], storeRes.Checksums[1])
+
+	codeInfo := input.WasmKeeper.GetCodeInfo(ctx, storeRes.CodeIDs[1])
+	require.NotNil(t, codeInfo)
+	require.Equal(t, wasmtypes.AccessTypeNobody, codeInfo.InstantiateConfig.Permission)
+}
+
+func TestMsgServer_StoreAndInstantiateAdmin(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+	_, _, addr2 := keyPubAddr()
+
+	code, err := os.ReadFile("../../../app/ibc-hooks/contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	authority := input.WasmExtensionKeeper.GetAuthority()
+	registerChecksum(t, ctx, input.WasmExtensionKeeper, code)
+	wasmMsgServer := wasmextensionkeeper.NewMsgServerImpl(input.WasmExtensionKeeper)
+
+	// invalid authority
+	_, err = wasmMsgServer.StoreAndInstantiateAdmin(ctx, &wasmextensiontypes.MsgStoreAndInstantiateAdmin{
+		Authority:    addr.String(),
+		Creator:      addr2.String(),
+		WASMByteCode: code,
+		Label:        "Counter",
+		Msg:          []byte("{}"),
+	})
+	require.Error(t, err)
+
+	// empty label
+	_, err = wasmMsgServer.StoreAndInstantiateAdmin(ctx, &wasmextensiontypes.MsgStoreAndInstantiateAdmin{
+		Authority:    authority,
+		Creator:      addr2.String(),
+		WASMByteCode: code,
+		Msg:          []byte("{}"),
+	})
+	require.Error(t, err)
+
+	// invalid msg
+	_, err = wasmMsgServer.StoreAndInstantiateAdmin(ctx, &wasmextensiontypes.MsgStoreAndInstantiateAdmin{
+		Authority:    authority,
+		Creator:      addr2.String(),
+		WASMByteCode: code,
+		Label:        "Counter",
+		Msg:          []byte("invalid json"),
+	})
+	require.Error(t, err)
+
+	// store and instantiate even if nobody is allowed to instantiate the code
+	res, err := wasmMsgServer.StoreAndInstantiateAdmin(ctx, &wasmextensiontypes.MsgStoreAndInstantiateAdmin{
+		Authority:    authority,
+		Creator:      addr2.String(),
+		WASMByteCode: code,
+		InstantiatePermission: &wasmextensiontypes.AccessConfig{
+			Permission: wasmtypes.AccessTypeNobody,
+		},
+		Admin: addr.String(),
+		Label: "Counter",
+		Msg:   []byte("{}"),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.CodeID)
+	require.NotEmpty(t, res.Checksum)
+
+	contractAddr, err := sdk.AccAddressFromBech32(res.Address)
+	require.NoError(t, err)
+
+	contractInfo := input.WasmKeeper.GetContractInfo(ctx, contractAddr)
+	require.NotNil(t, contractInfo)
+	require.Equal(t, res.CodeID, contractInfo.CodeID)
+	require.Equal(t, addr2.String(), contractInfo.Creator)
+	require.Equal(t, addr.String(), contractInfo.Admin)
+	require.Equal(t, "Counter", contractInfo.Label)
+}
+
+func TestMsgServer_UpdateCodeAccessConfigAdmin(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+	_, _, addr2 := keyPubAddr()
+
+	code, err := os.ReadFile("../../../app/ibc-hooks/contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	authority := input.WasmExtensionKeeper.GetAuthority()
+	registerChecksum(t, ctx, input.WasmExtensionKeeper, code)
+	wasmMsgServer := wasmextensionkeeper.NewMsgServerImpl(input.WasmExtensionKeeper)
+
+	storeRes, err := wasmMsgServer.StoreCodeAdmin(ctx, &wasmextensiontypes.MsgStoreCodeAdmin{
+		Authority:    authority,
+		Creator:      addr2.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	newConfig := &wasmextensiontypes.AccessConfig{
+		Permission: wasmtypes.AccessTypeAnyOfAddresses,
+		Addresses:  []string{addr.String()},
+	}
+
+	// invalid authority
+	_, err = wasmMsgServer.UpdateCodeAccessConfigAdmin(ctx, &wasmextensiontypes.MsgUpdateCodeAccessConfigAdmin{
+		Authority:                addr.String(),
+		CodeID:                   storeRes.CodeID,
+		NewInstantiatePermission: newConfig,
+	})
+	require.Error(t, err)
+
+	// missing permission
+	_, err = wasmMsgServer.UpdateCodeAccessConfigAdmin(ctx, &wasmextensiontypes.MsgUpdateCodeAccessConfigAdmin{
+		Authority: authority,
+		CodeID:    storeRes.CodeID,
+	})
+	require.Error(t, err)
+
+	// unknown code
+	_, err = wasmMsgServer.UpdateCodeAccessConfigAdmin(ctx, &wasmextensiontypes.MsgUpdateCodeAccessConfigAdmin{
+		Authority:                authority,
+		CodeID:                   storeRes.CodeID + 1,
+		NewInstantiatePermission: newConfig,
+	})
+	require.Error(t, err)
+
+	// valid update
+	_, err = wasmMsgServer.UpdateCodeAccessConfigAdmin(ctx, &wasmextensiontypes.MsgUpdateCodeAccessConfigAdmin{
+		Authority:                authority,
+		CodeID:                   storeRes.CodeID,
+		NewInstantiatePermission: newConfig,
+	})
+	require.NoError(t, err)
+
+	codeInfo := input.WasmKeeper.GetCodeInfo(ctx, storeRes.CodeID)
+	require.NotNil(t, codeInfo)
+	require.Equal(t, wasmtypes.AccessTypeAnyOfAddresses, codeInfo.InstantiateConfig.Permission)
+	require.Equal(t, []string{addr.String()}, codeInfo.InstantiateConfig.Addresses)
+}
+
+func TestMsgServer_MigrateContractAdmin(t *testing.T) {
+	// ics721 requires an ibc port, so run it against the full app
+	app := minitiaapp.SetupWithGenesisAccounts(t.TempDir(), nil, nil)
+	ctx := app.NewContext(true).WithBlockTime(time.Now())
+	_, _, addr := keyPubAddr()
+	_, _, addr2 := keyPubAddr()
+
+	code, err := os.ReadFile("../../../contrib/wasm/ics721_base.wasm")
+	require.NoError(t, err)
+
+	authority := app.WasmExtensionKeeper.GetAuthority()
+	registerChecksum(t, ctx, app.WasmExtensionKeeper, code)
+	wasmMsgServer := wasmextensionkeeper.NewMsgServerImpl(app.WasmExtensionKeeper)
+
+	// the contract admin is addr, not the authority
+	instantiateRes, err := wasmMsgServer.StoreAndInstantiateAdmin(ctx, &wasmextensiontypes.MsgStoreAndInstantiateAdmin{
+		Authority:    authority,
+		Creator:      addr2.String(),
+		WASMByteCode: code,
+		Admin:        addr.String(),
+		Label:        "ics721",
+		Msg:          []byte(`{"cw721_base_code_id":1}`),
+	})
+	require.NoError(t, err)
+
+	storeRes, err := wasmMsgServer.StoreCodeAdmin(ctx, &wasmextensiontypes.MsgStoreCodeAdmin{
+		Authority:    authority,
+		Creator:      addr2.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	// invalid authority
+	_, err = wasmMsgServer.MigrateContractAdmin(ctx, &wasmextensiontypes.MsgMigrateContractAdmin{
+		Authority: addr.String(),
+		Contract:  instantiateRes.Address,
+		CodeID:    storeRes.CodeID,
+		Msg:       []byte(`{"with_update":{}}`),
+	})
+	require.Error(t, err)
+
+	// unknown contract
+	_, err = wasmMsgServer.MigrateContractAdmin(ctx, &wasmextensiontypes.MsgMigrateContractAdmin{
+		Authority: authority,
+		Contract:  addr2.String(),
+		CodeID:    storeRes.CodeID,
+		Msg:       []byte(`{"with_update":{}}`),
+	})
+	require.Error(t, err)
+
+	// migrate without being the contract admin
+	_, err = wasmMsgServer.MigrateContractAdmin(ctx, &wasmextensiontypes.MsgMigrateContractAdmin{
+		Authority: authority,
+		Contract:  instantiateRes.Address,
+		CodeID:    storeRes.CodeID,
+		Msg:       []byte(`{"with_update":{}}`),
+	})
+	require.NoError(t, err)
+
+	contractAddr, err := sdk.AccAddressFromBech32(instantiateRes.Address)
+	require.NoError(t, err)
+
+	contractInfo := app.WasmKeeper.GetContractInfo(ctx, contractAddr)
+	require.NotNil(t, contractInfo)
+	require.Equal(t, storeRes.CodeID, contractInfo.CodeID)
+	require.Equal(t, addr.String(), contractInfo.Admin)
+}
+
+func TestMsgServer_SetContractAdminAdmin(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+	_, _, addr2 := keyPubAddr()
+
+	code, err := os.ReadFile("../../../app/ibc-hooks/contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	authority := input.WasmExtensionKeeper.GetAuthority()
+	registerChecksum(t, ctx, input.WasmExtensionKeeper, code)
+	wasmMsgServer := wasmextensionkeeper.NewMsgServerImpl(input.WasmExtensionKeeper)
+
+	// the contract has no admin
+	instantiateRes, err := wasmMsgServer.StoreAndInstantiateAdmin(ctx, &wasmextensiontypes.MsgStoreAndInstantiateAdmin{
+		Authority:    authority,
+		Creator:      addr2.String(),
+		WASMByteCode: code,
+		Label:        "Counter",
+		Msg:          []byte("{}"),
+	})
+	require.NoError(t, err)
+
+	contractAddr, err := sdk.AccAddressFromBech32(instantiateRes.Address)
+	require.NoError(t, err)
+
+	// invalid authority
+	_, err = wasmMsgServer.SetContractAdminAdmin(ctx, &wasmextensiontypes.MsgSetContractAdminAdmin{
+		Authority: addr.String(),
+		Contract:  instantiateRes.Address,
+		NewAdmin:  addr.String(),
+	})
+	require.Error(t, err)
+
+	// invalid new admin
+	_, err = wasmMsgServer.SetContractAdminAdmin(ctx, &wasmextensiontypes.MsgSetContractAdminAdmin{
+		Authority: authority,
+		Contract:  instantiateRes.Address,
+		NewAdmin:  "invalid",
+	})
+	require.Error(t, err)
+
+	// set admin
+	_, err = wasmMsgServer.SetContractAdminAdmin(ctx, &wasmextensiontypes.MsgSetContractAdminAdmin{
+		Authority: authority,
+		Contract:  instantiateRes.Address,
+		NewAdmin:  addr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, addr.String(), input.WasmKeeper.GetContractInfo(ctx, contractAddr).Admin)
+
+	// override admin
+	_, err = wasmMsgServer.SetContractAdminAdmin(ctx, &wasmextensiontypes.MsgSetContractAdminAdmin{
+		Authority: authority,
+		Contract:  instantiateRes.Address,
+		NewAdmin:  addr2.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, addr2.String(), input.WasmKeeper.GetContractInfo(ctx, contractAddr).Admin)
+
+	// clear admin
+	_, err = wasmMsgServer.SetContractAdminAdmin(ctx, &wasmextensiontypes.MsgSetContractAdminAdmin{
+		Authority: authority,
+		Contract:  instantiateRes.Address,
+	})
+	require.NoError(t, err)
+	require.Empty(t, input.WasmKeeper.GetContractInfo(ctx, contractAddr).Admin)
+}
+
+func TestMsgServer_UpdateParams(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+
+	authority := input.WasmExtensionKeeper.GetAuthority()
+	wasmMsgServer := wasmextensionkeeper.NewMsgServerImpl(input.WasmExtensionKeeper)
+
+	params := wasmextensiontypes.Params{
+		AcceptedStargateQueries: []wasmextensiontypes.AcceptedStargateQuery{
+			{
+				Path:         "/cosmos.bank.v1beta1.Query/Balance",
+				ResponseType: "cosmos.bank.v1beta1.QueryBalanceResponse",
+			},
+		},
+	}
+
+	// invalid authority
+	_, err := wasmMsgServer.UpdateParams(ctx, &wasmextensiontypes.MsgUpdateParams{
+		Authority: addr.String(),
+		Params:    params,
+	})
+	require.Error(t, err)
+
+	// invalid path
+	_, err = wasmMsgServer.UpdateParams(ctx, &wasmextensiontypes.MsgUpdateParams{
+		Authority: authority,
+		Params: wasmextensiontypes.Params{
+			AcceptedStargateQueries: []wasmextensiontypes.AcceptedStargateQuery{
+				{Path: "cosmos.bank.v1beta1.Query/Balance", ResponseType: "cosmos.bank.v1beta1.QueryBalanceResponse"},
+			},
+		},
+	})
+	require.Error(t, err)
+
+	// unknown query method
+	_, err = wasmMsgServer.UpdateParams(ctx, &wasmextensiontypes.MsgUpdateParams{
+		Authority: authority,
+		Params: wasmextensiontypes.Params{
+			AcceptedStargateQueries: []wasmextensiontypes.AcceptedStargateQuery{
+				{Path: "/cosmos.bank.v1beta1.Query/Unknown", ResponseType: "cosmos.bank.v1beta1.QueryBalanceResponse"},
+			},
+		},
+	})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrInvalidStargateQuery)
+
+	// mismatched response type
+	_, err = wasmMsgServer.UpdateParams(ctx, &wasmextensiontypes.MsgUpdateParams{
+		Authority: authority,
+		Params: wasmextensiontypes.Params{
+			AcceptedStargateQueries: []wasmextensiontypes.AcceptedStargateQuery{
+				{Path: "/cosmos.bank.v1beta1.Query/Balance", ResponseType: "cosmos.bank.v1beta1.QueryAllBalancesResponse"},
+			},
+		},
+	})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrInvalidStargateQuery)
+
+	// query which is not module query safe
+	_, err = wasmMsgServer.UpdateParams(ctx, &wasmextensiontypes.MsgUpdateParams{
+		Authority: authority,
+		Params: wasmextensiontypes.Params{
+			AcceptedStargateQueries: []wasmextensiontypes.AcceptedStargateQuery{
+				{Path: "/cosmos.tx.v1beta1.Service/GetTx", ResponseType: "cosmos.tx.v1beta1.GetTxResponse"},
+			},
+		},
+	})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrInvalidStargateQuery)
+	require.ErrorContains(t, err, "not module query safe")
+
+	// duplicated path
+	_, err = wasmMsgServer.UpdateParams(ctx, &wasmextensiontypes.MsgUpdateParams{
+		Authority: authority,
+		Params: wasmextensiontypes.Params{
+			AcceptedStargateQueries: append(params.AcceptedStargateQueries, params.AcceptedStargateQueries...),
+		},
+	})
+	require.Error(t, err)
+
+	// valid params
+	_, err = wasmMsgServer.UpdateParams(ctx, &wasmextensiontypes.MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	res, err := input.WasmExtensionKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, res)
+}
+
+func TestMsgServer_AddRemoveAcceptedStargateMsgs(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+
+	authority := input.WasmExtensionKeeper.GetAuthority()
+	wasmMsgServer := wasmextensionkeeper.NewMsgServerImpl(input.WasmExtensionKeeper)
+	querier := wasmextensionkeeper.Querier{Keeper: input.WasmExtensionKeeper}
+
+	msgSend := "/cosmos.bank.v1beta1.MsgSend"
+	msgMultiSend := "/cosmos.bank.v1beta1.MsgMultiSend"
+
+	// invalid authority
+	_, err := wasmMsgServer.AddAcceptedStargateMsgs(ctx, &wasmextensiontypes.MsgAddAcceptedStargateMsgs{
+		Authority: addr.String(),
+		TypeURLs:  []string{msgSend},
+	})
+	require.Error(t, err)
+
+	// unknown type url
+	_, err = wasmMsgServer.AddAcceptedStargateMsgs(ctx, &wasmextensiontypes.MsgAddAcceptedStargateMsgs{
+		Authority: authority,
+		TypeURLs:  []string{"/cosmos.bank.v1beta1.MsgUnknown"},
+	})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrInvalidStargateMsg)
+
+	// not a msg
+	_, err = wasmMsgServer.AddAcceptedStargateMsgs(ctx, &wasmextensiontypes.MsgAddAcceptedStargateMsgs{
+		Authority: authority,
+		TypeURLs:  []string{"/cosmos.auth.v1beta1.BaseAccount"},
+	})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrInvalidStargateMsg)
+
+	// valid type urls
+	_, err = wasmMsgServer.AddAcceptedStargateMsgs(ctx, &wasmextensiontypes.MsgAddAcceptedStargateMsgs{
+		Authority: authority,
+		TypeURLs:  []string{msgSend, msgMultiSend},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.AcceptedStargateMsgs(ctx, &wasmextensiontypes.QueryAcceptedStargateMsgsRequest{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{msgSend, msgMultiSend}, res.TypeURLs)
+
+	// remove unknown type url
+	_, err = wasmMsgServer.RemoveAcceptedStargateMsgs(ctx, &wasmextensiontypes.MsgRemoveAcceptedStargateMsgs{
+		Authority: authority,
+		TypeURLs:  []string{"/cosmos.bank.v1beta1.MsgUnknown"},
+	})
+	require.ErrorIs(t, err, wasmextensiontypes.ErrInvalidStargateMsg)
+
+	_, err = wasmMsgServer.RemoveAcceptedStargateMsgs(ctx, &wasmextensiontypes.MsgRemoveAcceptedStargateMsgs{
+		Authority: authority,
+		TypeURLs:  []string{msgMultiSend},
+	})
+	require.NoError(t, err)
+
+	res, err = querier.AcceptedStargateMsgs(ctx, &wasmextensiontypes.QueryAcceptedStargateMsgsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{msgSend}, res.TypeURLs)
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"fmt"
+	"reflect"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	"github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+// StargateQuerier returns a stargate querier which accepts the queries in the
+// given accept list and the ones registered in the module params.
+func (k Keeper) StargateQuerier(
+	acceptList wasmkeeper.AcceptedQueries,
+	queryRouter wasmkeeper.GRPCQueryRouter,
+	cdc codec.Codec,
+) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+	acceptListQuerier := wasmkeeper.AcceptListStargateQuerier(acceptList, queryRouter, cdc)
+
+	return func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+		if _, accepted := acceptList[request.Path]; accepted {
+			return acceptListQuerier(ctx, request)
+		}
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, query := range params.AcceptedStargateQueries {
+			if query.Path != request.Path {
+				continue
+			}
+
+			protoResponseFn, err := k.resolveAcceptedStargateQuery(query)
+			if err != nil {
+				return nil, err
+			}
+
+			return wasmkeeper.AcceptListStargateQuerier(
+				wasmkeeper.AcceptedQueries{query.Path: protoResponseFn}, queryRouter, cdc,
+			)(ctx, request)
+		}
+
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", request.Path)}
+	}
+}
+
+// validateAcceptedStargateQueries checks that all the given queries can be
+// resolved from the interface registry.
+func (k Keeper) validateAcceptedStargateQueries(queries []types.AcceptedStargateQuery) error {
+	for _, query := range queries {
+		if _, err := k.resolveAcceptedStargateQuery(query); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveAcceptedStargateQuery resolves the response type of the query from the
+// interface registry and checks that it is the output of the queried grpc method.
+func (k Keeper) resolveAcceptedStargateQuery(query types.AcceptedStargateQuery) (func() proto.Message, error) {
+	serviceName, methodName, err := types.SplitQueryPath(query.Path)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidStargateQuery, err.Error())
+	}
+
+	desc, err := k.cdc.InterfaceRegistry().FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidStargateQuery, "unknown query service %s", serviceName)
+	}
+
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidStargateQuery, "%s is not a query service", serviceName)
+	}
+
+	methodDesc := serviceDesc.Methods().ByName(protoreflect.Name(methodName))
+	if methodDesc == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidStargateQuery, "unknown query method %s", query.Path)
+	}
+
+	if outputName := methodDesc.Output().FullName(); string(outputName) != query.ResponseType {
+		return nil, errorsmod.Wrapf(types.ErrInvalidStargateQuery, "response type of %s is %s, got %s", query.Path, outputName, query.ResponseType)
+	}
+
+	responseType := proto.MessageType(query.ResponseType)
+	if responseType == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidStargateQuery, "unregistered response type %s", query.ResponseType)
+	}
+
+	return func() proto.Message {
+		return reflect.New(responseType.Elem()).Interface().(proto.Message)
+	}, nil
+}
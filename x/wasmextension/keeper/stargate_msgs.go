@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	"github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+// StargateMsgDecorator returns a decorator which rejects stargate messages
+// whose type url, or the type url of any msg nested in it, is not in the
+// accepted stargate msg allowlist. All the other messages are forwarded to the
+// wrapped messenger.
+func (k Keeper) StargateMsgDecorator() func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return wasmkeeper.MessageHandlerFunc(func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+			if msg.Any != nil {
+				if err := k.checkAcceptedStargateMsg(ctx, &codectypes.Any{TypeUrl: msg.Any.TypeURL, Value: msg.Any.Value}); err != nil {
+					return nil, nil, nil, err
+				}
+			}
+
+			return old.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+		})
+	}
+}
+
+// checkAcceptedStargateMsg checks that the msg and all the msgs nested in it,
+// e.g. the msgs of an authz MsgExec, are in the accepted stargate msg allowlist.
+func (k Keeper) checkAcceptedStargateMsg(ctx context.Context, msgAny *codectypes.Any) error {
+	accepted, err := k.IsAcceptedStargateMsg(ctx, msgAny.TypeUrl)
+	if err != nil {
+		return err
+	} else if !accepted {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "'%s' msg is not allowed from the contract", msgAny.TypeUrl)
+	}
+
+	var msg sdk.Msg
+	if err := k.cdc.InterfaceRegistry().UnpackAny(msgAny, &msg); err != nil {
+		return err
+	}
+
+	return codectypes.UnpackInterfaces(msg, nestedStargateMsgChecker{ctx: ctx, keeper: k})
+}
+
+// nestedStargateMsgChecker is an any unpacker which checks the msgs nested in a
+// stargate message against the accepted stargate msg allowlist.
+type nestedStargateMsgChecker struct {
+	ctx    context.Context
+	keeper Keeper
+}
+
+// UnpackAny implements codectypes.AnyUnpacker.
+func (c nestedStargateMsgChecker) UnpackAny(msgAny *codectypes.Any, iface interface{}) error {
+	if msgAny == nil {
+		return nil
+	}
+
+	if err := c.keeper.cdc.InterfaceRegistry().UnpackAny(msgAny, iface); err != nil {
+		return err
+	}
+
+	// the nested values which are not msgs, e.g. an authz authorization, can
+	// still carry msgs
+	if _, ok := msgAny.GetCachedValue().(sdk.Msg); !ok {
+		return codectypes.UnpackInterfaces(msgAny.GetCachedValue(), c)
+	}
+
+	return c.keeper.checkAcceptedStargateMsg(c.ctx, msgAny)
+}
+
+// IsAcceptedStargateMsg returns true if contracts are allowed to dispatch the
+// msg type url as a stargate message.
+func (k Keeper) IsAcceptedStargateMsg(ctx context.Context, typeURL string) (bool, error) {
+	return k.AcceptedStargateMsgs.Has(ctx, typeURL)
+}
+
+// AddAcceptedStargateMsgs adds the msg type urls to the stargate msg allowlist.
+func (k Keeper) AddAcceptedStargateMsgs(ctx context.Context, typeURLs []string) error {
+	for _, typeURL := range typeURLs {
+		if err := k.validateStargateMsgTypeURL(typeURL); err != nil {
+			return err
+		}
+
+		if err := k.AcceptedStargateMsgs.Set(ctx, typeURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveAcceptedStargateMsgs removes the msg type urls from the stargate msg
+// allowlist.
+func (k Keeper) RemoveAcceptedStargateMsgs(ctx context.Context, typeURLs []string) error {
+	for _, typeURL := range typeURLs {
+		if found, err := k.AcceptedStargateMsgs.Has(ctx, typeURL); err != nil {
+			return err
+		} else if !found {
+			return errorsmod.Wrapf(types.ErrInvalidStargateMsg, "%s is not an accepted msg", typeURL)
+		}
+
+		if err := k.AcceptedStargateMsgs.Remove(ctx, typeURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateStargateMsgTypeURL checks that the type url is registered as a msg
+// in the interface registry.
+func (k Keeper) validateStargateMsgTypeURL(typeURL string) error {
+	if !slices.Contains(k.cdc.InterfaceRegistry().ListImplementations(sdk.MsgInterfaceProtoName), typeURL) {
+		return errorsmod.Wrapf(types.ErrInvalidStargateMsg, "%s is not a registered msg", typeURL)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	opchildtypes "github.com/initia-labs/OPinit/x/opchild/types"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	wasmextensiontypes "github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+type mockMessenger struct {
+	called bool
+}
+
+func (m *mockMessenger) DispatchMsg(_ sdk.Context, _ sdk.AccAddress, _ string, _ wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	m.called = true
+	return nil, nil, nil, nil
+}
+
+func TestStargateMsgDecorator(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, contractAddr := keyPubAddr()
+
+	wrapped := &mockMessenger{}
+	messenger := input.WasmExtensionKeeper.StargateMsgDecorator()(wrapped)
+
+	stargateMsg := wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{TypeURL: "/cosmos.bank.v1beta1.MsgSend"}}
+
+	// not accepted
+	_, _, _, err := messenger.DispatchMsg(ctx, contractAddr, "", stargateMsg)
+	require.ErrorIs(t, err, wasmextensiontypes.ErrUnauthorized)
+	require.False(t, wrapped.called)
+
+	// non stargate messages are not gated
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", wasmvmtypes.CosmosMsg{
+		Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: contractAddr.String()}},
+	})
+	require.NoError(t, err)
+	require.True(t, wrapped.called)
+
+	// accepted
+	wrapped.called = false
+	require.NoError(t, input.WasmExtensionKeeper.AddAcceptedStargateMsgs(ctx, []string{"/cosmos.bank.v1beta1.MsgSend"}))
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", stargateMsg)
+	require.NoError(t, err)
+	require.True(t, wrapped.called)
+
+	// the msgs nested in an accepted msg are checked as well
+	sendMsg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{FromAddress: contractAddr.String(), ToAddress: contractAddr.String()})
+	require.NoError(t, err)
+	executeMsgs := &opchildtypes.MsgExecuteMessages{Sender: contractAddr.String(), Messages: []*codectypes.Any{sendMsg}}
+	executeMsgsBz, err := input.EncodingConfig.Codec.Marshal(executeMsgs)
+	require.NoError(t, err)
+	wrapperMsg := wasmvmtypes.CosmosMsg{Any: &wasmvmtypes.AnyMsg{TypeURL: "/opinit.opchild.v1.MsgExecuteMessages", Value: executeMsgsBz}}
+	require.NoError(t, input.WasmExtensionKeeper.AddAcceptedStargateMsgs(ctx, []string{"/opinit.opchild.v1.MsgExecuteMessages"}))
+
+	wrapped.called = false
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", wrapperMsg)
+	require.NoError(t, err)
+	require.True(t, wrapped.called)
+
+	// removed
+	wrapped.called = false
+	require.NoError(t, input.WasmExtensionKeeper.RemoveAcceptedStargateMsgs(ctx, []string{"/cosmos.bank.v1beta1.MsgSend"}))
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", stargateMsg)
+	require.ErrorIs(t, err, wasmextensiontypes.ErrUnauthorized)
+	require.False(t, wrapped.called)
+
+	_, _, _, err = messenger.DispatchMsg(ctx, contractAddr, "", wrapperMsg)
+	require.ErrorIs(t, err, wasmextensiontypes.ErrUnauthorized)
+	require.False(t, wrapped.called)
+}
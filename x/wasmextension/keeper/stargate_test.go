@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	wasmextensiontypes "github.com/initia-labs/miniwasm/x/wasmextension/types"
+)
+
+func TestStargateQuerier(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	addr := input.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin(bondDenom, 100))
+
+	queryRouter := baseapp.NewGRPCQueryRouter()
+	queryRouter.SetInterfaceRegistry(input.EncodingConfig.InterfaceRegistry)
+	banktypes.RegisterQueryServer(queryRouter, input.BankKeeper)
+
+	acceptList := wasmkeeper.AcceptedQueries{
+		"/cosmos.bank.v1beta1.Query/Params": func() proto.Message { return &banktypes.QueryParamsResponse{} },
+	}
+	querier := input.WasmExtensionKeeper.StargateQuerier(acceptList, queryRouter, input.EncodingConfig.Codec)
+
+	balanceReq, err := input.EncodingConfig.Codec.Marshal(&banktypes.QueryBalanceRequest{Address: addr.String(), Denom: bondDenom})
+	require.NoError(t, err)
+	balanceQuery := &wasmvmtypes.StargateQuery{Path: "/cosmos.bank.v1beta1.Query/Balance", Data: balanceReq}
+
+	paramsReq, err := input.EncodingConfig.Codec.Marshal(&banktypes.QueryParamsRequest{})
+	require.NoError(t, err)
+
+	// statically accepted query
+	_, err = querier(ctx, &wasmvmtypes.StargateQuery{Path: "/cosmos.bank.v1beta1.Query/Params", Data: paramsReq})
+	require.NoError(t, err)
+
+	// not yet accepted
+	_, err = querier(ctx, balanceQuery)
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+
+	// accept the balance query through params
+	require.NoError(t, input.WasmExtensionKeeper.SetParams(ctx, wasmextensiontypes.Params{
+		AcceptedStargateQueries: []wasmextensiontypes.AcceptedStargateQuery{
+			{
+				Path:         "/cosmos.bank.v1beta1.Query/Balance",
+				ResponseType: "cosmos.bank.v1beta1.QueryBalanceResponse",
+			},
+		},
+	}))
+
+	res, err := querier(ctx, balanceQuery)
+	require.NoError(t, err)
+
+	var balanceRes banktypes.QueryBalanceResponse
+	require.NoError(t, input.EncodingConfig.Codec.UnmarshalJSON(res, &balanceRes))
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 100), *balanceRes.Balance)
+
+	// the response is the json encoding of the response type
+	var jsonRes map[string]any
+	require.NoError(t, json.Unmarshal(res, &jsonRes))
+	require.Contains(t, jsonRes, "balance")
+}
@@ -10,6 +10,17 @@ import (
 // RegisterLegacyAminoCodec registers the concrete types and interface
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgStoreCodeAdmin{}, "wasmextension/MsgStoreCodeAdmin", nil)
+	cdc.RegisterConcrete(&MsgStoreCodesAdmin{}, "wasmextension/MsgStoreCodesAdmin", nil)
+	cdc.RegisterConcrete(&MsgStoreAndInstantiateAdmin{}, "wasmextension/MsgStoreAndInstantiateAdmin", nil)
+	cdc.RegisterConcrete(&MsgUpdateCodeAccessConfigAdmin{}, "wasmextension/MsgUpdateCodeAccessConfigAdmin", nil)
+	cdc.RegisterConcrete(&MsgMigrateContractAdmin{}, "wasmextension/MsgMigrateContractAdmin", nil)
+	cdc.RegisterConcrete(&MsgSetContractAdminAdmin{}, "wasmextension/MsgSetContractAdminAdmin", nil)
+	cdc.RegisterConcrete(&MsgRegisterChecksums{}, "wasmextension/MsgRegisterChecksums", nil)
+	cdc.RegisterConcrete(&MsgUnregisterChecksums{}, "wasmextension/MsgUnregisterChecksums", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "wasmextension/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgAddAcceptedStargateMsgs{}, "wasmextension/MsgAddAcceptedStargateMsgs", nil)
+	cdc.RegisterConcrete(&MsgRemoveAcceptedStargateMsgs{}, "wasmextension/MsgRemoveAcceptedStargateMsgs", nil)
+	cdc.RegisterConcrete(&MsgRetryFailedCallback{}, "wasmextension/MsgRetryFailedCallback", nil)
 }
 
 // RegisterInterfaces registers the concrete proto types and interfaces with the SDK interface registry
@@ -17,6 +28,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgStoreCodeAdmin{},
+		&MsgStoreCodesAdmin{},
+		&MsgStoreAndInstantiateAdmin{},
+		&MsgUpdateCodeAccessConfigAdmin{},
+		&MsgMigrateContractAdmin{},
+		&MsgSetContractAdminAdmin{},
+		&MsgRegisterChecksums{},
+		&MsgUnregisterChecksums{},
+		&MsgUpdateParams{},
+		&MsgAddAcceptedStargateMsgs{},
+		&MsgRemoveAcceptedStargateMsgs{},
+		&MsgRetryFailedCallback{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -12,4 +12,22 @@ var (
 
 	// ErrCreateFailed error for wasm code that has already been uploaded or failed
 	ErrUnauthorized = errorsmod.Register(DefaultCodespace, 2, "unauthorized")
+
+	// ErrInvalidStargateQuery error for a stargate query which cannot be accepted
+	ErrInvalidStargateQuery = errorsmod.Register(DefaultCodespace, 3, "invalid stargate query")
+
+	// ErrInvalidStargateMsg error for a stargate msg type url which cannot be accepted
+	ErrInvalidStargateMsg = errorsmod.Register(DefaultCodespace, 4, "invalid stargate msg")
+
+	// ErrChecksumNotRegistered error for a code whose checksum is not in the registry
+	ErrChecksumNotRegistered = errorsmod.Register(DefaultCodespace, 5, "checksum not registered")
+
+	// ErrInvalidChecksum error for a malformed code checksum registration
+	ErrInvalidChecksum = errorsmod.Register(DefaultCodespace, 6, "invalid checksum")
+
+	// ErrFailedCallbackNotFound error for a failed callback which is not in the retry queue
+	ErrFailedCallbackNotFound = errorsmod.Register(DefaultCodespace, 7, "failed callback not found")
+
+	// ErrFailedCallbackRetryTooEarly error for a retry of a failed callback before its retry interval has passed
+	ErrFailedCallbackRetryTooEarly = errorsmod.Register(DefaultCodespace, 8, "failed callback retry too early")
 )
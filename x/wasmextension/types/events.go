@@ -0,0 +1,14 @@
+package types
+
+// wasmextension module event types
+const (
+	EventTypeFailedCallbackQueued  = "failed_callback_queued"
+	EventTypeFailedCallbackRetried = "failed_callback_retried"
+	EventTypeFailedCallbackDropped = "failed_callback_dropped"
+
+	AttributeKeyID       = "id"
+	AttributeKeyContract = "contract"
+	AttributeKeyRetries  = "retries"
+	AttributeKeySuccess  = "success"
+	AttributeKeyError    = "error"
+)
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default wasmextension genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:               DefaultParams(),
+		AcceptedStargateMsgs: []string{},
+		AdminCodes:           []AdminCodeInfo{},
+		RegisteredChecksums:  []RegisteredChecksum{},
+		FailedCallbacks:      []FailedCallback{},
+		NextFailedCallbackID: 1,
+		ForwardRefunds:       []ForwardRefund{},
+	}
+}
+
+// defaultAcceptedStargateMsgs is the curated list of msgs contracts are
+// allowed to dispatch as stargate messages by default. Msgs wrapping other
+// msgs (e.g. authz MsgExec or ICA MsgSendTx) and the governance only msgs are
+// not in the list.
+var defaultAcceptedStargateMsgs = []string{
+	"/cosmos.bank.v1beta1.MsgSend",
+	"/cosmos.bank.v1beta1.MsgMultiSend",
+	"/cosmos.feegrant.v1beta1.MsgGrantAllowance",
+	"/cosmos.feegrant.v1beta1.MsgRevokeAllowance",
+	"/cosmwasm.wasm.v1.MsgStoreCode",
+	"/cosmwasm.wasm.v1.MsgInstantiateContract",
+	"/cosmwasm.wasm.v1.MsgInstantiateContract2",
+	"/cosmwasm.wasm.v1.MsgExecuteContract",
+	"/cosmwasm.wasm.v1.MsgMigrateContract",
+	"/cosmwasm.wasm.v1.MsgUpdateAdmin",
+	"/cosmwasm.wasm.v1.MsgClearAdmin",
+	"/ibc.applications.transfer.v1.MsgTransfer",
+	"/ibc.applications.fee.v1.MsgPayPacketFee",
+	"/ibc.applications.fee.v1.MsgPayPacketFeeAsync",
+	"/opinit.opchild.v1.MsgInitiateTokenWithdrawal",
+	"/miniwasm.tokenfactory.v1.MsgCreateDenom",
+	"/miniwasm.tokenfactory.v1.MsgMint",
+	"/miniwasm.tokenfactory.v1.MsgBurn",
+	"/miniwasm.tokenfactory.v1.MsgBurnFrom",
+	"/miniwasm.tokenfactory.v1.MsgForceTransfer",
+	"/miniwasm.tokenfactory.v1.MsgChangeAdmin",
+	"/miniwasm.tokenfactory.v1.MsgProposeAdmin",
+	"/miniwasm.tokenfactory.v1.MsgAcceptAdmin",
+	"/miniwasm.tokenfactory.v1.MsgCancelAdminTransfer",
+	"/miniwasm.tokenfactory.v1.MsgRenounceAdmin",
+	"/miniwasm.tokenfactory.v1.MsgGrantRole",
+	"/miniwasm.tokenfactory.v1.MsgRevokeRole",
+	"/miniwasm.tokenfactory.v1.MsgSetMintAllowance",
+	"/miniwasm.tokenfactory.v1.MsgSetSupplyCap",
+	"/miniwasm.tokenfactory.v1.MsgSetDenomMetadata",
+	"/miniwasm.tokenfactory.v1.MsgSetBeforeSendHook",
+	"/miniwasm.tokenfactory.v1.MsgSetBeforeSendHooks",
+	"/miniwasm.tokenfactory.v1.MsgSetDenomPaused",
+	"/miniwasm.tokenfactory.v1.MsgSetDenomStrictMode",
+	"/miniwasm.tokenfactory.v1.MsgSetAccountFrozen",
+	"/miniwasm.tokenfactory.v1.MsgDisableDenomCapabilities",
+	"/miniwasm.wasmextension.v1.MsgRetryFailedCallback",
+}
+
+// DefaultAcceptedStargateMsgs returns the sorted type urls of the default
+// accepted stargate msgs which are registered in the interface registry.
+func DefaultAcceptedStargateMsgs(registry codectypes.InterfaceRegistry) []string {
+	registered := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+
+	typeURLs := make([]string, 0, len(defaultAcceptedStargateMsgs))
+	for _, typeURL := range defaultAcceptedStargateMsgs {
+		if slices.Contains(registered, typeURL) {
+			typeURLs = append(typeURLs, typeURL)
+		}
+	}
+	slices.Sort(typeURLs)
+
+	return typeURLs
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if len(gs.AcceptedStargateMsgs) != 0 {
+		if err := validateTypeURLs(gs.AcceptedStargateMsgs); err != nil {
+			return err
+		}
+	}
+
+	seenCodeIDs := make(map[uint64]bool, len(gs.AdminCodes))
+	for _, adminCode := range gs.AdminCodes {
+		if err := adminCode.Validate(); err != nil {
+			return err
+		}
+
+		if seenCodeIDs[adminCode.CodeID] {
+			return fmt.Errorf("duplicate admin code: %d", adminCode.CodeID)
+		}
+		seenCodeIDs[adminCode.CodeID] = true
+	}
+
+	if err := validateRegisteredChecksums(gs.RegisteredChecksums); err != nil {
+		return err
+	}
+
+	seenCallbackIDs := make(map[uint64]bool, len(gs.FailedCallbacks))
+	for _, callback := range gs.FailedCallbacks {
+		if err := callback.Validate(); err != nil {
+			return err
+		}
+
+		if callback.ID >= gs.NextFailedCallbackID {
+			return fmt.Errorf("failed callback id %d is not less than the next id %d", callback.ID, gs.NextFailedCallbackID)
+		}
+
+		if seenCallbackIDs[callback.ID] {
+			return fmt.Errorf("duplicate failed callback: %d", callback.ID)
+		}
+		seenCallbackIDs[callback.ID] = true
+	}
+
+	seenForwardRefunds := make(map[string]bool, len(gs.ForwardRefunds))
+	for _, refund := range gs.ForwardRefunds {
+		if err := refund.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%s/%d", refund.PortId, refund.ChannelId, refund.Sequence)
+		if seenForwardRefunds[key] {
+			return fmt.Errorf("duplicate forward refund: %s", key)
+		}
+		seenForwardRefunds[key] = true
+	}
+
+	return nil
+}
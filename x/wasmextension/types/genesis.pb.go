@@ -0,0 +1,637 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: miniwasm/wasmextension/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the wasmextension module's genesis state.
+type GenesisState struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// accepted_stargate_msgs are the msg type urls contracts are allowed to
+	// dispatch as stargate messages.
+	AcceptedStargateMsgs []string `protobuf:"bytes,2,rep,name=accepted_stargate_msgs,json=acceptedStargateMsgs,proto3" json:"accepted_stargate_msgs,omitempty"`
+	// admin_codes are the codes stored with admin permission.
+	AdminCodes []AdminCodeInfo `protobuf:"bytes,3,rep,name=admin_codes,json=adminCodes,proto3" json:"admin_codes"`
+	// registered_checksums are the code checksums allowed to be stored with
+	// admin permission.
+	RegisteredChecksums []RegisteredChecksum `protobuf:"bytes,4,rep,name=registered_checksums,json=registeredChecksums,proto3" json:"registered_checksums"`
+	// failed_callbacks are the failed ibc lifecycle callbacks to be retried.
+	FailedCallbacks []FailedCallback `protobuf:"bytes,5,rep,name=failed_callbacks,json=failedCallbacks,proto3" json:"failed_callbacks"`
+	// next_failed_callback_id is the id of the next failed callback.
+	NextFailedCallbackID uint64 `protobuf:"varint,6,opt,name=next_failed_callback_id,json=nextFailedCallbackId,proto3" json:"next_failed_callback_id,omitempty"`
+	// forward_refunds are the refund addresses of the in-flight transfers
+	// forwarded by ibc hooks.
+	ForwardRefunds []ForwardRefund `protobuf:"bytes,7,rep,name=forward_refunds,json=forwardRefunds,proto3" json:"forward_refunds"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_474a472883cadcb4, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "miniwasm.wasmextension.v1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("miniwasm/wasmextension/v1/genesis.proto", fileDescriptor_474a472883cadcb4)
+}
+
+var fileDescriptor_474a472883cadcb4 = []byte{
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x6f, 0xd3, 0x40,
+	0x10, 0xc5, 0x6d, 0x12, 0x82, 0xd8, 0x20, 0x8a, 0x8c, 0x05, 0xa6, 0x07, 0x37, 0x20, 0x21, 0xcc,
+	0xa1, 0xb6, 0x5a, 0xe0, 0x8c, 0x48, 0x50, 0x51, 0x0f, 0xfc, 0x91, 0x73, 0x40, 0xea, 0xc5, 0xda,
+	0x78, 0xc7, 0xee, 0xaa, 0xf1, 0x6e, 0xb4, 0xb3, 0x69, 0xc3, 0xb7, 0xe0, 0x43, 0x71, 0xc8, 0xb1,
+	0x47, 0x4e, 0x15, 0x38, 0x5f, 0x04, 0x79, 0xb3, 0xa9, 0x48, 0xab, 0xa6, 0x17, 0xcb, 0x9a, 0x79,
+	0xbf, 0xf7, 0x56, 0xa3, 0x47, 0x5e, 0x55, 0x5c, 0xf0, 0x33, 0x8a, 0x55, 0xd2, 0x7c, 0x60, 0xa6,
+	0x41, 0x20, 0x97, 0x22, 0x39, 0xdd, 0x4b, 0x4a, 0x10, 0x80, 0x1c, 0xe3, 0x89, 0x92, 0x5a, 0x7a,
+	0xcf, 0x56, 0xc2, 0x78, 0x4d, 0x18, 0x9f, 0xee, 0x6d, 0xfb, 0xa5, 0x2c, 0xa5, 0x51, 0x25, 0xcd,
+	0xdf, 0x12, 0xd8, 0x7e, 0x79, 0xb3, 0xb3, 0xfe, 0x31, 0x01, 0xeb, 0xfb, 0xe2, 0x57, 0x9b, 0x3c,
+	0xf8, 0xb4, 0x4c, 0x1a, 0x6a, 0xaa, 0xc1, 0x7b, 0x4f, 0x3a, 0x13, 0xaa, 0x68, 0x85, 0x81, 0xdb,
+	0x73, 0xa3, 0xee, 0xfe, 0xf3, 0xf8, 0xc6, 0xe4, 0xf8, 0x9b, 0x11, 0xf6, 0xdb, 0xf3, 0x8b, 0x1d,
+	0x27, 0xb5, 0x98, 0xf7, 0x96, 0x3c, 0xa1, 0x79, 0x0e, 0x13, 0x0d, 0x2c, 0x43, 0x4d, 0x55, 0x49,
+	0x35, 0x64, 0x15, 0x96, 0x18, 0xdc, 0xe9, 0xb5, 0xa2, 0xfb, 0xa9, 0xbf, 0xda, 0x0e, 0xed, 0xf2,
+	0x33, 0x96, 0xe8, 0x7d, 0x25, 0x5d, 0xca, 0x2a, 0x2e, 0xb2, 0x5c, 0x32, 0xc0, 0xa0, 0xd5, 0x6b,
+	0x45, 0xdd, 0xfd, 0x68, 0x43, 0xf6, 0x87, 0x46, 0x3d, 0x90, 0x0c, 0x0e, 0x45, 0x21, 0xed, 0x13,
+	0x08, 0x5d, 0x0d, 0xd1, 0x2b, 0x88, 0xaf, 0xa0, 0xe4, 0xa8, 0x41, 0x01, 0xcb, 0xf2, 0x63, 0xc8,
+	0x4f, 0x70, 0x5a, 0x61, 0xd0, 0x36, 0xce, 0xbb, 0x1b, 0x9c, 0xd3, 0x4b, 0x6c, 0x60, 0x29, 0x6b,
+	0xff, 0x58, 0x5d, 0xdb, 0xa0, 0x77, 0x44, 0x1e, 0x15, 0x94, 0x8f, 0x9b, 0x0c, 0x3a, 0x1e, 0x8f,
+	0x68, 0x7e, 0x82, 0xc1, 0x5d, 0x93, 0xf1, 0x7a, 0x43, 0xc6, 0x81, 0x41, 0x06, 0x96, 0xb0, 0xfe,
+	0x5b, 0xc5, 0xda, 0xb4, 0x39, 0xca, 0x53, 0x01, 0x33, 0x9d, 0x5d, 0x09, 0xc8, 0x38, 0x0b, 0x3a,
+	0x3d, 0x37, 0x6a, 0xf7, 0x83, 0xfa, 0x62, 0xc7, 0xff, 0x02, 0x33, 0xbd, 0xee, 0x77, 0xf8, 0x31,
+	0xf5, 0xc5, 0xf5, 0x29, 0xf3, 0xbe, 0x93, 0xad, 0x42, 0xaa, 0x33, 0xaa, 0x58, 0xa6, 0xa0, 0x98,
+	0x0a, 0x86, 0xc1, 0xbd, 0x5b, 0x2f, 0x7d, 0xb0, 0x24, 0x52, 0x03, 0xd8, 0xa7, 0x3e, 0x2c, 0xfe,
+	0x1f, 0x62, 0x7f, 0x38, 0xff, 0x1b, 0x3a, 0xf3, 0x3a, 0x74, 0xcf, 0xeb, 0xd0, 0xfd, 0x53, 0x87,
+	0xee, 0xcf, 0x45, 0xe8, 0x9c, 0x2f, 0x42, 0xe7, 0xf7, 0x22, 0x74, 0x8e, 0xde, 0x95, 0x5c, 0x1f,
+	0x4f, 0x47, 0x71, 0x2e, 0xab, 0x84, 0x0b, 0xae, 0x39, 0xdd, 0x1d, 0xd3, 0x11, 0x26, 0x97, 0x15,
+	0x9d, 0x5d, 0x29, 0xa9, 0x69, 0xe8, 0xa8, 0x63, 0x2a, 0xfa, 0xe6, 0xdf, 0x00, 0x34, 0xee, 0x3e,
+	0x34, 0x25, 0x03, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForwardRefunds) > 0 {
+		for iNdEx := len(m.ForwardRefunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardRefunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextFailedCallbackID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextFailedCallbackID))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.FailedCallbacks) > 0 {
+		for iNdEx := len(m.FailedCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RegisteredChecksums) > 0 {
+		for iNdEx := len(m.RegisteredChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredChecksums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AdminCodes) > 0 {
+		for iNdEx := len(m.AdminCodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminCodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AcceptedStargateMsgs) > 0 {
+		for iNdEx := len(m.AcceptedStargateMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedStargateMsgs[iNdEx])
+			copy(dAtA[i:], m.AcceptedStargateMsgs[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AcceptedStargateMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AcceptedStargateMsgs) > 0 {
+		for _, s := range m.AcceptedStargateMsgs {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdminCodes) > 0 {
+		for _, e := range m.AdminCodes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegisteredChecksums) > 0 {
+		for _, e := range m.RegisteredChecksums {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedCallbacks) > 0 {
+		for _, e := range m.FailedCallbacks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextFailedCallbackID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextFailedCallbackID))
+	}
+	if len(m.ForwardRefunds) > 0 {
+		for _, e := range m.ForwardRefunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedStargateMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedStargateMsgs = append(m.AcceptedStargateMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminCodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminCodes = append(m.AdminCodes, AdminCodeInfo{})
+			if err := m.AdminCodes[len(m.AdminCodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredChecksums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredChecksums = append(m.RegisteredChecksums, RegisteredChecksum{})
+			if err := m.RegisteredChecksums[len(m.RegisteredChecksums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedCallbacks = append(m.FailedCallbacks, FailedCallback{})
+			if err := m.FailedCallbacks[len(m.FailedCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFailedCallbackID", wireType)
+			}
+			m.NextFailedCallbackID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextFailedCallbackID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRefunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardRefunds = append(m.ForwardRefunds, ForwardRefund{})
+			if err := m.ForwardRefunds[len(m.ForwardRefunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
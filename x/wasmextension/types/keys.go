@@ -4,8 +4,9 @@ const (
 	// ModuleName is the name of the contract module
 	ModuleName = "wasmextension"
 
-	// StoreKey is the string store representation
-	StoreKey = ModuleName
+	// StoreKey is the string store representation; it must not be prefixed
+	// with the wasm store key to avoid a collision between the two stores
+	StoreKey = "extwasm"
 
 	// TStoreKey is the string transient store representation
 	TStoreKey = "transient_" + ModuleName
@@ -16,3 +17,14 @@ const (
 	// RouterKey is the msg router key for the wasm module
 	RouterKey = ModuleName
 )
+
+var (
+	ParamsKeyPrefix               = []byte{0x11}
+	AcceptedStargateMsgsKeyPrefix = []byte{0x12}
+	AdminCodesKeyPrefix           = []byte{0x13}
+	RegisteredChecksumsKeyPrefix  = []byte{0x14}
+	FailedCallbacksKeyPrefix      = []byte{0x15}
+	NextFailedCallbackIDKey       = []byte{0x16}
+	FailedCallbackCountsKeyPrefix = []byte{0x17}
+	ForwardRefundsKeyPrefix       = []byte{0x18}
+)
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	queryv1 "cosmossdk.io/api/cosmos/query/v1"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+)
+
+// DefaultMaxCallbackRetries is the default number of times a failed ibc
+// lifecycle callback can be retried.
+const DefaultMaxCallbackRetries = 3
+
+// DefaultCallbackRetryInterval is the default number of blocks a failed ibc
+// lifecycle callback has to wait between retries.
+const DefaultCallbackRetryInterval = 100
+
+// DefaultMaxFailedCallbacksPerContract is the default number of failed ibc
+// lifecycle callbacks a contract can have in the retry queue.
+const DefaultMaxFailedCallbacksPerContract = 100
+
+// DefaultParams returns default wasmextension parameters.
+func DefaultParams() Params {
+	return Params{
+		AcceptedStargateQueries:       []AcceptedStargateQuery{},
+		MaxCallbackRetries:            DefaultMaxCallbackRetries,
+		CallbackRetryInterval:         DefaultCallbackRetryInterval,
+		MaxFailedCallbacksPerContract: DefaultMaxFailedCallbacksPerContract,
+	}
+}
+
+// Validate performs basic validation of the wasmextension parameters.
+func (p Params) Validate() error {
+	seenPaths := make(map[string]bool, len(p.AcceptedStargateQueries))
+	for _, query := range p.AcceptedStargateQueries {
+		if err := query.Validate(); err != nil {
+			return err
+		}
+
+		if seenPaths[query.Path] {
+			return fmt.Errorf("duplicate accepted stargate query path: %s", query.Path)
+		}
+		seenPaths[query.Path] = true
+	}
+
+	if p.MaxCallbackRetries != 0 && p.MaxFailedCallbacksPerContract == 0 {
+		return fmt.Errorf("max failed callbacks per contract must be positive when the retry queue is enabled")
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the accepted stargate query. The
+// queried grpc method has to be marked as module_query_safe, so that only the
+// deterministic queries can be accepted.
+func (q AcceptedStargateQuery) Validate() error {
+	serviceName, methodName, err := SplitQueryPath(q.Path)
+	if err != nil {
+		return err
+	}
+
+	if len(q.ResponseType) == 0 {
+		return fmt.Errorf("empty response type for stargate query path: %s", q.Path)
+	}
+
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return ErrInvalidStargateQuery.Wrapf("unknown query service %s", serviceName)
+	}
+
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return ErrInvalidStargateQuery.Wrapf("%s is not a query service", serviceName)
+	}
+
+	methodDesc := serviceDesc.Methods().ByName(protoreflect.Name(methodName))
+	if methodDesc == nil {
+		return ErrInvalidStargateQuery.Wrapf("unknown query method %s", q.Path)
+	}
+
+	if safe, ok := proto.GetExtension(methodDesc.Options(), queryv1.E_ModuleQuerySafe).(bool); !ok || !safe {
+		return ErrInvalidStargateQuery.Wrapf("%s is not module query safe", q.Path)
+	}
+
+	return nil
+}
+
+// SplitQueryPath splits a grpc method path of the form `/{service}/{method}`
+// into the full service name and the method name.
+func SplitQueryPath(path string) (service string, method string, err error) {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if !strings.HasPrefix(path, "/") || len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", fmt.Errorf("invalid stargate query path: %s", path)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,3104 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: miniwasm/wasmextension/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryAcceptedStargateMsgsRequest is the request type for the
+// Query/AcceptedStargateMsgs RPC method.
+type QueryAcceptedStargateMsgsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAcceptedStargateMsgsRequest) Reset()         { *m = QueryAcceptedStargateMsgsRequest{} }
+func (m *QueryAcceptedStargateMsgsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAcceptedStargateMsgsRequest) ProtoMessage()    {}
+func (*QueryAcceptedStargateMsgsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03040a887a91fdbe, []int{0}
+}
+func (m *QueryAcceptedStargateMsgsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAcceptedStargateMsgsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAcceptedStargateMsgsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAcceptedStargateMsgsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAcceptedStargateMsgsRequest.Merge(m, src)
+}
+func (m *QueryAcceptedStargateMsgsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAcceptedStargateMsgsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAcceptedStargateMsgsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAcceptedStargateMsgsRequest proto.InternalMessageInfo
+
+// QueryAcceptedStargateMsgsResponse is the response type for the
+// Query/AcceptedStargateMsgs RPC method.
+type QueryAcceptedStargateMsgsResponse struct {
+	// type_urls are the accepted msg type urls.
+	TypeURLs []string `protobuf:"bytes,1,rep,name=type_urls,json=typeUrls,proto3" json:"type_urls,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAcceptedStargateMsgsResponse) Reset()         { *m = QueryAcceptedStargateMsgsResponse{} }
+func (m *QueryAcceptedStargateMsgsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAcceptedStargateMsgsResponse) ProtoMessage()    {}
+func (*QueryAcceptedStargateMsgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03040a887a91fdbe, []int{1}
+}
+func (m *QueryAcceptedStargateMsgsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAcceptedStargateMsgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAcceptedStargateMsgsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAcceptedStargateMsgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAcceptedStargateMsgsResponse.Merge(m, src)
+}
+func (m *QueryAcceptedStargateMsgsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAcceptedStargateMsgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAcceptedStargateMsgsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAcceptedStargateMsgsResponse proto.InternalMessageInfo
+
+// QueryAdminCodesRequest is the request type for the Query/AdminCodes RPC
+// method.
+type QueryAdminCodesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAdminCodesRequest) Reset()         { *m = QueryAdminCodesRequest{} }
+func (m *QueryAdminCodesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminCodesRequest) ProtoMessage()    {}
+func (*QueryAdminCodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03040a887a91fdbe, []int{2}
+}
+func (m *QueryAdminCodesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminCodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminCodesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminCodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminCodesRequest.Merge(m, src)
+}
+func (m *QueryAdminCodesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminCodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminCodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminCodesRequest proto.InternalMessageInfo
+
+// QueryAdminCodesResponse is the response type for the Query/AdminCodes RPC
+// method.
+type QueryAdminCodesResponse struct {
+	// admin_codes are the codes stored with admin permission.
+	AdminCodes []AdminCodeInfo `protobuf:"bytes,1,rep,name=admin_codes,json=adminCodes,proto3" json:"admin_codes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAdminCodesResponse) Reset()         { *m = QueryAdminCodesResponse{} }
+func (m *QueryAdminCodesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminCodesResponse) ProtoMessage()    {}
+func (*QueryAdminCodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03040a887a91fdbe, []int{3}
+}
+func (m *QueryAdminCodesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminCodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminCodesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminCodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminCodesResponse.Merge(m, src)
+}
+func (m *QueryAdminCodesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminCodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminCodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminCodesResponse proto.InternalMessageInfo
+
+// QueryAdminCodeRequest is the request type for the Query/AdminCode RPC
+// method.
+type QueryAdminCodeRequest struct {
+	// code_id is the reference to the stored WASM code
+	CodeId uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+}
+
+func (m *QueryAdminCodeRequest) Reset()         { *m = QueryAdminCodeRequest{} }
+func (m *QueryAdminCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminCodeRequest) ProtoMessage()    {}
+func (*QueryAdminCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03040a887a91fdbe, []int{4}
+}
+func (m *QueryAdminCodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminCodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminCodeRequest.Merge(m, src)
+}
+func (m *QueryAdminCodeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminCodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminCodeRequest proto.InternalMessageInfo
+
+// QueryAdminCodeResponse is the response type for the Query/AdminCode RPC
+// method.
+type QueryAdminCodeResponse struct {
+	// admin_code is the admin store record of the code.
+	AdminCode AdminCodeInfo `protobuf:"bytes,1,opt,name=admin_code,json=adminCode,proto3" json:"admin_code"`
+}
+
+func (m *QueryAdminCodeResponse) Reset()         { *m = QueryAdminCodeResponse{} }
+func (m *QueryAdminCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminCodeResponse) ProtoMessage()    {}
+func (*QueryAdminCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03040a887a91fdbe, []int{5}
+}
+func (m *QueryAdminCodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminCodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminCodeResponse.Merge(m, src)
+}
+func (m *QueryAdminCodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminCodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminCodeResponse proto.InternalMessageInfo
+
+// QueryRegisteredChecksumsRequest is the request type for the
+// Query/RegisteredChecksums RPC method.
+type QueryRegisteredChecksumsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRegisteredChecksumsRequest) Reset()         { *m = QueryRegisteredChecksumsRequest{} }
+func (m *QueryRegisteredChecksumsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredChecksumsRequest) ProtoMessage()    {}
+func (*QueryRegisteredChecksumsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03040a887a91fdbe, []int{6}
+}
+func (m *QueryRegisteredChecksumsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredChecksumsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredChecksumsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredChecksumsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredChecksumsRequest.Merge(m, src)
+}
+func (m *QueryRegisteredChecksumsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredChecksumsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredChecksumsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegisteredChecksumsRequest proto.InternalMessageInfo
+
+// QueryRegisteredChecksumsResponse is the response type for the
+// Query/RegisteredChecksums RPC method.
+type QueryRegisteredChecksumsResponse struct {
+	// checksums are the registered code checksums.
+	Checksums []RegisteredChecksum `protobuf:"bytes,1,rep,name=checksums,proto3" json:"checksums"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRegisteredChecksumsResponse) Reset()         { *m = QueryRegisteredChecksumsResponse{} }
+func (m *QueryRegisteredChecksumsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredChecksumsResponse) ProtoMessage()    {}
+func (*QueryRegisteredChecksumsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03040a887a91fdbe, []int{7}
+}
+func (m *QueryRegisteredChecksumsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredChecksumsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredChecksumsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredChecksumsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredChecksumsResponse.Merge(m, src)
+}
+func (m *QueryRegisteredChecksumsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredChecksumsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredChecksumsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegisteredChecksumsResponse proto.InternalMessageInfo
+
+// QueryRegisteredChecksumRequest is the request type for the
+// Query/RegisteredChecksum RPC method.
+type QueryRegisteredChecksumRequest struct {
+	// checksum is the hex encoded sha256 hash of the code
+	Checksum string `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *QueryRegisteredChecksumRequest) Reset()         { *m = QueryRegisteredChecksumRequest{} }
+func (m *QueryRegisteredChecksumRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredChecksumRequest) ProtoMessage()    {}
+func (*QueryRegisteredChecksumRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03040a887a91fdbe, []int{8}
+}
+func (m *QueryRegisteredChecksumRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredChecksumRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredChecksumRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredChecksumRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredChecksumRequest.Merge(m, src)
+}
+func (m *QueryRegisteredChecksumRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredChecksumRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredChecksumRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegisteredChecksumRequest proto.InternalMessageInfo
+
+// QueryRegisteredChecksumResponse is the response type for the
+// Query/RegisteredChecksum RPC method.
+type QueryRegisteredChecksumResponse struct {
+	// checksum is the registered code checksum.
+	Checksum RegisteredChecksum `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum"`
+}
+
+func (m *QueryRegisteredChecksumResponse) Reset()         { *m = QueryRegisteredChecksumResponse{} }
+func (m *QueryRegisteredChecksumResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredChecksumResponse) ProtoMessage()    {}
+func (*QueryRegisteredChecksumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03040a887a91fdbe, []int{9}
+}
+func (m *QueryRegisteredChecksumResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredChecksumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredChecksumResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredChecksumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredChecksumResponse.Merge(m, src)
+}
+func (m *QueryRegisteredChecksumResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredChecksumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredChecksumResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegisteredChecksumResponse proto.InternalMessageInfo
+
+// QueryFailedCallbacksRequest is the request type for the
+// Query/FailedCallbacks RPC method.
+type QueryFailedCallbacksRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedCallbacksRequest) Reset()         { *m = QueryFailedCallbacksRequest{} }
+func (m *QueryFailedCallbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedCallbacksRequest) ProtoMessage()    {}
+func (*QueryFailedCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03040a887a91fdbe, []int{10}
+}
+func (m *QueryFailedCallbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedCallbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedCallbacksRequest.Merge(m, src)
+}
+func (m *QueryFailedCallbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedCallbacksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedCallbacksRequest proto.InternalMessageInfo
+
+// QueryFailedCallbacksResponse is the response type for the
+// Query/FailedCallbacks RPC method.
+type QueryFailedCallbacksResponse struct {
+	// failed_callbacks are the failed callbacks pending to be retried.
+	FailedCallbacks []FailedCallback `protobuf:"bytes,1,rep,name=failed_callbacks,json=failedCallbacks,proto3" json:"failed_callbacks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedCallbacksResponse) Reset()         { *m = QueryFailedCallbacksResponse{} }
+func (m *QueryFailedCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedCallbacksResponse) ProtoMessage()    {}
+func (*QueryFailedCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03040a887a91fdbe, []int{11}
+}
+func (m *QueryFailedCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedCallbacksResponse.Merge(m, src)
+}
+func (m *QueryFailedCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedCallbacksResponse proto.InternalMessageInfo
+
+// QueryFailedCallbackRequest is the request type for the Query/FailedCallback
+// RPC method.
+type QueryFailedCallbackRequest struct {
+	// id is the identifier of the failed callback
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryFailedCallbackRequest) Reset()         { *m = QueryFailedCallbackRequest{} }
+func (m *QueryFailedCallbackRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedCallbackRequest) ProtoMessage()    {}
+func (*QueryFailedCallbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03040a887a91fdbe, []int{12}
+}
+func (m *QueryFailedCallbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedCallbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedCallbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedCallbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedCallbackRequest.Merge(m, src)
+}
+func (m *QueryFailedCallbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedCallbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedCallbackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedCallbackRequest proto.InternalMessageInfo
+
+// QueryFailedCallbackResponse is the response type for the
+// Query/FailedCallback RPC method.
+type QueryFailedCallbackResponse struct {
+	// failed_callback is the failed callback.
+	FailedCallback FailedCallback `protobuf:"bytes,1,opt,name=failed_callback,json=failedCallback,proto3" json:"failed_callback"`
+}
+
+func (m *QueryFailedCallbackResponse) Reset()         { *m = QueryFailedCallbackResponse{} }
+func (m *QueryFailedCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedCallbackResponse) ProtoMessage()    {}
+func (*QueryFailedCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03040a887a91fdbe, []int{13}
+}
+func (m *QueryFailedCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedCallbackResponse.Merge(m, src)
+}
+func (m *QueryFailedCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedCallbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedCallbackResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryAcceptedStargateMsgsRequest)(nil), "miniwasm.wasmextension.v1.QueryAcceptedStargateMsgsRequest")
+	proto.RegisterType((*QueryAcceptedStargateMsgsResponse)(nil), "miniwasm.wasmextension.v1.QueryAcceptedStargateMsgsResponse")
+	proto.RegisterType((*QueryAdminCodesRequest)(nil), "miniwasm.wasmextension.v1.QueryAdminCodesRequest")
+	proto.RegisterType((*QueryAdminCodesResponse)(nil), "miniwasm.wasmextension.v1.QueryAdminCodesResponse")
+	proto.RegisterType((*QueryAdminCodeRequest)(nil), "miniwasm.wasmextension.v1.QueryAdminCodeRequest")
+	proto.RegisterType((*QueryAdminCodeResponse)(nil), "miniwasm.wasmextension.v1.QueryAdminCodeResponse")
+	proto.RegisterType((*QueryRegisteredChecksumsRequest)(nil), "miniwasm.wasmextension.v1.QueryRegisteredChecksumsRequest")
+	proto.RegisterType((*QueryRegisteredChecksumsResponse)(nil), "miniwasm.wasmextension.v1.QueryRegisteredChecksumsResponse")
+	proto.RegisterType((*QueryRegisteredChecksumRequest)(nil), "miniwasm.wasmextension.v1.QueryRegisteredChecksumRequest")
+	proto.RegisterType((*QueryRegisteredChecksumResponse)(nil), "miniwasm.wasmextension.v1.QueryRegisteredChecksumResponse")
+	proto.RegisterType((*QueryFailedCallbacksRequest)(nil), "miniwasm.wasmextension.v1.QueryFailedCallbacksRequest")
+	proto.RegisterType((*QueryFailedCallbacksResponse)(nil), "miniwasm.wasmextension.v1.QueryFailedCallbacksResponse")
+	proto.RegisterType((*QueryFailedCallbackRequest)(nil), "miniwasm.wasmextension.v1.QueryFailedCallbackRequest")
+	proto.RegisterType((*QueryFailedCallbackResponse)(nil), "miniwasm.wasmextension.v1.QueryFailedCallbackResponse")
+}
+
+func init() {
+	proto.RegisterFile("miniwasm/wasmextension/v1/query.proto", fileDescriptor_03040a887a91fdbe)
+}
+
+var fileDescriptor_03040a887a91fdbe = []byte{
+	// 864 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x33, 0x61, 0x59, 0x9a, 0x57, 0xd4, 0xa2, 0x61, 0x61, 0x17, 0xb3, 0x72, 0x43, 0x24,
+	0x96, 0x2c, 0x50, 0xbb, 0x4e, 0xb5, 0xbb, 0x6c, 0xdb, 0x0b, 0xa9, 0x54, 0x54, 0x89, 0x0a, 0xea,
+	0x52, 0x09, 0xf5, 0x12, 0x26, 0xf6, 0xd4, 0x1d, 0x9a, 0xd8, 0xa9, 0xc7, 0xe9, 0x0f, 0x55, 0xbd,
+	0xf0, 0x17, 0x20, 0x71, 0xe0, 0x0f, 0xe0, 0xc6, 0x95, 0x03, 0x42, 0x48, 0x5c, 0x38, 0xd0, 0x03,
+	0x12, 0x95, 0xb8, 0x70, 0xaa, 0x20, 0xe5, 0x0f, 0x59, 0x79, 0x32, 0x76, 0xea, 0x34, 0x71, 0xea,
+	0x28, 0x97, 0x68, 0x32, 0x33, 0xef, 0x7d, 0xbf, 0x9f, 0x37, 0xf6, 0x3c, 0x19, 0xde, 0x6d, 0x32,
+	0x97, 0x1d, 0x11, 0xde, 0xd4, 0xc3, 0x1f, 0x7a, 0x1c, 0x50, 0x97, 0x33, 0xcf, 0xd5, 0x0f, 0x0d,
+	0xfd, 0xa0, 0x4d, 0xfd, 0x13, 0xad, 0xe5, 0x7b, 0x81, 0x87, 0xdf, 0x8a, 0xb6, 0x69, 0x89, 0x6d,
+	0xda, 0xa1, 0xa1, 0xbc, 0x6f, 0x79, 0xbc, 0xe9, 0x71, 0xbd, 0x4e, 0x38, 0xed, 0xc6, 0xe8, 0x87,
+	0x46, 0x9d, 0x06, 0xc4, 0xd0, 0x5b, 0xc4, 0x61, 0x2e, 0x09, 0xc2, 0x8d, 0x22, 0x8d, 0x72, 0xcf,
+	0xf1, 0x1c, 0x4f, 0x0c, 0xf5, 0x70, 0x24, 0x67, 0x1f, 0x3a, 0x9e, 0xe7, 0x34, 0xa8, 0x4e, 0x5a,
+	0x4c, 0x27, 0xae, 0xeb, 0x05, 0x22, 0x84, 0xcb, 0xd5, 0x14, 0x87, 0xc1, 0x49, 0x8b, 0xca, 0x6d,
+	0xa5, 0xaf, 0xa1, 0xb8, 0x19, 0x8a, 0x7f, 0x6c, 0x59, 0xb4, 0x15, 0x50, 0x7b, 0x2b, 0x20, 0xbe,
+	0x43, 0x02, 0xba, 0xc1, 0x1d, 0x6e, 0xd2, 0x83, 0x36, 0xe5, 0x01, 0x5e, 0x03, 0xe8, 0x59, 0x7a,
+	0x80, 0x8a, 0xa8, 0x3c, 0x5d, 0x79, 0xa4, 0x75, 0xfd, 0x6b, 0xa1, 0x7f, 0xad, 0xcb, 0x2c, 0xfd,
+	0x6b, 0x9f, 0x13, 0x87, 0xca, 0x58, 0xf3, 0x5a, 0x64, 0xe9, 0x7b, 0x04, 0xef, 0xa4, 0x88, 0xf1,
+	0x96, 0xe7, 0x72, 0x8a, 0x1f, 0x43, 0x21, 0x34, 0x58, 0x6b, 0xfb, 0x0d, 0xfe, 0x00, 0x15, 0x5f,
+	0x2a, 0x17, 0xaa, 0xaf, 0x76, 0x2e, 0xe7, 0xa6, 0xbe, 0x38, 0x69, 0xd1, 0x6d, 0xf3, 0x53, 0x6e,
+	0x4e, 0x85, 0xcb, 0xdb, 0x7e, 0x83, 0xe3, 0x4f, 0x12, 0xc6, 0xf2, 0xc2, 0xd8, 0x7b, 0x23, 0x8d,
+	0x75, 0x75, 0x12, 0xce, 0xbe, 0x82, 0x37, 0xbb, 0xc6, 0xec, 0x26, 0x73, 0x57, 0x3d, 0x9b, 0x4e,
+	0x9c, 0xfd, 0x27, 0x04, 0xf7, 0x6f, 0x48, 0x48, 0xe2, 0xcf, 0x60, 0x9a, 0x84, 0xb3, 0x35, 0x2b,
+	0x9c, 0x16, 0xcc, 0xd3, 0x95, 0xb2, 0x36, 0xf4, 0xd9, 0xd1, 0xe2, 0x1c, 0xeb, 0xee, 0xae, 0x57,
+	0xbd, 0x73, 0x7e, 0x39, 0x97, 0x33, 0x81, 0xc4, 0x89, 0x27, 0x57, 0x97, 0x05, 0x78, 0x23, 0x69,
+	0x3a, 0x2a, 0xcb, 0x7d, 0x78, 0x25, 0x34, 0x5b, 0x63, 0xb6, 0xa8, 0xc9, 0x1d, 0xf3, 0x6e, 0xf8,
+	0x77, 0xdd, 0x2e, 0x39, 0xfd, 0x95, 0x8c, 0x29, 0x37, 0x00, 0x7a, 0x94, 0xb2, 0x92, 0x59, 0x21,
+	0x0b, 0x31, 0x64, 0x89, 0xc1, 0x9c, 0x10, 0x32, 0xa9, 0xc3, 0x78, 0x40, 0x7d, 0x6a, 0xaf, 0xee,
+	0x51, 0x6b, 0x9f, 0xb7, 0x9b, 0x13, 0x3f, 0xbb, 0xdf, 0x10, 0x14, 0x87, 0x6b, 0x49, 0xbc, 0x4d,
+	0x28, 0x58, 0xd1, 0xa4, 0x3c, 0xc2, 0xf9, 0x14, 0xba, 0x9b, 0xa9, 0x22, 0xc4, 0x38, 0xcb, 0xe4,
+	0x8e, 0x71, 0x05, 0xd4, 0x21, 0xfe, 0xa3, 0x52, 0x29, 0x30, 0x15, 0xe9, 0x8a, 0x42, 0x15, 0xcc,
+	0xf8, 0x7f, 0xc9, 0x1f, 0x5a, 0xe9, 0x6b, 0x4f, 0x70, 0x32, 0x7c, 0x4c, 0xf6, 0x9e, 0x26, 0x85,
+	0xb7, 0x85, 0xe6, 0x1a, 0x61, 0x0d, 0x6a, 0xaf, 0x92, 0x46, 0xa3, 0x4e, 0xac, 0xfd, 0x89, 0x9f,
+	0xec, 0xef, 0x08, 0x1e, 0x0e, 0xd6, 0x91, 0x60, 0x3b, 0xf0, 0xda, 0xae, 0x58, 0xaa, 0x59, 0xd1,
+	0x9a, 0x3c, 0xdc, 0xc7, 0x29, 0x80, 0xc9, 0x6c, 0x12, 0x6e, 0x76, 0x37, 0xa9, 0x31, 0xb9, 0xe3,
+	0xfd, 0x10, 0x94, 0x01, 0x10, 0x51, 0xad, 0x66, 0x20, 0x1f, 0xbf, 0xa5, 0x79, 0x66, 0x97, 0x8e,
+	0x06, 0x96, 0x36, 0x26, 0xfe, 0x12, 0x66, 0xfb, 0x88, 0x65, 0x7d, 0x33, 0x03, 0xcf, 0x24, 0x81,
+	0x2b, 0xbf, 0x02, 0xbc, 0x2c, 0x94, 0xf1, 0x9f, 0x08, 0xee, 0x0d, 0xea, 0x01, 0x78, 0x39, 0x45,
+	0x63, 0x54, 0x9b, 0x52, 0x56, 0xc6, 0x0b, 0xee, 0x72, 0x97, 0x9e, 0x7f, 0xf3, 0xf7, 0xff, 0xdf,
+	0xe5, 0x17, 0xb1, 0xa1, 0x0f, 0x6f, 0x9c, 0x44, 0x26, 0xa8, 0x71, 0x99, 0xa1, 0xd6, 0x0c, 0x5d,
+	0xff, 0x80, 0x00, 0x7a, 0xd7, 0x3a, 0x36, 0x46, 0xfa, 0xe8, 0xef, 0x32, 0x4a, 0x25, 0x4b, 0x88,
+	0x34, 0xac, 0x09, 0xc3, 0x65, 0xfc, 0x28, 0xcd, 0x70, 0xaf, 0xad, 0xe0, 0x1f, 0x11, 0x14, 0xe2,
+	0x34, 0x78, 0xe1, 0xd6, 0x8a, 0x91, 0x47, 0x23, 0x43, 0x84, 0xb4, 0xf8, 0x91, 0xb0, 0x58, 0xc1,
+	0x0b, 0xb7, 0xb3, 0xa8, 0x9f, 0xca, 0x9e, 0x72, 0x86, 0xff, 0x40, 0xf0, 0xfa, 0x80, 0xdb, 0x16,
+	0x2f, 0x8d, 0x32, 0x31, 0xbc, 0x1d, 0x28, 0xcb, 0x63, 0xc5, 0x4a, 0x94, 0x67, 0x02, 0xc5, 0xc0,
+	0x7a, 0x0a, 0x8a, 0x1f, 0xc7, 0xd7, 0x7a, 0x97, 0xf8, 0x5f, 0x08, 0xf0, 0xcd, 0xc4, 0xf8, 0x79,
+	0x76, 0x33, 0x11, 0xc7, 0xd2, 0x38, 0xa1, 0x12, 0xa3, 0x2a, 0x30, 0x56, 0xf0, 0x52, 0x46, 0x0c,
+	0xfd, 0x34, 0x1a, 0x9e, 0xe1, 0x9f, 0x11, 0xcc, 0xf6, 0xdd, 0x97, 0xf8, 0xe9, 0x28, 0x4f, 0x83,
+	0x2f, 0x72, 0xe5, 0x59, 0xe6, 0x38, 0x09, 0xb2, 0x28, 0x40, 0xe6, 0xf1, 0x07, 0x29, 0x20, 0xfd,
+	0x37, 0x37, 0xfe, 0x05, 0xc1, 0x4c, 0x32, 0x21, 0x7e, 0x92, 0xcd, 0x40, 0xe4, 0xfb, 0x69, 0xd6,
+	0xb0, 0x0c, 0x6f, 0x44, 0xbf, 0x6d, 0xfd, 0x94, 0xd9, 0x67, 0xd5, 0xad, 0xf3, 0xff, 0xd4, 0xdc,
+	0x79, 0x47, 0x45, 0x17, 0x1d, 0x15, 0xfd, 0xdb, 0x51, 0xd1, 0xb7, 0x57, 0x6a, 0xee, 0xe2, 0x4a,
+	0xcd, 0xfd, 0x73, 0xa5, 0xe6, 0x76, 0x9e, 0x38, 0x2c, 0xd8, 0x6b, 0xd7, 0x35, 0xcb, 0x6b, 0xea,
+	0xcc, 0x65, 0x01, 0x23, 0xf3, 0x0d, 0x52, 0xe7, 0x3d, 0x95, 0xe3, 0x3e, 0x1d, 0xf1, 0x0d, 0x50,
+	0xbf, 0x2b, 0x3e, 0x02, 0x16, 0x5f, 0x0c, 0x00, 0x40, 0xa5, 0x8c, 0x5f, 0xcf, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// AcceptedStargateMsgs returns the msg type urls contracts are allowed to
+	// dispatch as stargate messages.
+	AcceptedStargateMsgs(ctx context.Context, in *QueryAcceptedStargateMsgsRequest, opts ...grpc.CallOption) (*QueryAcceptedStargateMsgsResponse, error)
+	// AdminCodes returns the codes stored with admin permission.
+	AdminCodes(ctx context.Context, in *QueryAdminCodesRequest, opts ...grpc.CallOption) (*QueryAdminCodesResponse, error)
+	// AdminCode returns the admin store record of a code.
+	AdminCode(ctx context.Context, in *QueryAdminCodeRequest, opts ...grpc.CallOption) (*QueryAdminCodeResponse, error)
+	// RegisteredChecksums returns the code checksums allowed to be stored with
+	// admin permission.
+	RegisteredChecksums(ctx context.Context, in *QueryRegisteredChecksumsRequest, opts ...grpc.CallOption) (*QueryRegisteredChecksumsResponse, error)
+	// RegisteredChecksum returns the provenance metadata of a registered code
+	// checksum.
+	RegisteredChecksum(ctx context.Context, in *QueryRegisteredChecksumRequest, opts ...grpc.CallOption) (*QueryRegisteredChecksumResponse, error)
+	// FailedCallbacks returns the failed ibc lifecycle callbacks pending to be
+	// retried.
+	FailedCallbacks(ctx context.Context, in *QueryFailedCallbacksRequest, opts ...grpc.CallOption) (*QueryFailedCallbacksResponse, error)
+	// FailedCallback returns a failed ibc lifecycle callback.
+	FailedCallback(ctx context.Context, in *QueryFailedCallbackRequest, opts ...grpc.CallOption) (*QueryFailedCallbackResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AcceptedStargateMsgs(ctx context.Context, in *QueryAcceptedStargateMsgsRequest, opts ...grpc.CallOption) (*QueryAcceptedStargateMsgsResponse, error) {
+	out := new(QueryAcceptedStargateMsgsResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Query/AcceptedStargateMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdminCodes(ctx context.Context, in *QueryAdminCodesRequest, opts ...grpc.CallOption) (*QueryAdminCodesResponse, error) {
+	out := new(QueryAdminCodesResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Query/AdminCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdminCode(ctx context.Context, in *QueryAdminCodeRequest, opts ...grpc.CallOption) (*QueryAdminCodeResponse, error) {
+	out := new(QueryAdminCodeResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Query/AdminCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegisteredChecksums(ctx context.Context, in *QueryRegisteredChecksumsRequest, opts ...grpc.CallOption) (*QueryRegisteredChecksumsResponse, error) {
+	out := new(QueryRegisteredChecksumsResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Query/RegisteredChecksums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegisteredChecksum(ctx context.Context, in *QueryRegisteredChecksumRequest, opts ...grpc.CallOption) (*QueryRegisteredChecksumResponse, error) {
+	out := new(QueryRegisteredChecksumResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Query/RegisteredChecksum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedCallbacks(ctx context.Context, in *QueryFailedCallbacksRequest, opts ...grpc.CallOption) (*QueryFailedCallbacksResponse, error) {
+	out := new(QueryFailedCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Query/FailedCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedCallback(ctx context.Context, in *QueryFailedCallbackRequest, opts ...grpc.CallOption) (*QueryFailedCallbackResponse, error) {
+	out := new(QueryFailedCallbackResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Query/FailedCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// AcceptedStargateMsgs returns the msg type urls contracts are allowed to
+	// dispatch as stargate messages.
+	AcceptedStargateMsgs(context.Context, *QueryAcceptedStargateMsgsRequest) (*QueryAcceptedStargateMsgsResponse, error)
+	// AdminCodes returns the codes stored with admin permission.
+	AdminCodes(context.Context, *QueryAdminCodesRequest) (*QueryAdminCodesResponse, error)
+	// AdminCode returns the admin store record of a code.
+	AdminCode(context.Context, *QueryAdminCodeRequest) (*QueryAdminCodeResponse, error)
+	// RegisteredChecksums returns the code checksums allowed to be stored with
+	// admin permission.
+	RegisteredChecksums(context.Context, *QueryRegisteredChecksumsRequest) (*QueryRegisteredChecksumsResponse, error)
+	// RegisteredChecksum returns the provenance metadata of a registered code
+	// checksum.
+	RegisteredChecksum(context.Context, *QueryRegisteredChecksumRequest) (*QueryRegisteredChecksumResponse, error)
+	// FailedCallbacks returns the failed ibc lifecycle callbacks pending to be
+	// retried.
+	FailedCallbacks(context.Context, *QueryFailedCallbacksRequest) (*QueryFailedCallbacksResponse, error)
+	// FailedCallback returns a failed ibc lifecycle callback.
+	FailedCallback(context.Context, *QueryFailedCallbackRequest) (*QueryFailedCallbackResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AcceptedStargateMsgs(ctx context.Context, req *QueryAcceptedStargateMsgsRequest) (*QueryAcceptedStargateMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptedStargateMsgs not implemented")
+}
+func (*UnimplementedQueryServer) AdminCodes(ctx context.Context, req *QueryAdminCodesRequest) (*QueryAdminCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCodes not implemented")
+}
+func (*UnimplementedQueryServer) AdminCode(ctx context.Context, req *QueryAdminCodeRequest) (*QueryAdminCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCode not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredChecksums(ctx context.Context, req *QueryRegisteredChecksumsRequest) (*QueryRegisteredChecksumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredChecksums not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredChecksum(ctx context.Context, req *QueryRegisteredChecksumRequest) (*QueryRegisteredChecksumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredChecksum not implemented")
+}
+func (*UnimplementedQueryServer) FailedCallbacks(ctx context.Context, req *QueryFailedCallbacksRequest) (*QueryFailedCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedCallbacks not implemented")
+}
+func (*UnimplementedQueryServer) FailedCallback(ctx context.Context, req *QueryFailedCallbackRequest) (*QueryFailedCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedCallback not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AcceptedStargateMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAcceptedStargateMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AcceptedStargateMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Query/AcceptedStargateMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AcceptedStargateMsgs(ctx, req.(*QueryAcceptedStargateMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdminCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Query/AdminCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminCodes(ctx, req.(*QueryAdminCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdminCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Query/AdminCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminCode(ctx, req.(*QueryAdminCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredChecksums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredChecksumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredChecksums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Query/RegisteredChecksums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredChecksums(ctx, req.(*QueryRegisteredChecksumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredChecksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredChecksumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredChecksum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Query/RegisteredChecksum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredChecksum(ctx, req.(*QueryRegisteredChecksumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Query/FailedCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedCallbacks(ctx, req.(*QueryFailedCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Query/FailedCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedCallback(ctx, req.(*QueryFailedCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "miniwasm.wasmextension.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AcceptedStargateMsgs",
+			Handler:    _Query_AcceptedStargateMsgs_Handler,
+		},
+		{
+			MethodName: "AdminCodes",
+			Handler:    _Query_AdminCodes_Handler,
+		},
+		{
+			MethodName: "AdminCode",
+			Handler:    _Query_AdminCode_Handler,
+		},
+		{
+			MethodName: "RegisteredChecksums",
+			Handler:    _Query_RegisteredChecksums_Handler,
+		},
+		{
+			MethodName: "RegisteredChecksum",
+			Handler:    _Query_RegisteredChecksum_Handler,
+		},
+		{
+			MethodName: "FailedCallbacks",
+			Handler:    _Query_FailedCallbacks_Handler,
+		},
+		{
+			MethodName: "FailedCallback",
+			Handler:    _Query_FailedCallback_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "miniwasm/wasmextension/v1/query.proto",
+}
+
+func (m *QueryAcceptedStargateMsgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAcceptedStargateMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAcceptedStargateMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAcceptedStargateMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAcceptedStargateMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAcceptedStargateMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeURLs) > 0 {
+		for iNdEx := len(m.TypeURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeURLs[iNdEx])
+			copy(dAtA[i:], m.TypeURLs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeURLs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminCodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminCodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminCodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminCodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminCodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminCodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AdminCodes) > 0 {
+		for iNdEx := len(m.AdminCodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminCodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminCodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminCodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AdminCode.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredChecksumsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredChecksumsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredChecksumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredChecksumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredChecksumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredChecksumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checksums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredChecksumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredChecksumRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredChecksumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredChecksumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredChecksumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredChecksumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Checksum.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedCallbacks) > 0 {
+		for iNdEx := len(m.FailedCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedCallbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedCallbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedCallbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FailedCallback.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAcceptedStargateMsgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAcceptedStargateMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TypeURLs) > 0 {
+		for _, s := range m.TypeURLs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdminCodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdminCodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AdminCodes) > 0 {
+		for _, e := range m.AdminCodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdminCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovQuery(uint64(m.CodeId))
+	}
+	return n
+}
+
+func (m *QueryAdminCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AdminCode.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRegisteredChecksumsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredChecksumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checksums) > 0 {
+		for _, e := range m.Checksums {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredChecksumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredChecksumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Checksum.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFailedCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedCallbacks) > 0 {
+		for _, e := range m.FailedCallbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedCallbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryFailedCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FailedCallback.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAcceptedStargateMsgsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAcceptedStargateMsgsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAcceptedStargateMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAcceptedStargateMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAcceptedStargateMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAcceptedStargateMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeURLs = append(m.TypeURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminCodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminCodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminCodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminCodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminCodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminCodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminCodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminCodes = append(m.AdminCodes, AdminCodeInfo{})
+			if err := m.AdminCodes[len(m.AdminCodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminCode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdminCode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredChecksumsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredChecksumsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredChecksumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredChecksumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredChecksumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredChecksumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, RegisteredChecksum{})
+			if err := m.Checksums[len(m.Checksums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredChecksumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredChecksumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredChecksumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredChecksumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredChecksumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredChecksumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Checksum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedCallbacksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedCallbacksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedCallbacksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedCallbacksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedCallbacks = append(m.FailedCallbacks, FailedCallback{})
+			if err := m.FailedCallbacks[len(m.FailedCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedCallbackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedCallbackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedCallbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedCallbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedCallbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCallback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FailedCallback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,6 +28,103 @@ func (msg MsgStoreCodeAdmin) ValidateBasic() error {
 	return nil
 }
 
+func (msg MsgStoreCodesAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return err
+	}
+
+	if len(msg.Codes) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty codes")
+	}
+
+	for i, code := range msg.Codes {
+		if err := validateWasmCode(code.WASMByteCode, wasmtypes.MaxProposalWasmSize); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "code bytes at index %d %s", i, err.Error())
+		}
+
+		if code.InstantiatePermission != nil {
+			if err := code.InstantiatePermission.ToWasmAccessConfig().ValidateBasic(); err != nil {
+				return errorsmod.Wrapf(err, "instantiate permission at index %d", i)
+			}
+		}
+	}
+	return nil
+}
+
+func (msg MsgStoreAndInstantiateAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return err
+	}
+
+	if err := validateWasmCode(msg.WASMByteCode, wasmtypes.MaxProposalWasmSize); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "code bytes %s", err.Error())
+	}
+
+	if msg.InstantiatePermission != nil {
+		if err := msg.InstantiatePermission.ToWasmAccessConfig().ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "instantiate permission")
+		}
+	}
+
+	if len(msg.Admin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return errorsmod.Wrap(err, "admin")
+		}
+	}
+
+	if err := wasmtypes.ValidateLabel(msg.Label); err != nil {
+		return errorsmod.Wrap(err, "label")
+	}
+
+	if err := msg.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "payload msg")
+	}
+	return nil
+}
+
+func (msg MsgUpdateCodeAccessConfigAdmin) ValidateBasic() error {
+	if msg.CodeID == 0 {
+		return errorsmod.Wrap(wasmtypes.ErrEmpty, "code id is required")
+	}
+
+	if msg.NewInstantiatePermission == nil {
+		return errorsmod.Wrap(wasmtypes.ErrEmpty, "instantiate permission is required")
+	}
+
+	if err := msg.NewInstantiatePermission.ToWasmAccessConfig().ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "instantiate permission")
+	}
+	return nil
+}
+
+func (msg MsgMigrateContractAdmin) ValidateBasic() error {
+	if msg.CodeID == 0 {
+		return errorsmod.Wrap(wasmtypes.ErrEmpty, "code id is required")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+
+	if err := msg.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "payload msg")
+	}
+	return nil
+}
+
+func (msg MsgSetContractAdminAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+
+	if len(msg.NewAdmin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+			return errorsmod.Wrap(err, "new admin")
+		}
+	}
+	return nil
+}
+
 func validateWasmCode(s []byte, maxSize int) error {
 	if len(s) == 0 {
 		return errorsmod.Wrap(wasmtypes.ErrEmpty, "is required")
@@ -35,3 +134,88 @@ func validateWasmCode(s []byte, maxSize int) error {
 	}
 	return nil
 }
+
+func (msg MsgRegisterChecksums) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(msg.Checksums) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty checksums")
+	}
+
+	return validateRegisteredChecksums(msg.Checksums)
+}
+
+func (msg MsgUnregisterChecksums) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(msg.Checksums) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty checksums")
+	}
+
+	checksums := make([]RegisteredChecksum, len(msg.Checksums))
+	for i, checksum := range msg.Checksums {
+		checksums[i] = RegisteredChecksum{Checksum: checksum}
+	}
+
+	return validateRegisteredChecksums(checksums)
+}
+
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.Validate()
+}
+
+func (msg MsgAddAcceptedStargateMsgs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return validateTypeURLs(msg.TypeURLs)
+}
+
+func (msg MsgRemoveAcceptedStargateMsgs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return validateTypeURLs(msg.TypeURLs)
+}
+
+func (msg MsgRetryFailedCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if msg.ID == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty failed callback id")
+	}
+
+	return nil
+}
+
+func validateTypeURLs(typeURLs []string) error {
+	if len(typeURLs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty type urls")
+	}
+
+	seen := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return errorsmod.Wrapf(ErrInvalidStargateMsg, "invalid type url: %s", typeURL)
+		}
+
+		if seen[typeURL] {
+			return errorsmod.Wrapf(ErrInvalidStargateMsg, "duplicate type url: %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	return nil
+}
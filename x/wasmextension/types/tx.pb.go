@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_CosmWasm_wasmd_x_wasm_types "github.com/CosmWasm/wasmd/x/wasm/types"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -118,283 +119,5266 @@ func (m *MsgStoreCodeAdminResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStoreCodeAdminResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgStoreCodeAdmin)(nil), "miniwasm.wasmextension.v1.MsgStoreCodeAdmin")
-	proto.RegisterType((*MsgStoreCodeAdminResponse)(nil), "miniwasm.wasmextension.v1.MsgStoreCodeAdminResponse")
+// MsgStoreCodesAdmin submit multiple Wasm codes to the system with admin
+// permission
+type MsgStoreCodesAdmin struct {
+	// Authority is the actor that signed the messages
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Creator is the actor that created the codes
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Codes are the codes to store, in order
+	Codes []WasmCode `protobuf:"bytes,3,rep,name=codes,proto3" json:"codes"`
 }
 
-func init() {
-	proto.RegisterFile("miniwasm/wasmextension/v1/tx.proto", fileDescriptor_c0bd85b49373c8e9)
+func (m *MsgStoreCodesAdmin) Reset()         { *m = MsgStoreCodesAdmin{} }
+func (m *MsgStoreCodesAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgStoreCodesAdmin) ProtoMessage()    {}
+func (*MsgStoreCodesAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{2}
 }
-
-var fileDescriptor_c0bd85b49373c8e9 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x6d, 0x49, 0xe9, 0x11, 0x55, 0xd4, 0x2a, 0xe0, 0x78, 0x70, 0xa2, 0x20, 0x44,
-	0x14, 0x51, 0x5b, 0x0d, 0xd0, 0x81, 0x2d, 0x2e, 0x4b, 0x87, 0x48, 0xc8, 0x19, 0x90, 0x10, 0xc2,
-	0x72, 0xec, 0xe3, 0x72, 0x82, 0xbb, 0x8b, 0xfc, 0x2e, 0x25, 0xd9, 0x50, 0x47, 0x26, 0x3e, 0x4a,
-	0x06, 0x3e, 0x44, 0x25, 0x96, 0x8a, 0x89, 0xa9, 0x02, 0x67, 0xc8, 0xd7, 0x40, 0xe7, 0xd8, 0x29,
-	0x6d, 0x44, 0x51, 0x97, 0x93, 0xdf, 0x7b, 0xbf, 0x7b, 0xfe, 0xbf, 0xff, 0x3d, 0xdc, 0xe0, 0x4c,
-	0xb0, 0x4f, 0x21, 0x70, 0x57, 0x1f, 0x64, 0xac, 0x88, 0x00, 0x26, 0x85, 0x7b, 0xbc, 0xef, 0xaa,
-	0xb1, 0x33, 0x4c, 0xa4, 0x92, 0x46, 0xb5, 0x60, 0x9c, 0x4b, 0x8c, 0x73, 0xbc, 0x6f, 0xed, 0x84,
-	0x9c, 0x09, 0xe9, 0x66, 0xe7, 0x82, 0xb6, 0x1e, 0x44, 0x12, 0xb8, 0x04, 0x97, 0x03, 0xd5, 0x5d,
-	0x38, 0xd0, 0xbc, 0x50, 0x5d, 0x14, 0x82, 0x2c, 0x72, 0x17, 0x41, 0x5e, 0xda, 0xa5, 0x92, 0xca,
-	0x45, 0x5e, 0x7f, 0xe5, 0xd9, 0x47, 0xd7, 0x68, 0x9b, 0x0c, 0x49, 0x7e, 0xb9, 0xf1, 0x7d, 0x0d,
-	0xef, 0x74, 0x81, 0xf6, 0x94, 0x4c, 0xc8, 0xa1, 0x8c, 0x49, 0x27, 0xe6, 0x4c, 0x18, 0x07, 0x78,
-	0x2b, 0x1c, 0xa9, 0x81, 0x4c, 0x98, 0x9a, 0x98, 0xa8, 0x8e, 0x9a, 0x5b, 0x9e, 0xf9, 0xe3, 0xdb,
-	0xde, 0x6e, 0xfe, 0xdf, 0x4e, 0x1c, 0x27, 0x04, 0xa0, 0xa7, 0x12, 0x26, 0xa8, 0x7f, 0x81, 0x1a,
-	0x6d, 0xbc, 0x19, 0x25, 0x24, 0x54, 0x32, 0x31, 0xd7, 0xfe, 0x73, 0xab, 0x00, 0x8d, 0x03, 0xbc,
-	0xad, 0x15, 0x06, 0xfd, 0x89, 0x22, 0x41, 0x24, 0x63, 0x62, 0xae, 0xd7, 0x51, 0xb3, 0xe2, 0xdd,
-	0x4d, 0xcf, 0x6b, 0x95, 0xd7, 0x9d, 0x5e, 0xd7, 0x9b, 0xa8, 0x4c, 0x9a, 0x5f, 0xd1, 0x5c, 0x11,
-	0x19, 0xef, 0xf0, 0x7d, 0x26, 0x40, 0x85, 0x42, 0xb1, 0x50, 0x91, 0x60, 0x48, 0x12, 0xce, 0x40,
-	0x8f, 0x68, 0x6e, 0xd4, 0x51, 0xf3, 0x4e, 0xfb, 0xb1, 0xf3, 0x4f, 0xe7, 0x9d, 0x4e, 0x14, 0x11,
-	0x80, 0x43, 0x29, 0xde, 0x33, 0xea, 0xdf, 0xfb, 0xab, 0xcd, 0xab, 0x65, 0x97, 0x17, 0xed, 0x93,
-	0xf9, 0xb4, 0x75, 0x31, 0xdb, 0x97, 0xf9, 0xb4, 0x55, 0xbb, 0x6c, 0xe5, 0x8a, 0x6f, 0x8d, 0xb7,
-	0xb8, 0xba, 0x92, 0xf4, 0x09, 0x0c, 0xa5, 0x00, 0x62, 0x3c, 0xc4, 0x9b, 0x7a, 0xbc, 0x80, 0xc5,
-	0x99, 0xa5, 0x1b, 0x1e, 0x4e, 0xcf, 0x6b, 0x65, 0xcd, 0x1d, 0xbd, 0xf4, 0xcb, 0xba, 0x74, 0x14,
-	0x1b, 0x16, 0xbe, 0x1d, 0x0d, 0x48, 0xf4, 0x01, 0x46, 0x3c, 0xb3, 0xb0, 0xe2, 0x2f, 0xe3, 0xf6,
-	0x09, 0xc2, 0xeb, 0x5d, 0xa0, 0x86, 0xc2, 0xdb, 0x57, 0xde, 0xeb, 0xc9, 0x35, 0xb3, 0xae, 0x08,
-	0xb2, 0x9e, 0xdd, 0x84, 0x2e, 0xe4, 0x5b, 0xb7, 0x3e, 0xcf, 0xa7, 0x2d, 0xe4, 0xf5, 0x4e, 0x7f,
-	0xdb, 0xa5, 0xd3, 0xd4, 0x46, 0x67, 0xa9, 0x8d, 0x7e, 0xa5, 0x36, 0xfa, 0x3a, 0xb3, 0x4b, 0x67,
-	0x33, 0xbb, 0xf4, 0x73, 0x66, 0x97, 0xde, 0x3c, 0xa7, 0x4c, 0x0d, 0x46, 0x7d, 0x27, 0x92, 0xdc,
-	0x65, 0x82, 0x29, 0x16, 0xee, 0x7d, 0x0c, 0xfb, 0xe0, 0x2e, 0x97, 0x71, 0x7c, 0x65, 0x1d, 0xb3,
-	0x5d, 0xec, 0x97, 0xb3, 0x65, 0x7c, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0xea, 0x8f, 0xe6, 0x32,
-	0x51, 0x03, 0x00, 0x00,
+func (m *MsgStoreCodesAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStoreCodesAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStoreCodesAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStoreCodesAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStoreCodesAdmin.Merge(m, src)
+}
+func (m *MsgStoreCodesAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStoreCodesAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStoreCodesAdmin.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgStoreCodesAdmin proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// StoreCodeAdmin to submit Wasm code to the system with admin permission
-	StoreCodeAdmin(ctx context.Context, in *MsgStoreCodeAdmin, opts ...grpc.CallOption) (*MsgStoreCodeAdminResponse, error)
+// MsgStoreCodesAdminResponse returns store result data.
+type MsgStoreCodesAdminResponse struct {
+	// CodeIDs are the references to the stored WASM codes, in order
+	CodeIDs []uint64 `protobuf:"varint,1,rep,packed,name=code_ids,json=codeIds,proto3" json:"code_ids,omitempty"`
+	// Checksums are the sha256 hashes of the stored codes, in order
+	Checksums [][]byte `protobuf:"bytes,2,rep,name=checksums,proto3" json:"checksums,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgStoreCodesAdminResponse) Reset()         { *m = MsgStoreCodesAdminResponse{} }
+func (m *MsgStoreCodesAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStoreCodesAdminResponse) ProtoMessage()    {}
+func (*MsgStoreCodesAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{3}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgStoreCodesAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) StoreCodeAdmin(ctx context.Context, in *MsgStoreCodeAdmin, opts ...grpc.CallOption) (*MsgStoreCodeAdminResponse, error) {
-	out := new(MsgStoreCodeAdminResponse)
-	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Msg/StoreCodeAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgStoreCodesAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStoreCodesAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// StoreCodeAdmin to submit Wasm code to the system with admin permission
-	StoreCodeAdmin(context.Context, *MsgStoreCodeAdmin) (*MsgStoreCodeAdminResponse, error)
+func (m *MsgStoreCodesAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStoreCodesAdminResponse.Merge(m, src)
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgStoreCodesAdminResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (*UnimplementedMsgServer) StoreCodeAdmin(ctx context.Context, req *MsgStoreCodeAdmin) (*MsgStoreCodeAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoreCodeAdmin not implemented")
+func (m *MsgStoreCodesAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStoreCodesAdminResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgStoreCodesAdminResponse proto.InternalMessageInfo
+
+// MsgStoreAndInstantiateAdmin submit Wasm code to the system and instantiate
+// a contract from it with admin permission
+type MsgStoreAndInstantiateAdmin struct {
+	// Authority is the actor that signed the messages
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Creator is the actor that created the code and instantiates the contract
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// WASMByteCode can be raw or gzip compressed
+	WASMByteCode []byte `protobuf:"bytes,3,opt,name=wasm_byte_code,json=wasmByteCode,proto3" json:"wasm_byte_code,omitempty"`
+	// InstantiatePermission access control to apply on contract creation,
+	// optional
+	InstantiatePermission *AccessConfig `protobuf:"bytes,4,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission,omitempty"`
+	// Admin is an optional address that can execute migrations
+	Admin string `protobuf:"bytes,5,opt,name=admin,proto3" json:"admin,omitempty"`
+	// Label is optional metadata to be stored with a contract instance.
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	// Msg json encoded message to be passed to the contract on instantiation
+	Msg github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,7,opt,name=msg,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"msg,omitempty"`
 }
 
-func _Msg_StoreCodeAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStoreCodeAdmin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StoreCodeAdmin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/miniwasm.wasmextension.v1.Msg/StoreCodeAdmin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StoreCodeAdmin(ctx, req.(*MsgStoreCodeAdmin))
+func (m *MsgStoreAndInstantiateAdmin) Reset()         { *m = MsgStoreAndInstantiateAdmin{} }
+func (m *MsgStoreAndInstantiateAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgStoreAndInstantiateAdmin) ProtoMessage()    {}
+func (*MsgStoreAndInstantiateAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{4}
+}
+func (m *MsgStoreAndInstantiateAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStoreAndInstantiateAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStoreAndInstantiateAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgStoreAndInstantiateAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStoreAndInstantiateAdmin.Merge(m, src)
+}
+func (m *MsgStoreAndInstantiateAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStoreAndInstantiateAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStoreAndInstantiateAdmin.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "miniwasm.wasmextension.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "StoreCodeAdmin",
-			Handler:    _Msg_StoreCodeAdmin_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "miniwasm/wasmextension/v1/tx.proto",
+var xxx_messageInfo_MsgStoreAndInstantiateAdmin proto.InternalMessageInfo
+
+// MsgStoreAndInstantiateAdminResponse returns store and instantiate result
+// data.
+type MsgStoreAndInstantiateAdminResponse struct {
+	// CodeID is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Checksum is the sha256 hash of the stored code
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// Address is the bech32 address of the new contract instance.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Data contains bytes to returned from the contract
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *MsgStoreCodeAdmin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgStoreAndInstantiateAdminResponse) Reset()         { *m = MsgStoreAndInstantiateAdminResponse{} }
+func (m *MsgStoreAndInstantiateAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStoreAndInstantiateAdminResponse) ProtoMessage()    {}
+func (*MsgStoreAndInstantiateAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{5}
+}
+func (m *MsgStoreAndInstantiateAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStoreAndInstantiateAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStoreAndInstantiateAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgStoreAndInstantiateAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStoreAndInstantiateAdminResponse.Merge(m, src)
+}
+func (m *MsgStoreAndInstantiateAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStoreAndInstantiateAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStoreAndInstantiateAdminResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStoreCodeAdmin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgStoreAndInstantiateAdminResponse proto.InternalMessageInfo
+
+// MsgUpdateCodeAccessConfigAdmin updates the instantiate permission of a code
+// with admin permission
+type MsgUpdateCodeAccessConfigAdmin struct {
+	// Authority is the actor that signed the messages
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// CodeID references the stored WASM code
+	CodeID uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// NewInstantiatePermission is the new access control
+	NewInstantiatePermission *AccessConfig `protobuf:"bytes,3,opt,name=new_instantiate_permission,json=newInstantiatePermission,proto3" json:"new_instantiate_permission,omitempty"`
 }
 
-func (m *MsgStoreCodeAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InstantiatePermission != nil {
-		{
-			size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUpdateCodeAccessConfigAdmin) Reset()         { *m = MsgUpdateCodeAccessConfigAdmin{} }
+func (m *MsgUpdateCodeAccessConfigAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCodeAccessConfigAdmin) ProtoMessage()    {}
+func (*MsgUpdateCodeAccessConfigAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{6}
+}
+func (m *MsgUpdateCodeAccessConfigAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCodeAccessConfigAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCodeAccessConfigAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.WASMByteCode) > 0 {
-		i -= len(m.WASMByteCode)
-		copy(dAtA[i:], m.WASMByteCode)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WASMByteCode)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgStoreCodeAdminResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateCodeAccessConfigAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCodeAccessConfigAdmin.Merge(m, src)
+}
+func (m *MsgUpdateCodeAccessConfigAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCodeAccessConfigAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCodeAccessConfigAdmin.DiscardUnknown(m)
 }
 
-func (m *MsgStoreCodeAdminResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateCodeAccessConfigAdmin proto.InternalMessageInfo
+
+// MsgUpdateCodeAccessConfigAdminResponse defines the response structure for
+// executing a MsgUpdateCodeAccessConfigAdmin message.
+type MsgUpdateCodeAccessConfigAdminResponse struct {
 }
 
-func (m *MsgStoreCodeAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Checksum) > 0 {
-		i -= len(m.Checksum)
-		copy(dAtA[i:], m.Checksum)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CodeID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgUpdateCodeAccessConfigAdminResponse) Reset() {
+	*m = MsgUpdateCodeAccessConfigAdminResponse{}
+}
+func (m *MsgUpdateCodeAccessConfigAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCodeAccessConfigAdminResponse) ProtoMessage()    {}
+func (*MsgUpdateCodeAccessConfigAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{7}
+}
+func (m *MsgUpdateCodeAccessConfigAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCodeAccessConfigAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCodeAccessConfigAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateCodeAccessConfigAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCodeAccessConfigAdminResponse.Merge(m, src)
+}
+func (m *MsgUpdateCodeAccessConfigAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCodeAccessConfigAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCodeAccessConfigAdminResponse.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
+var xxx_messageInfo_MsgUpdateCodeAccessConfigAdminResponse proto.InternalMessageInfo
+
+// MsgMigrateContractAdmin migrates a contract to a new code version with admin
+// permission, regardless of the contract admin
+type MsgMigrateContractAdmin struct {
+	// Authority is the actor that signed the messages
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// CodeID references the new WASM code
+	CodeID uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Msg json encoded message to be passed to the contract on migration
+	Msg github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,4,opt,name=msg,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"msg,omitempty"`
+}
+
+func (m *MsgMigrateContractAdmin) Reset()         { *m = MsgMigrateContractAdmin{} }
+func (m *MsgMigrateContractAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateContractAdmin) ProtoMessage()    {}
+func (*MsgMigrateContractAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{8}
+}
+func (m *MsgMigrateContractAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateContractAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateContractAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateContractAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateContractAdmin.Merge(m, src)
+}
+func (m *MsgMigrateContractAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateContractAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateContractAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateContractAdmin proto.InternalMessageInfo
+
+// MsgMigrateContractAdminResponse returns contract migration result data.
+type MsgMigrateContractAdminResponse struct {
+	// Data contains same raw bytes returned as data from the wasm contract.
+	// (May be empty)
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MsgMigrateContractAdminResponse) Reset()         { *m = MsgMigrateContractAdminResponse{} }
+func (m *MsgMigrateContractAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateContractAdminResponse) ProtoMessage()    {}
+func (*MsgMigrateContractAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{9}
+}
+func (m *MsgMigrateContractAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateContractAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateContractAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateContractAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateContractAdminResponse.Merge(m, src)
+}
+func (m *MsgMigrateContractAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateContractAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateContractAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateContractAdminResponse proto.InternalMessageInfo
+
+// MsgSetContractAdminAdmin sets the admin of a contract with admin
+// permission, regardless of the current contract admin
+type MsgSetContractAdminAdmin struct {
+	// Authority is the actor that signed the messages
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// NewAdmin is the address of the new contract admin. Empty value clears the
+	// contract admin.
+	NewAdmin string `protobuf:"bytes,3,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
+}
+
+func (m *MsgSetContractAdminAdmin) Reset()         { *m = MsgSetContractAdminAdmin{} }
+func (m *MsgSetContractAdminAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractAdminAdmin) ProtoMessage()    {}
+func (*MsgSetContractAdminAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{10}
+}
+func (m *MsgSetContractAdminAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractAdminAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractAdminAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetContractAdminAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractAdminAdmin.Merge(m, src)
+}
+func (m *MsgSetContractAdminAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractAdminAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractAdminAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetContractAdminAdmin proto.InternalMessageInfo
+
+// MsgSetContractAdminAdminResponse defines the response structure for
+// executing a MsgSetContractAdminAdmin message.
+type MsgSetContractAdminAdminResponse struct {
+}
+
+func (m *MsgSetContractAdminAdminResponse) Reset()         { *m = MsgSetContractAdminAdminResponse{} }
+func (m *MsgSetContractAdminAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractAdminAdminResponse) ProtoMessage()    {}
+func (*MsgSetContractAdminAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{11}
+}
+func (m *MsgSetContractAdminAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractAdminAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractAdminAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetContractAdminAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractAdminAdminResponse.Merge(m, src)
+}
+func (m *MsgSetContractAdminAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractAdminAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractAdminAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetContractAdminAdminResponse proto.InternalMessageInfo
+
+// MsgRegisterChecksums registers code checksums which are allowed to be
+// stored with admin permission
+type MsgRegisterChecksums struct {
+	// Authority is the address that controls the module
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Checksums are the checksums to register with their provenance metadata.
+	// The metadata of an already registered checksum is overwritten.
+	Checksums []RegisteredChecksum `protobuf:"bytes,2,rep,name=checksums,proto3" json:"checksums"`
+}
+
+func (m *MsgRegisterChecksums) Reset()         { *m = MsgRegisterChecksums{} }
+func (m *MsgRegisterChecksums) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterChecksums) ProtoMessage()    {}
+func (*MsgRegisterChecksums) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{12}
+}
+func (m *MsgRegisterChecksums) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterChecksums) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterChecksums.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterChecksums) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterChecksums.Merge(m, src)
+}
+func (m *MsgRegisterChecksums) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterChecksums) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterChecksums.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterChecksums proto.InternalMessageInfo
+
+// MsgRegisterChecksumsResponse defines the response structure for executing a
+// MsgRegisterChecksums message.
+type MsgRegisterChecksumsResponse struct {
+}
+
+func (m *MsgRegisterChecksumsResponse) Reset()         { *m = MsgRegisterChecksumsResponse{} }
+func (m *MsgRegisterChecksumsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterChecksumsResponse) ProtoMessage()    {}
+func (*MsgRegisterChecksumsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{13}
+}
+func (m *MsgRegisterChecksumsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterChecksumsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterChecksumsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterChecksumsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterChecksumsResponse.Merge(m, src)
+}
+func (m *MsgRegisterChecksumsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterChecksumsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterChecksumsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterChecksumsResponse proto.InternalMessageInfo
+
+// MsgUnregisterChecksums removes code checksums from the registry
+type MsgUnregisterChecksums struct {
+	// Authority is the address that controls the module
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Checksums are the sha256 hashes to remove from the registry
+	Checksums [][]byte `protobuf:"bytes,2,rep,name=checksums,proto3" json:"checksums,omitempty"`
+}
+
+func (m *MsgUnregisterChecksums) Reset()         { *m = MsgUnregisterChecksums{} }
+func (m *MsgUnregisterChecksums) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterChecksums) ProtoMessage()    {}
+func (*MsgUnregisterChecksums) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{14}
+}
+func (m *MsgUnregisterChecksums) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterChecksums) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterChecksums.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnregisterChecksums) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterChecksums.Merge(m, src)
+}
+func (m *MsgUnregisterChecksums) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterChecksums) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterChecksums.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnregisterChecksums proto.InternalMessageInfo
+
+// MsgUnregisterChecksumsResponse defines the response structure for executing
+// a MsgUnregisterChecksums message.
+type MsgUnregisterChecksumsResponse struct {
+}
+
+func (m *MsgUnregisterChecksumsResponse) Reset()         { *m = MsgUnregisterChecksumsResponse{} }
+func (m *MsgUnregisterChecksumsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterChecksumsResponse) ProtoMessage()    {}
+func (*MsgUnregisterChecksumsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{15}
+}
+func (m *MsgUnregisterChecksumsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterChecksumsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterChecksumsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnregisterChecksumsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterChecksumsResponse.Merge(m, src)
+}
+func (m *MsgUnregisterChecksumsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterChecksumsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterChecksumsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnregisterChecksumsResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// Authority is the address that controls the module
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Params defines the x/wasmextension parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{16}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{17}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgAddAcceptedStargateMsgs adds msg type urls to the stargate msg allowlist
+type MsgAddAcceptedStargateMsgs struct {
+	// Authority is the address that controls the module
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// TypeURLs are the msg type urls to accept, e.g. /cosmos.bank.v1beta1.MsgSend
+	TypeURLs []string `protobuf:"bytes,2,rep,name=type_urls,json=typeUrls,proto3" json:"type_urls,omitempty"`
+}
+
+func (m *MsgAddAcceptedStargateMsgs) Reset()         { *m = MsgAddAcceptedStargateMsgs{} }
+func (m *MsgAddAcceptedStargateMsgs) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAcceptedStargateMsgs) ProtoMessage()    {}
+func (*MsgAddAcceptedStargateMsgs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{18}
+}
+func (m *MsgAddAcceptedStargateMsgs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAcceptedStargateMsgs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAcceptedStargateMsgs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAcceptedStargateMsgs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAcceptedStargateMsgs.Merge(m, src)
+}
+func (m *MsgAddAcceptedStargateMsgs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAcceptedStargateMsgs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAcceptedStargateMsgs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAcceptedStargateMsgs proto.InternalMessageInfo
+
+// MsgAddAcceptedStargateMsgsResponse defines the response structure for
+// executing a MsgAddAcceptedStargateMsgs message.
+type MsgAddAcceptedStargateMsgsResponse struct {
+}
+
+func (m *MsgAddAcceptedStargateMsgsResponse) Reset()         { *m = MsgAddAcceptedStargateMsgsResponse{} }
+func (m *MsgAddAcceptedStargateMsgsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAcceptedStargateMsgsResponse) ProtoMessage()    {}
+func (*MsgAddAcceptedStargateMsgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{19}
+}
+func (m *MsgAddAcceptedStargateMsgsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAcceptedStargateMsgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAcceptedStargateMsgsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAcceptedStargateMsgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAcceptedStargateMsgsResponse.Merge(m, src)
+}
+func (m *MsgAddAcceptedStargateMsgsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAcceptedStargateMsgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAcceptedStargateMsgsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAcceptedStargateMsgsResponse proto.InternalMessageInfo
+
+// MsgRemoveAcceptedStargateMsgs removes msg type urls from the stargate msg
+// allowlist
+type MsgRemoveAcceptedStargateMsgs struct {
+	// Authority is the address that controls the module
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// TypeURLs are the msg type urls to remove from the allowlist
+	TypeURLs []string `protobuf:"bytes,2,rep,name=type_urls,json=typeUrls,proto3" json:"type_urls,omitempty"`
+}
+
+func (m *MsgRemoveAcceptedStargateMsgs) Reset()         { *m = MsgRemoveAcceptedStargateMsgs{} }
+func (m *MsgRemoveAcceptedStargateMsgs) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAcceptedStargateMsgs) ProtoMessage()    {}
+func (*MsgRemoveAcceptedStargateMsgs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{20}
+}
+func (m *MsgRemoveAcceptedStargateMsgs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAcceptedStargateMsgs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAcceptedStargateMsgs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAcceptedStargateMsgs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAcceptedStargateMsgs.Merge(m, src)
+}
+func (m *MsgRemoveAcceptedStargateMsgs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAcceptedStargateMsgs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAcceptedStargateMsgs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAcceptedStargateMsgs proto.InternalMessageInfo
+
+// MsgRemoveAcceptedStargateMsgsResponse defines the response structure for
+// executing a MsgRemoveAcceptedStargateMsgs message.
+type MsgRemoveAcceptedStargateMsgsResponse struct {
+}
+
+func (m *MsgRemoveAcceptedStargateMsgsResponse) Reset()         { *m = MsgRemoveAcceptedStargateMsgsResponse{} }
+func (m *MsgRemoveAcceptedStargateMsgsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAcceptedStargateMsgsResponse) ProtoMessage()    {}
+func (*MsgRemoveAcceptedStargateMsgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{21}
+}
+func (m *MsgRemoveAcceptedStargateMsgsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAcceptedStargateMsgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAcceptedStargateMsgsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAcceptedStargateMsgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAcceptedStargateMsgsResponse.Merge(m, src)
+}
+func (m *MsgRemoveAcceptedStargateMsgsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAcceptedStargateMsgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAcceptedStargateMsgsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAcceptedStargateMsgsResponse proto.InternalMessageInfo
+
+// MsgRetryFailedCallback retries a failed ibc lifecycle callback
+type MsgRetryFailedCallback struct {
+	// Sender is the actor that signed the messages
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ID is the identifier of the failed callback
+	ID uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRetryFailedCallback) Reset()         { *m = MsgRetryFailedCallback{} }
+func (m *MsgRetryFailedCallback) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryFailedCallback) ProtoMessage()    {}
+func (*MsgRetryFailedCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{22}
+}
+func (m *MsgRetryFailedCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryFailedCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryFailedCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryFailedCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryFailedCallback.Merge(m, src)
+}
+func (m *MsgRetryFailedCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryFailedCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryFailedCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryFailedCallback proto.InternalMessageInfo
+
+// MsgRetryFailedCallbackResponse defines the response structure for executing
+// a MsgRetryFailedCallback message.
+type MsgRetryFailedCallbackResponse struct {
+	// Success is true when the callback is executed and removed from the queue.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Dropped is true when the retry failed and the callback is removed from the
+	// queue as it reached the max retries.
+	Dropped bool `protobuf:"varint,2,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (m *MsgRetryFailedCallbackResponse) Reset()         { *m = MsgRetryFailedCallbackResponse{} }
+func (m *MsgRetryFailedCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryFailedCallbackResponse) ProtoMessage()    {}
+func (*MsgRetryFailedCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0bd85b49373c8e9, []int{23}
+}
+func (m *MsgRetryFailedCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryFailedCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryFailedCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryFailedCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryFailedCallbackResponse.Merge(m, src)
+}
+func (m *MsgRetryFailedCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryFailedCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryFailedCallbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryFailedCallbackResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgStoreCodeAdmin)(nil), "miniwasm.wasmextension.v1.MsgStoreCodeAdmin")
+	proto.RegisterType((*MsgStoreCodeAdminResponse)(nil), "miniwasm.wasmextension.v1.MsgStoreCodeAdminResponse")
+	proto.RegisterType((*MsgStoreCodesAdmin)(nil), "miniwasm.wasmextension.v1.MsgStoreCodesAdmin")
+	proto.RegisterType((*MsgStoreCodesAdminResponse)(nil), "miniwasm.wasmextension.v1.MsgStoreCodesAdminResponse")
+	proto.RegisterType((*MsgStoreAndInstantiateAdmin)(nil), "miniwasm.wasmextension.v1.MsgStoreAndInstantiateAdmin")
+	proto.RegisterType((*MsgStoreAndInstantiateAdminResponse)(nil), "miniwasm.wasmextension.v1.MsgStoreAndInstantiateAdminResponse")
+	proto.RegisterType((*MsgUpdateCodeAccessConfigAdmin)(nil), "miniwasm.wasmextension.v1.MsgUpdateCodeAccessConfigAdmin")
+	proto.RegisterType((*MsgUpdateCodeAccessConfigAdminResponse)(nil), "miniwasm.wasmextension.v1.MsgUpdateCodeAccessConfigAdminResponse")
+	proto.RegisterType((*MsgMigrateContractAdmin)(nil), "miniwasm.wasmextension.v1.MsgMigrateContractAdmin")
+	proto.RegisterType((*MsgMigrateContractAdminResponse)(nil), "miniwasm.wasmextension.v1.MsgMigrateContractAdminResponse")
+	proto.RegisterType((*MsgSetContractAdminAdmin)(nil), "miniwasm.wasmextension.v1.MsgSetContractAdminAdmin")
+	proto.RegisterType((*MsgSetContractAdminAdminResponse)(nil), "miniwasm.wasmextension.v1.MsgSetContractAdminAdminResponse")
+	proto.RegisterType((*MsgRegisterChecksums)(nil), "miniwasm.wasmextension.v1.MsgRegisterChecksums")
+	proto.RegisterType((*MsgRegisterChecksumsResponse)(nil), "miniwasm.wasmextension.v1.MsgRegisterChecksumsResponse")
+	proto.RegisterType((*MsgUnregisterChecksums)(nil), "miniwasm.wasmextension.v1.MsgUnregisterChecksums")
+	proto.RegisterType((*MsgUnregisterChecksumsResponse)(nil), "miniwasm.wasmextension.v1.MsgUnregisterChecksumsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "miniwasm.wasmextension.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "miniwasm.wasmextension.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAddAcceptedStargateMsgs)(nil), "miniwasm.wasmextension.v1.MsgAddAcceptedStargateMsgs")
+	proto.RegisterType((*MsgAddAcceptedStargateMsgsResponse)(nil), "miniwasm.wasmextension.v1.MsgAddAcceptedStargateMsgsResponse")
+	proto.RegisterType((*MsgRemoveAcceptedStargateMsgs)(nil), "miniwasm.wasmextension.v1.MsgRemoveAcceptedStargateMsgs")
+	proto.RegisterType((*MsgRemoveAcceptedStargateMsgsResponse)(nil), "miniwasm.wasmextension.v1.MsgRemoveAcceptedStargateMsgsResponse")
+	proto.RegisterType((*MsgRetryFailedCallback)(nil), "miniwasm.wasmextension.v1.MsgRetryFailedCallback")
+	proto.RegisterType((*MsgRetryFailedCallbackResponse)(nil), "miniwasm.wasmextension.v1.MsgRetryFailedCallbackResponse")
+}
+
+func init() {
+	proto.RegisterFile("miniwasm/wasmextension/v1/tx.proto", fileDescriptor_c0bd85b49373c8e9)
+}
+
+var fileDescriptor_c0bd85b49373c8e9 = []byte{
+	// 1434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x89, 0x63, 0xbf, 0x58, 0xed, 0xb7, 0xfb, 0x4d, 0x9b, 0xcd, 0xb6, 0xb5, 0xfd,
+	0xdd, 0xfe, 0x72, 0xf3, 0x6d, 0xec, 0xc6, 0x6d, 0xda, 0xc6, 0x85, 0x82, 0x9d, 0x08, 0x29, 0x12,
+	0x96, 0xaa, 0x4d, 0x4b, 0x25, 0x84, 0xb0, 0xd6, 0xde, 0x61, 0xbb, 0xd4, 0xbb, 0x6b, 0xed, 0x6c,
+	0x9a, 0xe4, 0x80, 0x84, 0x40, 0xaa, 0x04, 0x17, 0x10, 0xa7, 0x0a, 0xce, 0x08, 0x4e, 0x28, 0x07,
+	0x8e, 0x5c, 0xe0, 0x42, 0x25, 0x2e, 0x15, 0x27, 0x2e, 0x58, 0xe0, 0x1e, 0x72, 0xe0, 0x3f, 0x28,
+	0x17, 0x34, 0xbb, 0xde, 0x89, 0x93, 0xd9, 0x5d, 0xc7, 0x6e, 0xa1, 0x17, 0x2e, 0x89, 0x67, 0xe6,
+	0xbd, 0x37, 0xef, 0xf3, 0x79, 0x3f, 0x66, 0x66, 0x41, 0x32, 0x74, 0x53, 0xdf, 0x50, 0xb0, 0x51,
+	0x24, 0x7f, 0xd0, 0xa6, 0x83, 0x4c, 0xac, 0x5b, 0x66, 0xf1, 0xfe, 0x42, 0xd1, 0xd9, 0x2c, 0xb4,
+	0x6d, 0xcb, 0xb1, 0xf8, 0x59, 0x5f, 0xa6, 0xb0, 0x47, 0xa6, 0x70, 0x7f, 0x41, 0x3c, 0xa2, 0x18,
+	0xba, 0x69, 0x15, 0xdd, 0xbf, 0x9e, 0xb4, 0x38, 0xd3, 0xb4, 0xb0, 0x61, 0xe1, 0xa2, 0x81, 0x35,
+	0x62, 0xc5, 0xc0, 0x5a, 0x6f, 0x61, 0xd6, 0x5b, 0xa8, 0xbb, 0xa3, 0xa2, 0x37, 0xe8, 0x2d, 0x4d,
+	0x6b, 0x96, 0x66, 0x79, 0xf3, 0xe4, 0x57, 0x6f, 0xf6, 0x4c, 0x84, 0x6f, 0x5b, 0x6d, 0xd4, 0x53,
+	0x96, 0x7e, 0x8a, 0xc1, 0x91, 0x1a, 0xd6, 0xd6, 0x1c, 0xcb, 0x46, 0xcb, 0x96, 0x8a, 0x2a, 0xaa,
+	0xa1, 0x9b, 0xfc, 0x15, 0x48, 0x29, 0xeb, 0xce, 0x5d, 0xcb, 0xd6, 0x9d, 0x2d, 0x81, 0xcb, 0x71,
+	0xf9, 0x54, 0x55, 0xf8, 0xf9, 0xdb, 0xf9, 0xe9, 0xde, 0xbe, 0x15, 0x55, 0xb5, 0x11, 0xc6, 0x6b,
+	0x8e, 0xad, 0x9b, 0x9a, 0xbc, 0x2b, 0xca, 0x97, 0x60, 0xb2, 0x69, 0x23, 0xc5, 0xb1, 0x6c, 0x21,
+	0x36, 0x40, 0xcb, 0x17, 0xe4, 0xaf, 0xc0, 0x21, 0xe2, 0x61, 0xbd, 0xb1, 0xe5, 0xa0, 0x7a, 0xd3,
+	0x52, 0x91, 0x10, 0xcf, 0x71, 0xf9, 0x74, 0xf5, 0x3f, 0xdd, 0x4e, 0x36, 0x7d, 0xa7, 0xb2, 0x56,
+	0xab, 0x6e, 0x39, 0xae, 0x6b, 0x72, 0x9a, 0xc8, 0xf9, 0x23, 0xfe, 0x6d, 0x38, 0xa6, 0x9b, 0xd8,
+	0x51, 0x4c, 0x47, 0x57, 0x1c, 0x54, 0x6f, 0x23, 0xdb, 0xd0, 0x31, 0x81, 0x28, 0x8c, 0xe7, 0xb8,
+	0xfc, 0x54, 0xe9, 0x5c, 0x21, 0x94, 0xf9, 0x42, 0xa5, 0xd9, 0x44, 0x18, 0x2f, 0x5b, 0xe6, 0x3b,
+	0xba, 0x26, 0x1f, 0xed, 0x33, 0x73, 0x93, 0x5a, 0x29, 0x97, 0x3e, 0xd8, 0xd9, 0x9e, 0xdb, 0xc5,
+	0xf6, 0xf1, 0xce, 0xf6, 0x5c, 0x76, 0x2f, 0x95, 0x0c, 0x6f, 0xd2, 0x5b, 0x30, 0xcb, 0x4c, 0xca,
+	0x08, 0xb7, 0x2d, 0x13, 0x23, 0xfe, 0x14, 0x4c, 0x12, 0x78, 0x75, 0x5d, 0x75, 0x29, 0x1d, 0xaf,
+	0x42, 0xb7, 0x93, 0x4d, 0x10, 0xb9, 0xd5, 0x15, 0x39, 0x41, 0x96, 0x56, 0x55, 0x5e, 0x84, 0x64,
+	0xf3, 0x2e, 0x6a, 0xde, 0xc3, 0xeb, 0x86, 0x4b, 0x61, 0x5a, 0xa6, 0x63, 0xe9, 0x4f, 0x0e, 0xf8,
+	0x7e, 0xf3, 0xf8, 0x9f, 0x0f, 0xd6, 0x0a, 0x4c, 0x10, 0x47, 0xb1, 0x10, 0xcf, 0xc5, 0xf3, 0x53,
+	0xa5, 0x53, 0x11, 0x1c, 0xdf, 0x51, 0xb0, 0x41, 0xbc, 0xac, 0xa6, 0x1e, 0x75, 0xb2, 0x63, 0x5f,
+	0xef, 0x6c, 0xcf, 0x71, 0xb2, 0xa7, 0x5c, 0xbe, 0xc4, 0x52, 0x9b, 0x0b, 0xa7, 0xd6, 0x83, 0x29,
+	0x35, 0x40, 0x64, 0x67, 0x29, 0xb9, 0x67, 0x21, 0xd9, 0x23, 0x17, 0x0b, 0x5c, 0x2e, 0x9e, 0x1f,
+	0xaf, 0x4e, 0x75, 0x3b, 0xd9, 0x49, 0x8f, 0x5d, 0x2c, 0x4f, 0x7a, 0xf4, 0x62, 0xfe, 0x04, 0xa4,
+	0x7c, 0x3e, 0xb1, 0x10, 0xcb, 0xc5, 0xf3, 0x69, 0x79, 0x77, 0x42, 0x7a, 0x30, 0x0e, 0xc7, 0xfd,
+	0x4d, 0x2a, 0xa6, 0xba, 0xba, 0x9b, 0x18, 0xff, 0xd6, 0x45, 0xcf, 0x0a, 0x5f, 0x80, 0x09, 0x85,
+	0x90, 0x21, 0x4c, 0x0c, 0x40, 0xe2, 0x89, 0xf1, 0xd3, 0x30, 0xd1, 0x52, 0x1a, 0xa8, 0x25, 0x24,
+	0x88, 0xbc, 0xec, 0x0d, 0xf8, 0x3a, 0xc4, 0x0d, 0xac, 0x09, 0x93, 0x2e, 0xa4, 0xda, 0xd3, 0x4e,
+	0x76, 0x49, 0xd3, 0x9d, 0xbb, 0xeb, 0x8d, 0x42, 0xd3, 0x32, 0x8a, 0xcb, 0x16, 0x36, 0xee, 0xf8,
+	0xad, 0x4b, 0x2d, 0x6e, 0xba, 0xff, 0x7b, 0x6d, 0x4b, 0x56, 0x36, 0x96, 0x2d, 0xd3, 0xb1, 0x95,
+	0xa6, 0x53, 0x43, 0x18, 0x2b, 0x1a, 0xfa, 0x7c, 0x67, 0x7b, 0x6e, 0x4a, 0x37, 0x5b, 0xba, 0x89,
+	0xea, 0xef, 0x62, 0xcb, 0x94, 0x89, 0xe5, 0xf2, 0x4b, 0x6c, 0x8e, 0x9d, 0x0f, 0xce, 0xb1, 0x80,
+	0x40, 0x4b, 0xdf, 0x70, 0x70, 0x2a, 0x62, 0xfd, 0xb9, 0xd5, 0x34, 0xc9, 0x0c, 0xc5, 0x63, 0x4d,
+	0x88, 0x0f, 0xe0, 0xd3, 0x17, 0xe4, 0x79, 0x18, 0x57, 0x15, 0x47, 0x71, 0xe3, 0x99, 0x96, 0xdd,
+	0xdf, 0xd2, 0x97, 0x31, 0xc8, 0xd4, 0xb0, 0x76, 0xbb, 0xad, 0x2a, 0x5e, 0x1e, 0xf4, 0x87, 0xf2,
+	0xd9, 0x92, 0xb7, 0x0f, 0x63, 0x2c, 0x14, 0x23, 0x02, 0xd1, 0x44, 0x1b, 0xf5, 0x90, 0xcc, 0x8b,
+	0x0f, 0x97, 0x79, 0x82, 0x89, 0x36, 0x56, 0x03, 0x9b, 0xf2, 0x2b, 0x6c, 0x54, 0x2f, 0x30, 0x51,
+	0x8d, 0x20, 0x41, 0xca, 0xc3, 0xd9, 0x68, 0x09, 0x3f, 0xb4, 0xd2, 0x8f, 0x31, 0x98, 0xa9, 0x61,
+	0xad, 0xa6, 0x6b, 0xb6, 0x2b, 0xeb, 0xe5, 0xdc, 0xb3, 0x51, 0x79, 0x99, 0x74, 0x29, 0xcf, 0xd0,
+	0xc0, 0x46, 0x40, 0x25, 0xfb, 0x03, 0x10, 0x0f, 0x0d, 0x40, 0xaf, 0xa0, 0xc6, 0xff, 0xb6, 0x82,
+	0xba, 0xc6, 0x52, 0x7f, 0x86, 0xa1, 0x3e, 0x88, 0x2d, 0x69, 0x11, 0xb2, 0x21, 0x4b, 0xb4, 0x8e,
+	0xfc, 0x94, 0xe6, 0xfa, 0x52, 0xfa, 0x29, 0x07, 0x02, 0xa9, 0x41, 0xe4, 0xec, 0xd1, 0x79, 0x11,
+	0x11, 0x58, 0x84, 0x14, 0xc9, 0x6e, 0xaf, 0xef, 0x0d, 0xaa, 0xd3, 0xa4, 0x89, 0x36, 0x5c, 0x27,
+	0xcb, 0x4b, 0x2c, 0x65, 0x67, 0xd9, 0x1e, 0x14, 0x84, 0x4f, 0x92, 0x20, 0x17, 0xb6, 0x46, 0x33,
+	0xf4, 0x57, 0x0e, 0xa6, 0x6b, 0x58, 0x93, 0x91, 0xa6, 0x63, 0x07, 0xd9, 0xcb, 0xfe, 0x31, 0x36,
+	0x32, 0x39, 0x6f, 0xec, 0x3f, 0x1c, 0xa7, 0x4a, 0xf3, 0x11, 0x35, 0xeb, 0x6f, 0x8c, 0x54, 0x7f,
+	0xeb, 0xfe, 0xb3, 0x7e, 0xd7, 0x54, 0x79, 0x91, 0xe5, 0x41, 0x62, 0x78, 0x60, 0x60, 0x48, 0x19,
+	0x38, 0x11, 0x34, 0x4f, 0xf1, 0x7f, 0xc5, 0xc1, 0x31, 0x52, 0xcc, 0xa6, 0xfd, 0xdc, 0x18, 0x88,
+	0xbc, 0x1e, 0x94, 0xaf, 0xb2, 0x38, 0x4e, 0xb3, 0xdd, 0x87, 0x75, 0x47, 0xca, 0x41, 0x26, 0x78,
+	0x85, 0x62, 0xf9, 0x9e, 0x83, 0xc3, 0xb4, 0x31, 0xdd, 0x54, 0x6c, 0xe5, 0x19, 0x40, 0xac, 0x40,
+	0xa2, 0xed, 0x5a, 0x70, 0x33, 0x7c, 0xaa, 0xf4, 0xbf, 0x88, 0x18, 0x7a, 0x5b, 0xf5, 0xc7, 0xad,
+	0xa7, 0x5b, 0xbe, 0xc8, 0x82, 0x3d, 0x19, 0xd2, 0x6a, 0x3d, 0x23, 0xd2, 0x2c, 0xcc, 0xec, 0x9b,
+	0xa2, 0xf0, 0xbe, 0xe3, 0xdc, 0xdb, 0x5b, 0x45, 0x55, 0x49, 0xc3, 0x6d, 0x3b, 0x48, 0x5d, 0x73,
+	0x14, 0x5b, 0x53, 0x1c, 0x54, 0xc3, 0xda, 0xe8, 0x48, 0xcf, 0x43, 0x8a, 0x34, 0xb1, 0xfa, 0xba,
+	0xdd, 0xf2, 0xc2, 0x95, 0xaa, 0xa6, 0xbb, 0x9d, 0x6c, 0xf2, 0xd6, 0x56, 0x1b, 0xdd, 0x96, 0x5f,
+	0xc7, 0x72, 0x92, 0x2c, 0xdf, 0xb6, 0x5b, 0xb8, 0x7c, 0x9d, 0x85, 0x93, 0x67, 0xe0, 0x84, 0xf8,
+	0x27, 0x9d, 0x06, 0x29, 0x7c, 0x95, 0x82, 0xfc, 0x81, 0x83, 0x93, 0x6e, 0xc2, 0x1a, 0xd6, 0x7d,
+	0xf4, 0xa2, 0x70, 0xde, 0x60, 0x71, 0xfe, 0x3f, 0xa0, 0xd6, 0xc2, 0x5c, 0x94, 0xce, 0xc1, 0x99,
+	0x48, 0x01, 0x8a, 0xf6, 0xa1, 0x57, 0x7d, 0x32, 0x72, 0xec, 0xad, 0xd7, 0x14, 0xbd, 0x85, 0xd4,
+	0x65, 0xa5, 0xd5, 0x6a, 0x28, 0xcd, 0x7b, 0xfc, 0x45, 0x48, 0x60, 0x64, 0xaa, 0xc8, 0x1e, 0x88,
+	0xb1, 0x27, 0xc7, 0x1f, 0x83, 0x18, 0xbd, 0x5e, 0x24, 0xba, 0x9d, 0x6c, 0x6c, 0x75, 0x45, 0x8e,
+	0xe9, 0x6a, 0xf9, 0x32, 0x41, 0xd3, 0x13, 0x0a, 0x2e, 0xb7, 0x80, 0xfd, 0xa5, 0x5b, 0x90, 0x09,
+	0x5e, 0xa1, 0xe7, 0x8d, 0x00, 0x93, 0x78, 0xdd, 0x3d, 0xfa, 0x5d, 0x17, 0x93, 0xb2, 0x3f, 0x24,
+	0x2b, 0xaa, 0x6d, 0xb5, 0xdb, 0xc8, 0x73, 0x27, 0x29, 0xfb, 0xc3, 0xd2, 0x1f, 0x69, 0x88, 0xd7,
+	0xb0, 0xc6, 0x3b, 0x70, 0x68, 0xdf, 0x73, 0xf9, 0x42, 0x44, 0x81, 0x31, 0xef, 0x41, 0xf1, 0xf2,
+	0x30, 0xd2, 0xd4, 0xe3, 0x0d, 0x38, 0xbc, 0xff, 0xe1, 0x37, 0x7f, 0x40, 0x43, 0x9e, 0xb8, 0xb8,
+	0x38, 0x94, 0x38, 0xdd, 0xf8, 0x33, 0x0e, 0x84, 0xf0, 0x07, 0xd1, 0x01, 0x6c, 0x06, 0xe8, 0x89,
+	0x37, 0x46, 0xd3, 0xa3, 0x4e, 0x7d, 0xc1, 0xc1, 0xf1, 0xa8, 0xbb, 0xee, 0x52, 0xb4, 0xfd, 0x08,
+	0x55, 0xb1, 0x32, 0xb2, 0x2a, 0xf5, 0xee, 0x01, 0x39, 0x98, 0x83, 0xee, 0x8d, 0xa5, 0x68, 0xdb,
+	0x41, 0x3a, 0x62, 0x79, 0x78, 0x1d, 0xea, 0xc8, 0x47, 0x1c, 0x1c, 0x0d, 0xbe, 0x3f, 0x5d, 0x1a,
+	0x10, 0x80, 0x20, 0x25, 0xf1, 0xfa, 0x08, 0x4a, 0xd4, 0x97, 0xf7, 0xe0, 0x08, 0x7b, 0x53, 0x29,
+	0x46, 0x5b, 0x64, 0x14, 0xc4, 0xab, 0x43, 0x2a, 0xd0, 0xed, 0x3f, 0xe4, 0xe0, 0xbf, 0x41, 0x37,
+	0x85, 0x85, 0x01, 0xe1, 0x66, 0x55, 0xc4, 0xa5, 0xa1, 0x55, 0xa8, 0x17, 0x26, 0xa4, 0xf7, 0x1c,
+	0xf1, 0x73, 0x07, 0x49, 0x36, 0x4f, 0x56, 0x2c, 0x1d, 0x5c, 0x96, 0xee, 0xf7, 0x09, 0x07, 0x33,
+	0x61, 0x87, 0xee, 0x80, 0x7e, 0x10, 0xa2, 0x26, 0xbe, 0x3c, 0x92, 0x1a, 0xf5, 0xe8, 0x21, 0x07,
+	0x62, 0xc4, 0x09, 0x79, 0x6d, 0x50, 0x7c, 0xc3, 0x34, 0xc5, 0x57, 0x47, 0xd5, 0xdc, 0x93, 0x22,
+	0x41, 0xc7, 0xd9, 0xc2, 0x20, 0xcb, 0x8c, 0x8a, 0xb8, 0x34, 0xb4, 0x8a, 0xef, 0x85, 0x38, 0xf1,
+	0x3e, 0xb9, 0x86, 0x55, 0xd7, 0x1e, 0xfd, 0x9e, 0x19, 0x7b, 0xd4, 0xcd, 0x70, 0x8f, 0xbb, 0x19,
+	0xee, 0xb7, 0x6e, 0x86, 0xfb, 0xf4, 0x49, 0x66, 0xec, 0xf1, 0x93, 0xcc, 0xd8, 0x2f, 0x4f, 0x32,
+	0x63, 0x6f, 0x2e, 0xf6, 0x3d, 0xee, 0x74, 0x53, 0x77, 0x74, 0x65, 0xbe, 0xa5, 0x34, 0x70, 0x91,
+	0x7e, 0xf4, 0xdd, 0xdc, 0xf7, 0xd9, 0xd7, 0x7d, 0xeb, 0x35, 0x12, 0xee, 0x47, 0xdf, 0x4b, 0x7f,
+	0x0d, 0x00, 0xa5, 0xdd, 0xff, 0x51, 0xb9, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// StoreCodeAdmin to submit Wasm code to the system with admin permission
+	StoreCodeAdmin(ctx context.Context, in *MsgStoreCodeAdmin, opts ...grpc.CallOption) (*MsgStoreCodeAdminResponse, error)
+	// StoreCodesAdmin to submit multiple Wasm codes to the system with admin
+	// permission
+	StoreCodesAdmin(ctx context.Context, in *MsgStoreCodesAdmin, opts ...grpc.CallOption) (*MsgStoreCodesAdminResponse, error)
+	// StoreAndInstantiateAdmin to submit Wasm code to the system and instantiate
+	// a contract from it with admin permission
+	StoreAndInstantiateAdmin(ctx context.Context, in *MsgStoreAndInstantiateAdmin, opts ...grpc.CallOption) (*MsgStoreAndInstantiateAdminResponse, error)
+	// UpdateCodeAccessConfigAdmin to update the instantiate permission of a code
+	// with admin permission
+	UpdateCodeAccessConfigAdmin(ctx context.Context, in *MsgUpdateCodeAccessConfigAdmin, opts ...grpc.CallOption) (*MsgUpdateCodeAccessConfigAdminResponse, error)
+	// MigrateContractAdmin to migrate a contract to a new code version with
+	// admin permission
+	MigrateContractAdmin(ctx context.Context, in *MsgMigrateContractAdmin, opts ...grpc.CallOption) (*MsgMigrateContractAdminResponse, error)
+	// SetContractAdminAdmin to set or clear the admin of a contract with admin
+	// permission
+	SetContractAdminAdmin(ctx context.Context, in *MsgSetContractAdminAdmin, opts ...grpc.CallOption) (*MsgSetContractAdminAdminResponse, error)
+	// RegisterChecksums registers code checksums which are allowed to be stored
+	// with admin permission
+	RegisterChecksums(ctx context.Context, in *MsgRegisterChecksums, opts ...grpc.CallOption) (*MsgRegisterChecksumsResponse, error)
+	// UnregisterChecksums removes code checksums from the registry
+	UnregisterChecksums(ctx context.Context, in *MsgUnregisterChecksums, opts ...grpc.CallOption) (*MsgUnregisterChecksumsResponse, error)
+	// UpdateParams defines an operation for updating the wasmextension module
+	// parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AddAcceptedStargateMsgs adds msg type urls to the list of messages
+	// contracts are allowed to dispatch as stargate messages
+	AddAcceptedStargateMsgs(ctx context.Context, in *MsgAddAcceptedStargateMsgs, opts ...grpc.CallOption) (*MsgAddAcceptedStargateMsgsResponse, error)
+	// RemoveAcceptedStargateMsgs removes msg type urls from the list of messages
+	// contracts are allowed to dispatch as stargate messages
+	RemoveAcceptedStargateMsgs(ctx context.Context, in *MsgRemoveAcceptedStargateMsgs, opts ...grpc.CallOption) (*MsgRemoveAcceptedStargateMsgsResponse, error)
+	// RetryFailedCallback retries a failed ibc lifecycle callback. It can be
+	// sent by any account.
+	RetryFailedCallback(ctx context.Context, in *MsgRetryFailedCallback, opts ...grpc.CallOption) (*MsgRetryFailedCallbackResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) StoreCodeAdmin(ctx context.Context, in *MsgStoreCodeAdmin, opts ...grpc.CallOption) (*MsgStoreCodeAdminResponse, error) {
+	out := new(MsgStoreCodeAdminResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Msg/StoreCodeAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StoreCodesAdmin(ctx context.Context, in *MsgStoreCodesAdmin, opts ...grpc.CallOption) (*MsgStoreCodesAdminResponse, error) {
+	out := new(MsgStoreCodesAdminResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Msg/StoreCodesAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StoreAndInstantiateAdmin(ctx context.Context, in *MsgStoreAndInstantiateAdmin, opts ...grpc.CallOption) (*MsgStoreAndInstantiateAdminResponse, error) {
+	out := new(MsgStoreAndInstantiateAdminResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Msg/StoreAndInstantiateAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCodeAccessConfigAdmin(ctx context.Context, in *MsgUpdateCodeAccessConfigAdmin, opts ...grpc.CallOption) (*MsgUpdateCodeAccessConfigAdminResponse, error) {
+	out := new(MsgUpdateCodeAccessConfigAdminResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Msg/UpdateCodeAccessConfigAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateContractAdmin(ctx context.Context, in *MsgMigrateContractAdmin, opts ...grpc.CallOption) (*MsgMigrateContractAdminResponse, error) {
+	out := new(MsgMigrateContractAdminResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Msg/MigrateContractAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetContractAdminAdmin(ctx context.Context, in *MsgSetContractAdminAdmin, opts ...grpc.CallOption) (*MsgSetContractAdminAdminResponse, error) {
+	out := new(MsgSetContractAdminAdminResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Msg/SetContractAdminAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterChecksums(ctx context.Context, in *MsgRegisterChecksums, opts ...grpc.CallOption) (*MsgRegisterChecksumsResponse, error) {
+	out := new(MsgRegisterChecksumsResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Msg/RegisterChecksums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnregisterChecksums(ctx context.Context, in *MsgUnregisterChecksums, opts ...grpc.CallOption) (*MsgUnregisterChecksumsResponse, error) {
+	out := new(MsgUnregisterChecksumsResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Msg/UnregisterChecksums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAcceptedStargateMsgs(ctx context.Context, in *MsgAddAcceptedStargateMsgs, opts ...grpc.CallOption) (*MsgAddAcceptedStargateMsgsResponse, error) {
+	out := new(MsgAddAcceptedStargateMsgsResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Msg/AddAcceptedStargateMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAcceptedStargateMsgs(ctx context.Context, in *MsgRemoveAcceptedStargateMsgs, opts ...grpc.CallOption) (*MsgRemoveAcceptedStargateMsgsResponse, error) {
+	out := new(MsgRemoveAcceptedStargateMsgsResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Msg/RemoveAcceptedStargateMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetryFailedCallback(ctx context.Context, in *MsgRetryFailedCallback, opts ...grpc.CallOption) (*MsgRetryFailedCallbackResponse, error) {
+	out := new(MsgRetryFailedCallbackResponse)
+	err := c.cc.Invoke(ctx, "/miniwasm.wasmextension.v1.Msg/RetryFailedCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// StoreCodeAdmin to submit Wasm code to the system with admin permission
+	StoreCodeAdmin(context.Context, *MsgStoreCodeAdmin) (*MsgStoreCodeAdminResponse, error)
+	// StoreCodesAdmin to submit multiple Wasm codes to the system with admin
+	// permission
+	StoreCodesAdmin(context.Context, *MsgStoreCodesAdmin) (*MsgStoreCodesAdminResponse, error)
+	// StoreAndInstantiateAdmin to submit Wasm code to the system and instantiate
+	// a contract from it with admin permission
+	StoreAndInstantiateAdmin(context.Context, *MsgStoreAndInstantiateAdmin) (*MsgStoreAndInstantiateAdminResponse, error)
+	// UpdateCodeAccessConfigAdmin to update the instantiate permission of a code
+	// with admin permission
+	UpdateCodeAccessConfigAdmin(context.Context, *MsgUpdateCodeAccessConfigAdmin) (*MsgUpdateCodeAccessConfigAdminResponse, error)
+	// MigrateContractAdmin to migrate a contract to a new code version with
+	// admin permission
+	MigrateContractAdmin(context.Context, *MsgMigrateContractAdmin) (*MsgMigrateContractAdminResponse, error)
+	// SetContractAdminAdmin to set or clear the admin of a contract with admin
+	// permission
+	SetContractAdminAdmin(context.Context, *MsgSetContractAdminAdmin) (*MsgSetContractAdminAdminResponse, error)
+	// RegisterChecksums registers code checksums which are allowed to be stored
+	// with admin permission
+	RegisterChecksums(context.Context, *MsgRegisterChecksums) (*MsgRegisterChecksumsResponse, error)
+	// UnregisterChecksums removes code checksums from the registry
+	UnregisterChecksums(context.Context, *MsgUnregisterChecksums) (*MsgUnregisterChecksumsResponse, error)
+	// UpdateParams defines an operation for updating the wasmextension module
+	// parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AddAcceptedStargateMsgs adds msg type urls to the list of messages
+	// contracts are allowed to dispatch as stargate messages
+	AddAcceptedStargateMsgs(context.Context, *MsgAddAcceptedStargateMsgs) (*MsgAddAcceptedStargateMsgsResponse, error)
+	// RemoveAcceptedStargateMsgs removes msg type urls from the list of messages
+	// contracts are allowed to dispatch as stargate messages
+	RemoveAcceptedStargateMsgs(context.Context, *MsgRemoveAcceptedStargateMsgs) (*MsgRemoveAcceptedStargateMsgsResponse, error)
+	// RetryFailedCallback retries a failed ibc lifecycle callback. It can be
+	// sent by any account.
+	RetryFailedCallback(context.Context, *MsgRetryFailedCallback) (*MsgRetryFailedCallbackResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) StoreCodeAdmin(ctx context.Context, req *MsgStoreCodeAdmin) (*MsgStoreCodeAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreCodeAdmin not implemented")
+}
+func (*UnimplementedMsgServer) StoreCodesAdmin(ctx context.Context, req *MsgStoreCodesAdmin) (*MsgStoreCodesAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreCodesAdmin not implemented")
+}
+func (*UnimplementedMsgServer) StoreAndInstantiateAdmin(ctx context.Context, req *MsgStoreAndInstantiateAdmin) (*MsgStoreAndInstantiateAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreAndInstantiateAdmin not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCodeAccessConfigAdmin(ctx context.Context, req *MsgUpdateCodeAccessConfigAdmin) (*MsgUpdateCodeAccessConfigAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCodeAccessConfigAdmin not implemented")
+}
+func (*UnimplementedMsgServer) MigrateContractAdmin(ctx context.Context, req *MsgMigrateContractAdmin) (*MsgMigrateContractAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateContractAdmin not implemented")
+}
+func (*UnimplementedMsgServer) SetContractAdminAdmin(ctx context.Context, req *MsgSetContractAdminAdmin) (*MsgSetContractAdminAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContractAdminAdmin not implemented")
+}
+func (*UnimplementedMsgServer) RegisterChecksums(ctx context.Context, req *MsgRegisterChecksums) (*MsgRegisterChecksumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterChecksums not implemented")
+}
+func (*UnimplementedMsgServer) UnregisterChecksums(ctx context.Context, req *MsgUnregisterChecksums) (*MsgUnregisterChecksumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterChecksums not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) AddAcceptedStargateMsgs(ctx context.Context, req *MsgAddAcceptedStargateMsgs) (*MsgAddAcceptedStargateMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAcceptedStargateMsgs not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAcceptedStargateMsgs(ctx context.Context, req *MsgRemoveAcceptedStargateMsgs) (*MsgRemoveAcceptedStargateMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAcceptedStargateMsgs not implemented")
+}
+func (*UnimplementedMsgServer) RetryFailedCallback(ctx context.Context, req *MsgRetryFailedCallback) (*MsgRetryFailedCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryFailedCallback not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_StoreCodeAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStoreCodeAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StoreCodeAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Msg/StoreCodeAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StoreCodeAdmin(ctx, req.(*MsgStoreCodeAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StoreCodesAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStoreCodesAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StoreCodesAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Msg/StoreCodesAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StoreCodesAdmin(ctx, req.(*MsgStoreCodesAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StoreAndInstantiateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStoreAndInstantiateAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StoreAndInstantiateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Msg/StoreAndInstantiateAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StoreAndInstantiateAdmin(ctx, req.(*MsgStoreAndInstantiateAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCodeAccessConfigAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCodeAccessConfigAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCodeAccessConfigAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Msg/UpdateCodeAccessConfigAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCodeAccessConfigAdmin(ctx, req.(*MsgUpdateCodeAccessConfigAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateContractAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateContractAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateContractAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Msg/MigrateContractAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateContractAdmin(ctx, req.(*MsgMigrateContractAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetContractAdminAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetContractAdminAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetContractAdminAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Msg/SetContractAdminAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetContractAdminAdmin(ctx, req.(*MsgSetContractAdminAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterChecksums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterChecksums)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterChecksums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Msg/RegisterChecksums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterChecksums(ctx, req.(*MsgRegisterChecksums))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnregisterChecksums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterChecksums)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterChecksums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Msg/UnregisterChecksums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterChecksums(ctx, req.(*MsgUnregisterChecksums))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAcceptedStargateMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAcceptedStargateMsgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAcceptedStargateMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Msg/AddAcceptedStargateMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAcceptedStargateMsgs(ctx, req.(*MsgAddAcceptedStargateMsgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAcceptedStargateMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAcceptedStargateMsgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAcceptedStargateMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Msg/RemoveAcceptedStargateMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAcceptedStargateMsgs(ctx, req.(*MsgRemoveAcceptedStargateMsgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetryFailedCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryFailedCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryFailedCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.wasmextension.v1.Msg/RetryFailedCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryFailedCallback(ctx, req.(*MsgRetryFailedCallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "miniwasm.wasmextension.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StoreCodeAdmin",
+			Handler:    _Msg_StoreCodeAdmin_Handler,
+		},
+		{
+			MethodName: "StoreCodesAdmin",
+			Handler:    _Msg_StoreCodesAdmin_Handler,
+		},
+		{
+			MethodName: "StoreAndInstantiateAdmin",
+			Handler:    _Msg_StoreAndInstantiateAdmin_Handler,
+		},
+		{
+			MethodName: "UpdateCodeAccessConfigAdmin",
+			Handler:    _Msg_UpdateCodeAccessConfigAdmin_Handler,
+		},
+		{
+			MethodName: "MigrateContractAdmin",
+			Handler:    _Msg_MigrateContractAdmin_Handler,
+		},
+		{
+			MethodName: "SetContractAdminAdmin",
+			Handler:    _Msg_SetContractAdminAdmin_Handler,
+		},
+		{
+			MethodName: "RegisterChecksums",
+			Handler:    _Msg_RegisterChecksums_Handler,
+		},
+		{
+			MethodName: "UnregisterChecksums",
+			Handler:    _Msg_UnregisterChecksums_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddAcceptedStargateMsgs",
+			Handler:    _Msg_AddAcceptedStargateMsgs_Handler,
+		},
+		{
+			MethodName: "RemoveAcceptedStargateMsgs",
+			Handler:    _Msg_RemoveAcceptedStargateMsgs_Handler,
+		},
+		{
+			MethodName: "RetryFailedCallback",
+			Handler:    _Msg_RetryFailedCallback_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "miniwasm/wasmextension/v1/tx.proto",
+}
+
+func (m *MsgStoreCodeAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreCodeAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreCodeAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InstantiatePermission != nil {
+		{
+			size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WASMByteCode) > 0 {
+		i -= len(m.WASMByteCode)
+		copy(dAtA[i:], m.WASMByteCode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WASMByteCode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreCodeAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreCodeAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreCodeAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreCodesAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreCodesAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreCodesAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Codes) > 0 {
+		for iNdEx := len(m.Codes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Codes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreCodesAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreCodesAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreCodesAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CodeIDs) > 0 {
+		dAtA3 := make([]byte, len(m.CodeIDs)*10)
+		var j2 int
+		for _, num := range m.CodeIDs {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreAndInstantiateAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreAndInstantiateAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreAndInstantiateAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.InstantiatePermission != nil {
+		{
+			size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WASMByteCode) > 0 {
+		i -= len(m.WASMByteCode)
+		copy(dAtA[i:], m.WASMByteCode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WASMByteCode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreAndInstantiateAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreAndInstantiateAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreAndInstantiateAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCodeAccessConfigAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCodeAccessConfigAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCodeAccessConfigAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewInstantiatePermission != nil {
+		{
+			size, err := m.NewInstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCodeAccessConfigAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCodeAccessConfigAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCodeAccessConfigAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateContractAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateContractAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateContractAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateContractAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateContractAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateContractAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetContractAdminAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractAdminAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractAdminAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetContractAdminAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractAdminAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractAdminAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterChecksums) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterChecksums) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterChecksums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checksums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterChecksumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterChecksumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterChecksumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterChecksums) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterChecksums) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterChecksums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterChecksumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterChecksumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterChecksumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAcceptedStargateMsgs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAcceptedStargateMsgs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAcceptedStargateMsgs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TypeURLs) > 0 {
+		for iNdEx := len(m.TypeURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeURLs[iNdEx])
+			copy(dAtA[i:], m.TypeURLs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAcceptedStargateMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAcceptedStargateMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAcceptedStargateMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAcceptedStargateMsgs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAcceptedStargateMsgs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAcceptedStargateMsgs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TypeURLs) > 0 {
+		for iNdEx := len(m.TypeURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeURLs[iNdEx])
+			copy(dAtA[i:], m.TypeURLs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAcceptedStargateMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAcceptedStargateMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAcceptedStargateMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryFailedCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryFailedCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryFailedCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryFailedCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryFailedCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryFailedCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Dropped {
+		i--
+		if m.Dropped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgStoreCodeAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WASMByteCode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InstantiatePermission != nil {
+		l = m.InstantiatePermission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStoreCodeAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStoreCodesAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Codes) > 0 {
+		for _, e := range m.Codes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStoreCodesAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CodeIDs) > 0 {
+		l = 0
+		for _, e := range m.CodeIDs {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStoreAndInstantiateAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WASMByteCode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InstantiatePermission != nil {
+		l = m.InstantiatePermission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStoreAndInstantiateAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCodeAccessConfigAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	if m.NewInstantiatePermission != nil {
+		l = m.NewInstantiatePermission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCodeAccessConfigAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateContractAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateContractAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetContractAdminAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetContractAdminAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterChecksums) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Checksums) > 0 {
+		for _, e := range m.Checksums {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterChecksumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnregisterChecksums) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnregisterChecksumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAcceptedStargateMsgs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TypeURLs) > 0 {
+		for _, s := range m.TypeURLs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddAcceptedStargateMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAcceptedStargateMsgs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TypeURLs) > 0 {
+		for _, s := range m.TypeURLs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveAcceptedStargateMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRetryFailedCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *MsgRetryFailedCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.Dropped {
+		n += 2
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgStoreCodeAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreCodeAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreCodeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.WASMByteCode == nil {
+				m.WASMByteCode = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstantiatePermission == nil {
+				m.InstantiatePermission = &AccessConfig{}
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreCodeAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreCodeAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreCodeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreCodesAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreCodesAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreCodesAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codes = append(m.Codes, WasmCode{})
+			if err := m.Codes[len(m.Codes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreCodesAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreCodesAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreCodesAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CodeIDs = append(m.CodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CodeIDs) == 0 {
+					m.CodeIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CodeIDs = append(m.CodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeIDs", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreAndInstantiateAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreAndInstantiateAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreAndInstantiateAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.WASMByteCode == nil {
+				m.WASMByteCode = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstantiatePermission == nil {
+				m.InstantiatePermission = &AccessConfig{}
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreAndInstantiateAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreAndInstantiateAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreAndInstantiateAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCodeAccessConfigAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCodeAccessConfigAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCodeAccessConfigAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewInstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewInstantiatePermission == nil {
+				m.NewInstantiatePermission = &AccessConfig{}
+			}
+			if err := m.NewInstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCodeAccessConfigAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCodeAccessConfigAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCodeAccessConfigAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateContractAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateContractAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateContractAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateContractAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateContractAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateContractAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetContractAdminAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetContractAdminAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetContractAdminAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetContractAdminAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetContractAdminAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetContractAdminAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterChecksums) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterChecksums: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterChecksums: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, RegisteredChecksum{})
+			if err := m.Checksums[len(m.Checksums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterChecksumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterChecksumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterChecksumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterChecksums) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterChecksums: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterChecksums: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterChecksumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterChecksumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterChecksumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgStoreCodeAdmin) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.WASMByteCode)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if m.InstantiatePermission != nil {
-		l = m.InstantiatePermission.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgAddAcceptedStargateMsgs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAcceptedStargateMsgs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAcceptedStargateMsgs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeURLs = append(m.TypeURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgStoreCodeAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovTx(uint64(m.CodeID))
-	}
-	l = len(m.Checksum)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgAddAcceptedStargateMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAcceptedStargateMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAcceptedStargateMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgStoreCodeAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAcceptedStargateMsgs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -417,10 +5401,10 @@ func (m *MsgStoreCodeAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreCodeAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAcceptedStargateMsgs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreCodeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAcceptedStargateMsgs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -457,7 +5441,7 @@ func (m *MsgStoreCodeAdmin) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeURLs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -485,13 +5469,113 @@ func (m *MsgStoreCodeAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.TypeURLs = append(m.TypeURLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAcceptedStargateMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAcceptedStargateMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAcceptedStargateMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryFailedCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryFailedCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryFailedCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -501,31 +5585,29 @@ func (m *MsgStoreCodeAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
-			if m.WASMByteCode == nil {
-				m.WASMByteCode = []byte{}
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -535,28 +5617,11 @@ func (m *MsgStoreCodeAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.InstantiatePermission == nil {
-				m.InstantiatePermission = &AccessConfig{}
-			}
-			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -578,7 +5643,7 @@ func (m *MsgStoreCodeAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStoreCodeAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRetryFailedCallbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -601,17 +5666,17 @@ func (m *MsgStoreCodeAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreCodeAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetryFailedCallbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreCodeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetryFailedCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.CodeID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -621,16 +5686,17 @@ func (m *MsgStoreCodeAdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Success = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dropped", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -640,26 +5706,12 @@ func (m *MsgStoreCodeAdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
-			if m.Checksum == nil {
-				m.Checksum = []byte{}
-			}
-			iNdEx = postIndex
+			m.Dropped = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
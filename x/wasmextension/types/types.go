@@ -1,6 +1,14 @@
 package types
 
 import (
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
@@ -13,3 +21,88 @@ func (ac *AccessConfig) ToWasmAccessConfig() *wasmtypes.AccessConfig {
 		Addresses:  ac.Addresses,
 	}
 }
+
+// Validate performs basic validation of the admin code record.
+func (c AdminCodeInfo) Validate() error {
+	if c.CodeID == 0 {
+		return fmt.Errorf("empty code id")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Creator); err != nil {
+		return fmt.Errorf("invalid creator of admin code %d: %w", c.CodeID, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Authority); err != nil {
+		return fmt.Errorf("invalid authority of admin code %d: %w", c.CodeID, err)
+	}
+	if c.Height < 0 {
+		return fmt.Errorf("negative height of admin code %d", c.CodeID)
+	}
+	if len(c.Checksum) == 0 {
+		return fmt.Errorf("empty checksum of admin code %d", c.CodeID)
+	}
+	return nil
+}
+
+// Validate performs basic validation of the checksum registration.
+func (c RegisteredChecksum) Validate() error {
+	if len(c.Checksum) != sha256.Size {
+		return ErrInvalidChecksum.Wrapf("checksum must be %d bytes, got %d", sha256.Size, len(c.Checksum))
+	}
+	if c.SourceURL != "" {
+		if _, err := url.ParseRequestURI(c.SourceURL); err != nil {
+			return ErrInvalidChecksum.Wrapf("invalid source url: %s", err)
+		}
+	}
+	return nil
+}
+
+// Validate performs basic validation of the failed callback.
+func (c FailedCallback) Validate() error {
+	if c.ID == 0 {
+		return fmt.Errorf("empty failed callback id")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return fmt.Errorf("invalid contract of failed callback %d: %w", c.ID, err)
+	}
+	if err := c.Msg.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid msg of failed callback %d: %w", c.ID, err)
+	}
+	if c.Height < 0 {
+		return fmt.Errorf("negative height of failed callback %d", c.ID)
+	}
+	if c.NextRetryHeight < c.Height {
+		return fmt.Errorf("next retry height of failed callback %d is before its height", c.ID)
+	}
+	return nil
+}
+
+func (r ForwardRefund) Validate() error {
+	if err := host.PortIdentifierValidator(r.PortId); err != nil {
+		return fmt.Errorf("invalid port of forward refund: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel of forward refund: %w", err)
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("empty sequence of forward refund")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.RefundAddress); err != nil {
+		return fmt.Errorf("invalid refund address of forward refund: %w", err)
+	}
+	return nil
+}
+
+func validateRegisteredChecksums(checksums []RegisteredChecksum) error {
+	seen := make(map[string]bool, len(checksums))
+	for _, checksum := range checksums {
+		if err := checksum.Validate(); err != nil {
+			return err
+		}
+
+		if seen[string(checksum.Checksum)] {
+			return ErrInvalidChecksum.Wrapf("duplicate checksum: %X", checksum.Checksum)
+		}
+		seen[string(checksum.Checksum)] = true
+	}
+
+	return nil
+}
@@ -5,8 +5,10 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_CosmWasm_wasmd_x_wasm_types "github.com/CosmWasm/wasmd/x/wasm/types"
 	types "github.com/CosmWasm/wasmd/x/wasm/types"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -64,8 +66,339 @@ func (m *AccessConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AccessConfig proto.InternalMessageInfo
 
+// WasmCode is a Wasm code to store together with its instantiate permission.
+type WasmCode struct {
+	// WASMByteCode can be raw or gzip compressed
+	WASMByteCode []byte `protobuf:"bytes,1,opt,name=wasm_byte_code,json=wasmByteCode,proto3" json:"wasm_byte_code,omitempty"`
+	// InstantiatePermission access control to apply on contract creation,
+	// optional
+	InstantiatePermission *AccessConfig `protobuf:"bytes,2,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission,omitempty"`
+}
+
+func (m *WasmCode) Reset()         { *m = WasmCode{} }
+func (m *WasmCode) String() string { return proto.CompactTextString(m) }
+func (*WasmCode) ProtoMessage()    {}
+func (*WasmCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4846531563304135, []int{1}
+}
+func (m *WasmCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WasmCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WasmCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WasmCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmCode.Merge(m, src)
+}
+func (m *WasmCode) XXX_Size() int {
+	return m.Size()
+}
+func (m *WasmCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmCode proto.InternalMessageInfo
+
+// AdminCodeInfo is the record of a code stored with admin permission.
+type AdminCodeInfo struct {
+	// CodeID is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Creator is the actor that created the code
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Authority is the actor that signed the store message
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Height is the block height the code was stored at
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// Checksum is the sha256 hash of the stored code
+	Checksum []byte `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *AdminCodeInfo) Reset()         { *m = AdminCodeInfo{} }
+func (m *AdminCodeInfo) String() string { return proto.CompactTextString(m) }
+func (*AdminCodeInfo) ProtoMessage()    {}
+func (*AdminCodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4846531563304135, []int{2}
+}
+func (m *AdminCodeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminCodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminCodeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminCodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminCodeInfo.Merge(m, src)
+}
+func (m *AdminCodeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminCodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminCodeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminCodeInfo proto.InternalMessageInfo
+
+// RegisteredChecksum is a code checksum approved by the authority to be
+// stored with admin permission.
+type RegisteredChecksum struct {
+	// Checksum is the sha256 hash of the uncompressed wasm code
+	Checksum []byte `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// SourceURL is an optional url of the source the code was built from
+	SourceURL string `protobuf:"bytes,2,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	// BuilderImage is an optional docker image used to build the code, e.g.
+	// cosmwasm/optimizer:0.16.0
+	BuilderImage string `protobuf:"bytes,3,opt,name=builder_image,json=builderImage,proto3" json:"builder_image,omitempty"`
+}
+
+func (m *RegisteredChecksum) Reset()         { *m = RegisteredChecksum{} }
+func (m *RegisteredChecksum) String() string { return proto.CompactTextString(m) }
+func (*RegisteredChecksum) ProtoMessage()    {}
+func (*RegisteredChecksum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4846531563304135, []int{3}
+}
+func (m *RegisteredChecksum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredChecksum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredChecksum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisteredChecksum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredChecksum.Merge(m, src)
+}
+func (m *RegisteredChecksum) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredChecksum) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredChecksum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredChecksum proto.InternalMessageInfo
+
+// Params defines the set of wasmextension parameters.
+type Params struct {
+	// AcceptedStargateQueries is the list of stargate queries contracts are
+	// allowed to make in addition to the ones hardcoded in the app.
+	AcceptedStargateQueries []AcceptedStargateQuery `protobuf:"bytes,1,rep,name=accepted_stargate_queries,json=acceptedStargateQueries,proto3" json:"accepted_stargate_queries"`
+	// MaxCallbackRetries is the number of times a failed ibc lifecycle callback
+	// can be retried before it is dropped. Zero disables the retry queue.
+	MaxCallbackRetries uint32 `protobuf:"varint,2,opt,name=max_callback_retries,json=maxCallbackRetries,proto3" json:"max_callback_retries,omitempty"`
+	// CallbackRetryInterval is the number of blocks a failed ibc lifecycle
+	// callback has to wait before it can be retried again.
+	CallbackRetryInterval uint64 `protobuf:"varint,3,opt,name=callback_retry_interval,json=callbackRetryInterval,proto3" json:"callback_retry_interval,omitempty"`
+	// MaxFailedCallbacksPerContract is the number of failed ibc lifecycle
+	// callbacks a contract can have in the retry queue. The callbacks failing
+	// beyond it are dropped.
+	MaxFailedCallbacksPerContract uint32 `protobuf:"varint,4,opt,name=max_failed_callbacks_per_contract,json=maxFailedCallbacksPerContract,proto3" json:"max_failed_callbacks_per_contract,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4846531563304135, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// AcceptedStargateQuery is a stargate query path together with the proto
+// message its response is decoded into.
+type AcceptedStargateQuery struct {
+	// Path is the full grpc method path of the query,
+	// e.g. /connect.oracle.v2.Query/GetPrice
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// ResponseType is the full proto message name of the query response,
+	// e.g. connect.oracle.v2.GetPriceResponse
+	ResponseType string `protobuf:"bytes,2,opt,name=response_type,json=responseType,proto3" json:"response_type,omitempty"`
+}
+
+func (m *AcceptedStargateQuery) Reset()         { *m = AcceptedStargateQuery{} }
+func (m *AcceptedStargateQuery) String() string { return proto.CompactTextString(m) }
+func (*AcceptedStargateQuery) ProtoMessage()    {}
+func (*AcceptedStargateQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4846531563304135, []int{5}
+}
+func (m *AcceptedStargateQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcceptedStargateQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptedStargateQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcceptedStargateQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptedStargateQuery.Merge(m, src)
+}
+func (m *AcceptedStargateQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcceptedStargateQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptedStargateQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptedStargateQuery proto.InternalMessageInfo
+
+// FailedCallback is an ibc lifecycle callback of a contract which failed to be
+// executed and is kept to be retried.
+type FailedCallback struct {
+	// ID is the identifier of the failed callback
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Contract is the address of the callback contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Msg is the json encoded sudo message of the callback
+	Msg github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,3,opt,name=msg,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"msg,omitempty"`
+	// Retries is the number of failed retries of the callback
+	Retries uint32 `protobuf:"varint,4,opt,name=retries,proto3" json:"retries,omitempty"`
+	// Height is the block height the callback failed at first
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// NextRetryHeight is the block height from which the callback can be retried
+	NextRetryHeight int64 `protobuf:"varint,6,opt,name=next_retry_height,json=nextRetryHeight,proto3" json:"next_retry_height,omitempty"`
+}
+
+func (m *FailedCallback) Reset()         { *m = FailedCallback{} }
+func (m *FailedCallback) String() string { return proto.CompactTextString(m) }
+func (*FailedCallback) ProtoMessage()    {}
+func (*FailedCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4846531563304135, []int{6}
+}
+func (m *FailedCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedCallback.Merge(m, src)
+}
+func (m *FailedCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedCallback proto.InternalMessageInfo
+
+// ForwardRefund is the refund address of an ics20 transfer forwarded by an ibc
+// hook, which receives the funds refunded when the transfer fails.
+type ForwardRefund struct {
+	// PortId is the source port of the forwarded transfer
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// ChannelId is the source channel of the forwarded transfer
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Sequence is the packet sequence of the forwarded transfer
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// RefundAddress is the address receiving the refunded funds
+	RefundAddress string `protobuf:"bytes,4,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+}
+
+func (m *ForwardRefund) Reset()         { *m = ForwardRefund{} }
+func (m *ForwardRefund) String() string { return proto.CompactTextString(m) }
+func (*ForwardRefund) ProtoMessage()    {}
+func (*ForwardRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4846531563304135, []int{7}
+}
+func (m *ForwardRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardRefund.Merge(m, src)
+}
+func (m *ForwardRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardRefund proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AccessConfig)(nil), "miniwasm.wasmextension.v1.AccessConfig")
+	proto.RegisterType((*WasmCode)(nil), "miniwasm.wasmextension.v1.WasmCode")
+	proto.RegisterType((*AdminCodeInfo)(nil), "miniwasm.wasmextension.v1.AdminCodeInfo")
+	proto.RegisterType((*RegisteredChecksum)(nil), "miniwasm.wasmextension.v1.RegisteredChecksum")
+	proto.RegisterType((*Params)(nil), "miniwasm.wasmextension.v1.Params")
+	proto.RegisterType((*AcceptedStargateQuery)(nil), "miniwasm.wasmextension.v1.AcceptedStargateQuery")
+	proto.RegisterType((*FailedCallback)(nil), "miniwasm.wasmextension.v1.FailedCallback")
+	proto.RegisterType((*ForwardRefund)(nil), "miniwasm.wasmextension.v1.ForwardRefund")
 }
 
 func init() {
@@ -73,26 +406,67 @@ func init() {
 }
 
 var fileDescriptor_4846531563304135 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0xcd, 0xcc, 0xcb,
-	0x2c, 0x4f, 0x2c, 0xce, 0xd5, 0x07, 0x11, 0xa9, 0x15, 0x25, 0xa9, 0x79, 0xc5, 0x99, 0xf9, 0x79,
-	0xfa, 0x65, 0x86, 0xfa, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42,
-	0x92, 0x30, 0x65, 0x7a, 0x28, 0xca, 0xf4, 0xca, 0x0c, 0xa5, 0x24, 0x93, 0xf3, 0x8b, 0x73, 0xf3,
-	0x8b, 0xe3, 0xc1, 0x0a, 0xf5, 0x21, 0x1c, 0x88, 0x2e, 0x29, 0x19, 0x10, 0x0f, 0x6e, 0x38, 0x9a,
-	0x99, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x10, 0x5d, 0x20, 0x16, 0x44, 0x54, 0x69, 0x21, 0x23,
-	0x17, 0x8f, 0x63, 0x72, 0x72, 0x6a, 0x71, 0xb1, 0x73, 0x7e, 0x5e, 0x5a, 0x66, 0xba, 0x50, 0x30,
-	0x17, 0x57, 0x41, 0x6a, 0x51, 0x6e, 0x66, 0x31, 0xc8, 0x42, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x3e,
-	0x23, 0x19, 0x3d, 0x98, 0xc9, 0x60, 0xf7, 0xe8, 0x95, 0x19, 0xea, 0x41, 0xf4, 0x84, 0x54, 0x16,
-	0xa4, 0x3a, 0x89, 0x7e, 0xba, 0x27, 0x2f, 0x58, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0xd0, 0xa9,
-	0x14, 0x84, 0x64, 0x8c, 0x90, 0x19, 0x17, 0x67, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x6a,
-	0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22, 0x50, 0xe7, 0x3b,
-	0x42, 0xe4, 0x82, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0xad, 0x58, 0x66, 0x2c, 0x90,
-	0x67, 0x74, 0x0a, 0x3e, 0xf1, 0x50, 0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18,
-	0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5,
-	0x18, 0xa2, 0x4c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x33, 0xf3,
-	0x32, 0x4b, 0x32, 0x13, 0x75, 0x73, 0x12, 0x93, 0x8a, 0xf5, 0xe1, 0x21, 0x5d, 0x81, 0x16, 0xd6,
-	0xe0, 0x40, 0x49, 0x62, 0x03, 0xfb, 0xdf, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x08, 0xb0,
-	0x87, 0x92, 0x01, 0x00, 0x00,
+	// 959 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0xae, 0x13, 0x4f, 0xec, 0x40, 0x46, 0xf9, 0xe3, 0x44, 0xad, 0x1d, 0x5c, 0x21,
+	0xac, 0x88, 0xda, 0x4d, 0x80, 0x48, 0xf4, 0x82, 0x62, 0x57, 0x55, 0x2d, 0x11, 0x29, 0x8c, 0xa9,
+	0x22, 0x71, 0x60, 0x35, 0xde, 0x7d, 0x59, 0x0f, 0xdd, 0x9d, 0x71, 0x67, 0xc6, 0x89, 0x7d, 0xe6,
+	0x0b, 0x70, 0x44, 0x48, 0x48, 0x80, 0x38, 0x70, 0xec, 0x01, 0xbe, 0x43, 0x8e, 0x15, 0xe2, 0xc0,
+	0xc9, 0x02, 0xe7, 0xd0, 0x3b, 0x47, 0x4e, 0x68, 0x66, 0x77, 0x1d, 0xbb, 0x2a, 0xcd, 0xc5, 0xde,
+	0xf7, 0xde, 0xef, 0xfd, 0xfd, 0xcd, 0xbc, 0x41, 0xef, 0x46, 0x8c, 0xb3, 0x0b, 0xaa, 0xa2, 0x86,
+	0xf9, 0x81, 0xa1, 0x06, 0xae, 0x98, 0xe0, 0x8d, 0xf3, 0xfd, 0x86, 0x1e, 0xf5, 0x41, 0xd5, 0xfb,
+	0x52, 0x68, 0x81, 0xb7, 0x53, 0x58, 0x7d, 0x0e, 0x56, 0x3f, 0xdf, 0xdf, 0x59, 0xa3, 0x11, 0xe3,
+	0xa2, 0x61, 0x7f, 0x63, 0xf4, 0xce, 0xb6, 0x27, 0x54, 0x24, 0x94, 0x6b, 0xa5, 0x46, 0x2c, 0x24,
+	0xa6, 0xdb, 0x46, 0x9a, 0xe6, 0x7b, 0x25, 0xcd, 0xce, 0x7a, 0x20, 0x02, 0x11, 0x7b, 0x99, 0xaf,
+	0x58, 0x5b, 0xfd, 0xd1, 0x41, 0x85, 0x23, 0xcf, 0x03, 0xa5, 0x5a, 0x82, 0x9f, 0xb1, 0x00, 0x77,
+	0x10, 0xea, 0x83, 0x8c, 0x98, 0x32, 0x35, 0x94, 0x9c, 0x5d, 0xa7, 0xb6, 0x7a, 0x70, 0xbb, 0x9e,
+	0x46, 0xb6, 0x25, 0xd6, 0xcf, 0xf7, 0xeb, 0xb1, 0xcf, 0xe7, 0xa3, 0x3e, 0x34, 0x37, 0xfe, 0x19,
+	0x57, 0xd6, 0x46, 0x34, 0x0a, 0x1f, 0x54, 0xaf, 0x3d, 0xab, 0x64, 0x26, 0x0c, 0x3e, 0x44, 0x79,
+	0xea, 0xfb, 0x12, 0x94, 0x02, 0x55, 0xca, 0xec, 0x2e, 0xd6, 0xf2, 0xcd, 0xd2, 0xef, 0xbf, 0xde,
+	0x5b, 0x4f, 0xca, 0x3f, 0x8a, 0x6d, 0x1d, 0x2d, 0x19, 0x0f, 0xc8, 0x35, 0xf4, 0x41, 0xf6, 0xdb,
+	0x1f, 0x2a, 0x4e, 0xf5, 0x27, 0x07, 0x2d, 0x9f, 0x52, 0x15, 0xb5, 0x84, 0x0f, 0xf8, 0x10, 0xad,
+	0x9a, 0x1a, 0xdc, 0xee, 0x48, 0x83, 0xeb, 0x09, 0x1f, 0x6c, 0x8d, 0x85, 0xe6, 0xdb, 0x93, 0x71,
+	0xa5, 0x70, 0x7a, 0xd4, 0x39, 0x6e, 0x8e, 0x34, 0x18, 0x24, 0x29, 0x18, 0x5c, 0x2a, 0xe1, 0x2f,
+	0xd1, 0x26, 0xe3, 0x4a, 0x53, 0xae, 0x19, 0xd5, 0xe0, 0xce, 0xf4, 0x98, 0xd9, 0x75, 0x6a, 0x2b,
+	0x07, 0xef, 0xd5, 0xff, 0x97, 0x86, 0xfa, 0xec, 0x80, 0xc8, 0xc6, 0x4c, 0x98, 0x93, 0xeb, 0x7e,
+	0xff, 0x70, 0x50, 0xf1, 0xc8, 0x8f, 0x18, 0x37, 0xd9, 0xda, 0xfc, 0x4c, 0xe0, 0xbb, 0x68, 0xc9,
+	0xd4, 0xe7, 0x32, 0xdf, 0x96, 0x98, 0x6d, 0xa2, 0xc9, 0xb8, 0x92, 0xb3, 0xe6, 0x87, 0x24, 0x67,
+	0x4c, 0x6d, 0x1f, 0x1f, 0xa0, 0x25, 0x4f, 0x02, 0xd5, 0x42, 0xda, 0x3a, 0xde, 0x34, 0x97, 0x14,
+	0x68, 0xa7, 0x39, 0xd0, 0x3d, 0x21, 0x99, 0x1e, 0x95, 0x16, 0x6f, 0xf0, 0xba, 0x86, 0xe2, 0x4d,
+	0x94, 0xeb, 0x01, 0x0b, 0x7a, 0xba, 0x94, 0xdd, 0x75, 0x6a, 0x8b, 0x24, 0x91, 0xf0, 0x0e, 0x5a,
+	0xf6, 0x7a, 0xe0, 0x3d, 0x55, 0x83, 0xa8, 0x74, 0xcb, 0x0c, 0x93, 0x4c, 0xe5, 0xea, 0xd7, 0x0e,
+	0xc2, 0x04, 0x02, 0xa6, 0x34, 0x48, 0xf0, 0x5b, 0x89, 0x7a, 0xce, 0xc5, 0x99, 0x77, 0xc1, 0xef,
+	0x23, 0xa4, 0xc4, 0x40, 0x7a, 0xe0, 0x0e, 0x64, 0x98, 0x74, 0x55, 0x9c, 0x8c, 0x2b, 0xf9, 0x8e,
+	0xd5, 0x3e, 0x21, 0x9f, 0x92, 0x7c, 0x0c, 0x78, 0x22, 0x43, 0x7c, 0x17, 0x15, 0xbb, 0x03, 0x16,
+	0xfa, 0x20, 0x5d, 0x16, 0xd1, 0x00, 0xe2, 0x86, 0x48, 0x21, 0x51, 0xb6, 0x8d, 0xae, 0xfa, 0x5b,
+	0x06, 0xe5, 0x4e, 0xa8, 0xa4, 0x91, 0xc2, 0x17, 0x68, 0x9b, 0x7a, 0x1e, 0xf4, 0x35, 0xf8, 0xae,
+	0xd2, 0x54, 0x06, 0x86, 0xcd, 0x67, 0x03, 0x90, 0x0c, 0x54, 0xc9, 0xd9, 0x5d, 0xac, 0xad, 0x1c,
+	0xdc, 0xbf, 0x81, 0x4a, 0xe3, 0xdb, 0x49, 0x5c, 0x3f, 0x1b, 0x80, 0x1c, 0x35, 0xf3, 0x97, 0xe3,
+	0xca, 0xc2, 0x2f, 0x2f, 0x9f, 0xef, 0x39, 0x64, 0x8b, 0xbe, 0x06, 0xc1, 0x40, 0xe1, 0xfb, 0x68,
+	0x3d, 0xa2, 0x43, 0xd7, 0xa3, 0x61, 0xd8, 0xa5, 0xde, 0x53, 0x57, 0x82, 0xb6, 0x39, 0x4d, 0x83,
+	0x45, 0x82, 0x23, 0x3a, 0x6c, 0x25, 0x26, 0x12, 0x5b, 0xf0, 0x21, 0xda, 0x9a, 0x43, 0x8f, 0x5c,
+	0xc6, 0x35, 0xc8, 0x73, 0x1a, 0xda, 0x26, 0xb3, 0x64, 0xc3, 0x9b, 0xf1, 0x18, 0xb5, 0x13, 0x23,
+	0x7e, 0x8c, 0xde, 0x31, 0x99, 0xce, 0x28, 0x0b, 0xc1, 0x9f, 0x26, 0x54, 0xe6, 0xcc, 0xba, 0x9e,
+	0xe0, 0x5a, 0x52, 0x2f, 0xa6, 0xb0, 0x48, 0xee, 0x44, 0x74, 0xf8, 0xc8, 0xe2, 0xd2, 0xe4, 0xea,
+	0x04, 0x64, 0x2b, 0x01, 0x55, 0x4f, 0xd0, 0xc6, 0x6b, 0x1b, 0xc6, 0x18, 0x65, 0xfb, 0x54, 0xf7,
+	0x2c, 0x77, 0x79, 0x62, 0xbf, 0x0d, 0x13, 0x12, 0x54, 0x5f, 0x70, 0x05, 0xae, 0x59, 0x1c, 0x31,
+	0x75, 0xa4, 0x90, 0x2a, 0xcd, 0x65, 0xaf, 0x7e, 0x9f, 0x41, 0xab, 0xf3, 0x09, 0xf1, 0x26, 0xca,
+	0x4c, 0x8f, 0x78, 0x6e, 0x32, 0xae, 0x64, 0xda, 0x0f, 0x49, 0x86, 0xf9, 0xf8, 0x43, 0xb4, 0x3c,
+	0xad, 0xf6, 0xa6, 0xb3, 0x3d, 0x45, 0x62, 0x17, 0x2d, 0x46, 0x2a, 0xb0, 0x03, 0x2a, 0x34, 0x8f,
+	0xff, 0x1d, 0x57, 0x3e, 0x0e, 0x98, 0xee, 0x0d, 0xba, 0x75, 0x4f, 0x44, 0x8d, 0x96, 0x50, 0xd1,
+	0x69, 0xba, 0xe0, 0xfc, 0xc6, 0xd0, 0xfe, 0x27, 0x5b, 0x8e, 0xd0, 0x8b, 0xb4, 0xef, 0x63, 0x50,
+	0x8a, 0x06, 0xf0, 0xdd, 0xcb, 0xe7, 0x7b, 0x2b, 0x8c, 0x87, 0x8c, 0x83, 0xfb, 0x95, 0x12, 0x9c,
+	0x98, 0xc8, 0xb8, 0x84, 0x96, 0x52, 0xea, 0xe2, 0x19, 0xa6, 0xe2, 0xcc, 0xfd, 0xb8, 0x35, 0x77,
+	0x3f, 0xf6, 0xd0, 0x1a, 0x87, 0xa1, 0x4e, 0x38, 0x4c, 0x20, 0x39, 0x0b, 0x79, 0xcb, 0x18, 0x2c,
+	0x7b, 0x8f, 0xad, 0xba, 0xfa, 0xb3, 0x83, 0x8a, 0x8f, 0x84, 0xbc, 0xa0, 0xd2, 0x27, 0x70, 0x36,
+	0xe0, 0x3e, 0xde, 0x42, 0x4b, 0x7d, 0x21, 0x75, 0xba, 0x06, 0xf2, 0x24, 0x67, 0xc4, 0xb6, 0x8f,
+	0xef, 0x20, 0xe4, 0xf5, 0x28, 0xe7, 0x10, 0x1a, 0x5b, 0x3c, 0xec, 0x7c, 0xa2, 0x69, 0xfb, 0xe6,
+	0x8a, 0x29, 0x78, 0x36, 0x00, 0xee, 0x41, 0x72, 0x5c, 0xa6, 0x32, 0xfe, 0x04, 0xad, 0x4a, 0x1b,
+	0xdd, 0x4d, 0x76, 0x65, 0x29, 0x7b, 0xc3, 0x80, 0x8b, 0x31, 0x3e, 0x51, 0x36, 0x3b, 0x97, 0x7f,
+	0x97, 0x17, 0x2e, 0x27, 0x65, 0xe7, 0xc5, 0xa4, 0xec, 0xfc, 0x35, 0x29, 0x3b, 0xdf, 0x5c, 0x95,
+	0x17, 0x5e, 0x5c, 0x95, 0x17, 0xfe, 0xbc, 0x2a, 0x2f, 0x7c, 0xf1, 0xd1, 0xcc, 0xc8, 0x19, 0x67,
+	0x9a, 0xd1, 0x7b, 0x21, 0xed, 0xaa, 0xc6, 0xf4, 0x3d, 0x1b, 0xbe, 0xf2, 0xa2, 0x59, 0x06, 0xba,
+	0x39, 0xfb, 0xa4, 0x7c, 0xf0, 0xdf, 0x00, 0xee, 0x32, 0xf4, 0xc5, 0xf8, 0x06, 0x00, 0x00,
 }
 
 func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
@@ -132,42 +506,1538 @@ func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WasmCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AccessConfig) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *WasmCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WasmCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InstantiatePermission != nil {
+		{
+			size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WASMByteCode) > 0 {
+		i -= len(m.WASMByteCode)
+		copy(dAtA[i:], m.WASMByteCode)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.WASMByteCode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminCodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminCodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminCodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredChecksum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredChecksum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredChecksum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuilderImage) > 0 {
+		i -= len(m.BuilderImage)
+		copy(dAtA[i:], m.BuilderImage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BuilderImage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceURL) > 0 {
+		i -= len(m.SourceURL)
+		copy(dAtA[i:], m.SourceURL)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SourceURL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFailedCallbacksPerContract != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxFailedCallbacksPerContract))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CallbackRetryInterval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CallbackRetryInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxCallbackRetries != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxCallbackRetries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AcceptedStargateQueries) > 0 {
+		for iNdEx := len(m.AcceptedStargateQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedStargateQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptedStargateQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptedStargateQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptedStargateQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResponseType) > 0 {
+		i -= len(m.ResponseType)
+		copy(dAtA[i:], m.ResponseType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ResponseType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRetryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextRetryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Retries != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovTypes(uint64(m.Permission))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WasmCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WASMByteCode)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.InstantiatePermission != nil {
+		l = m.InstantiatePermission.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminCodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisteredChecksum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SourceURL)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BuilderImage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AcceptedStargateQueries) > 0 {
+		for _, e := range m.AcceptedStargateQueries {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxCallbackRetries != 0 {
+		n += 1 + sovTypes(uint64(m.MaxCallbackRetries))
+	}
+	if m.CallbackRetryInterval != 0 {
+		n += 1 + sovTypes(uint64(m.CallbackRetryInterval))
+	}
+	if m.MaxFailedCallbacksPerContract != 0 {
+		n += 1 + sovTypes(uint64(m.MaxFailedCallbacksPerContract))
+	}
+	return n
+}
+
+func (m *AcceptedStargateQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ResponseType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *FailedCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTypes(uint64(m.ID))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovTypes(uint64(m.Retries))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.NextRetryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.NextRetryHeight))
+	}
+	return n
+}
+
+func (m *ForwardRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= types.AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WasmCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WasmCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WasmCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.WASMByteCode == nil {
+				m.WASMByteCode = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstantiatePermission == nil {
+				m.InstantiatePermission = &AccessConfig{}
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminCodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminCodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminCodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredChecksum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredChecksum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredChecksum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuilderImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuilderImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedStargateQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedStargateQueries = append(m.AcceptedStargateQueries, AcceptedStargateQuery{})
+			if err := m.AcceptedStargateQueries[len(m.AcceptedStargateQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackRetries", wireType)
+			}
+			m.MaxCallbackRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallbackRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackRetryInterval", wireType)
+			}
+			m.CallbackRetryInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackRetryInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailedCallbacksPerContract", wireType)
+			}
+			m.MaxFailedCallbacksPerContract = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFailedCallbacksPerContract |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcceptedStargateQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcceptedStargateQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcceptedStargateQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	if m.Permission != 0 {
-		n += 1 + sovTypes(uint64(m.Permission))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+func (m *FailedCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryHeight", wireType)
+			}
+			m.NextRetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRetryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+func (m *ForwardRefund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -190,17 +2060,17 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardRefund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardRefund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			m.Permission = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -210,14 +2080,27 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= types.AccessType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -245,7 +2128,58 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex